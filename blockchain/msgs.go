@@ -34,6 +34,10 @@ func EncodeMsg(pb proto.Message) ([]byte, error) {
 		msg.Sum = &bcproto.Message_StatusRequest{StatusRequest: pb}
 	case *bcproto.StatusResponse:
 		msg.Sum = &bcproto.Message_StatusResponse{StatusResponse: pb}
+	case *bcproto.LightBlockRequest:
+		msg.Sum = &bcproto.Message_LightBlockRequest{LightBlockRequest: pb}
+	case *bcproto.LightBlockResponse:
+		msg.Sum = &bcproto.Message_LightBlockResponse{LightBlockResponse: pb}
 	default:
 		return nil, fmt.Errorf("unknown message type %T", pb)
 	}
@@ -66,6 +70,10 @@ func DecodeMsg(bz []byte) (proto.Message, error) {
 		return msg.StatusRequest, nil
 	case *bcproto.Message_StatusResponse:
 		return msg.StatusResponse, nil
+	case *bcproto.Message_LightBlockRequest:
+		return msg.LightBlockRequest, nil
+	case *bcproto.Message_LightBlockResponse:
+		return msg.LightBlockResponse, nil
 	default:
 		return nil, fmt.Errorf("unknown message type %T", msg)
 	}
@@ -103,6 +111,19 @@ func ValidateMsg(pb proto.Message) error {
 		}
 	case *bcproto.StatusRequest:
 		return nil
+	case *bcproto.LightBlockRequest:
+		if msg.Height < 0 {
+			return errors.New("negative Height")
+		}
+	case *bcproto.LightBlockResponse:
+		// the peer does not have the light block
+		if msg.LightBlock == nil {
+			return nil
+		}
+		_, err := types.LightBlockFromProto(msg.LightBlock)
+		if err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("unknown message type %T", msg)
 	}
@@ -53,6 +53,26 @@ func TestBcNoBlockResponseMessageValidateBasic(t *testing.T) {
 	}
 }
 
+func TestBcLightBlockRequestMessageValidateBasic(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		requestHeight int64
+		expectErr     bool
+	}{
+		{"Valid Request Message", 0, false},
+		{"Valid Request Message", 1, false},
+		{"Invalid Request Message", -1, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			request := bcproto.LightBlockRequest{Height: tc.requestHeight}
+			assert.Equal(t, tc.expectErr, ValidateMsg(&request) != nil, "Validate Basic had an unexpected result")
+		})
+	}
+}
+
 func TestBcStatusRequestMessageValidateBasic(t *testing.T) {
 	request := bcproto.StatusRequest{}
 	assert.NoError(t, ValidateMsg(&request))
@@ -112,6 +132,10 @@ func TestBlockchainMessageVectors(t *testing.T) {
 		{"StatusResponseMessage", &bcproto.Message{Sum: &bcproto.Message_StatusResponse{
 			StatusResponse: &bcproto.StatusResponse{Height: math.MaxInt64, Base: math.MaxInt64}}},
 			"2a1408ffffffffffffffff7f10ffffffffffffffff7f"},
+		{"LightBlockRequestMessage", &bcproto.Message{Sum: &bcproto.Message_LightBlockRequest{
+			LightBlockRequest: &bcproto.LightBlockRequest{Height: 1}}}, "32020801"},
+		{"LightBlockResponseMessage", &bcproto.Message{Sum: &bcproto.Message_LightBlockResponse{
+			LightBlockResponse: &bcproto.LightBlockResponse{}}}, "3a00"},
 	}
 
 	for _, tc := range testCases {
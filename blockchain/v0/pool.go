@@ -9,6 +9,7 @@ import (
 
 	flow "github.com/creatachain/augusteum/libs/flowrate"
 	"github.com/creatachain/augusteum/libs/log"
+	tmrand "github.com/creatachain/augusteum/libs/rand"
 	"github.com/creatachain/augusteum/libs/service"
 	tmsync "github.com/creatachain/augusteum/libs/sync"
 	"github.com/creatachain/augusteum/p2p"
@@ -28,9 +29,10 @@ eg, L = latency = 0.1s
 */
 
 const (
-	requestIntervalMS         = 2
-	maxTotalRequesters        = 600
-	maxPendingRequests        = maxTotalRequesters
+	requestIntervalMS = 2
+	// MaxTotalRequesters is the maximum number of blocks requested ahead.
+	MaxTotalRequesters        = 600
+	maxPendingRequests        = MaxTotalRequesters
 	maxPendingRequestsPerPeer = 20
 
 	// Minimum recv rate to ensure we're receiving blocks from a peer fast
@@ -75,12 +77,12 @@ type BlockPool struct {
 	numPending int32 // number of requests pending assignment or block response
 
 	requestsCh chan<- BlockRequest
-	errorsCh   chan<- peerError
+	errorsCh   chan<- PeerError
 }
 
 // NewBlockPool returns a new BlockPool with the height equal to start. Block
 // requests and errors will be sent to requestsCh and errorsCh accordingly.
-func NewBlockPool(start int64, requestsCh chan<- BlockRequest, errorsCh chan<- peerError) *BlockPool {
+func NewBlockPool(start int64, requestsCh chan<- BlockRequest, errorsCh chan<- PeerError) *BlockPool {
 	bp := &BlockPool{
 		peers: make(map[p2p.ID]*bpPeer),
 
@@ -95,6 +97,15 @@ func NewBlockPool(start int64, requestsCh chan<- BlockRequest, errorsCh chan<- p
 	return bp
 }
 
+// SetHeight sets the height of the first block to request. It must be called
+// before the pool is started.
+func (pool *BlockPool) SetHeight(height int64) {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+
+	pool.height = height
+}
+
 // OnStart implements service.Service by spawning requesters routine and recording
 // pool's start time.
 func (pool *BlockPool) OnStart() error {
@@ -117,7 +128,7 @@ func (pool *BlockPool) makeRequestersRoutine() {
 			time.Sleep(requestIntervalMS * time.Millisecond)
 			// check for timed out peers
 			pool.removeTimedoutPeers()
-		case lenRequesters >= maxTotalRequesters:
+		case lenRequesters >= MaxTotalRequesters:
 			// sleep for a bit.
 			time.Sleep(requestIntervalMS * time.Millisecond)
 			// check for timed out peers
@@ -139,7 +150,7 @@ func (pool *BlockPool) removeTimedoutPeers() {
 			// curRate can be 0 on start
 			if curRate != 0 && curRate < minRecvRate {
 				err := errors.New("peer is not sending us data fast enough")
-				pool.sendError(err, peer.id)
+				pool.SendError(err, peer.id)
 				pool.Logger.Error("SendTimeout", "peer", peer.id,
 					"reason", err,
 					"curRate", fmt.Sprintf("%d KB/s", curRate/1024),
@@ -202,6 +213,29 @@ func (pool *BlockPool) PeekTwoBlocks() (first *types.Block, second *types.Block)
 	return
 }
 
+// PeekBlocks returns up to max consecutive blocks starting at pool.height.
+// We need to see the next block's Commit to validate a block, so the commits
+// of all but the last block returned can be verified.
+// The caller will verify the commits.
+func (pool *BlockPool) PeekBlocks(max int) []*types.Block {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+
+	blocks := make([]*types.Block, 0, max)
+	for height := pool.height; len(blocks) < max; height++ {
+		r := pool.requesters[height]
+		if r == nil {
+			break
+		}
+		block := r.getBlock()
+		if block == nil {
+			break
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
 // PopRequest pops the first block at pool.height.
 // It must have been validated by 'second'.Commit from PeekTwoBlocks().
 func (pool *BlockPool) PopRequest() {
@@ -261,7 +295,7 @@ func (pool *BlockPool) AddBlock(peerID p2p.ID, block *types.Block, blockSize int
 			diff *= -1
 		}
 		if diff > maxDiffBetweenCurrentAndReceivedBlockHeight {
-			pool.sendError(errors.New("peer sent us a block we didn't expect with a height too far ahead/behind"), peerID)
+			pool.SendError(errors.New("peer sent us a block we didn't expect with a height too far ahead/behind"), peerID)
 		}
 		return
 	}
@@ -274,7 +308,7 @@ func (pool *BlockPool) AddBlock(peerID p2p.ID, block *types.Block, blockSize int
 		}
 	} else {
 		pool.Logger.Info("invalid peer", "peer", peerID, "blockHeight", block.Height)
-		pool.sendError(errors.New("invalid peer"), peerID)
+		pool.SendError(errors.New("invalid peer"), peerID)
 	}
 }
 
@@ -314,6 +348,26 @@ func (pool *BlockPool) RemovePeer(peerID p2p.ID) {
 	pool.removePeer(peerID)
 }
 
+// PeersAtHeight returns the peers which allegedly have the block at the given
+// height, in random order.
+func (pool *BlockPool) PeersAtHeight(height int64) []p2p.ID {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+
+	peers := make([]p2p.ID, 0, len(pool.peers))
+	for _, peer := range pool.peers {
+		if peer.didTimeout || height < peer.base || height > peer.height {
+			continue
+		}
+		peers = append(peers, peer.id)
+	}
+	shuffled := make([]p2p.ID, len(peers))
+	for i, j := range tmrand.Perm(len(peers)) {
+		shuffled[i] = peers[j]
+	}
+	return shuffled
+}
+
 func (pool *BlockPool) removePeer(peerID p2p.ID) {
 	for _, requester := range pool.requesters {
 		if requester.getPeerID() == peerID {
@@ -402,11 +456,12 @@ func (pool *BlockPool) sendRequest(height int64, peerID p2p.ID) {
 	pool.requestsCh <- BlockRequest{height, peerID}
 }
 
-func (pool *BlockPool) sendError(err error, peerID p2p.ID) {
+// SendError reports an error caused by the peer.
+func (pool *BlockPool) SendError(err error, peerID p2p.ID) {
 	if !pool.IsRunning() {
 		return
 	}
-	pool.errorsCh <- peerError{err, peerID}
+	pool.errorsCh <- PeerError{err, peerID}
 }
 
 // for debugging purposes
@@ -497,7 +552,7 @@ func (peer *bpPeer) onTimeout() {
 	defer peer.pool.mtx.Unlock()
 
 	err := errors.New("peer did not send us anything")
-	peer.pool.sendError(err, peer.id)
+	peer.pool.SendError(err, peer.id)
 	peer.logger.Error("SendTimeout", "reason", err, "timeout", peerTimeout)
 	peer.didTimeout = true
 }
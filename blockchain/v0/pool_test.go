@@ -80,7 +80,7 @@ func makePeers(numPeers int, minHeight, maxHeight int64) testPeers {
 func TestBlockPoolBasic(t *testing.T) {
 	start := int64(42)
 	peers := makePeers(10, start+1, 1000)
-	errorsCh := make(chan peerError, 1000)
+	errorsCh := make(chan PeerError, 1000)
 	requestsCh := make(chan BlockRequest, 1000)
 	pool := NewBlockPool(start, requestsCh, errorsCh)
 	pool.SetLogger(log.TestingLogger())
@@ -140,7 +140,7 @@ func TestBlockPoolBasic(t *testing.T) {
 func TestBlockPoolTimeout(t *testing.T) {
 	start := int64(42)
 	peers := makePeers(10, start+1, 1000)
-	errorsCh := make(chan peerError, 1000)
+	errorsCh := make(chan PeerError, 1000)
 	requestsCh := make(chan BlockRequest, 1000)
 	pool := NewBlockPool(start, requestsCh, errorsCh)
 	pool.SetLogger(log.TestingLogger())
@@ -188,7 +188,7 @@ func TestBlockPoolTimeout(t *testing.T) {
 		case err := <-errorsCh:
 			t.Log(err)
 			// consider error to be always timeout here
-			if _, ok := timedOut[err.peerID]; !ok {
+			if _, ok := timedOut[err.PeerID]; !ok {
 				counter++
 				if counter == len(peers) {
 					return // Done!
@@ -208,7 +208,7 @@ func TestBlockPoolRemovePeer(t *testing.T) {
 		peers[peerID] = testPeer{peerID, 0, height, make(chan inputData)}
 	}
 	requestsCh := make(chan BlockRequest)
-	errorsCh := make(chan peerError)
+	errorsCh := make(chan PeerError)
 
 	pool := NewBlockPool(1, requestsCh, errorsCh)
 	pool.SetLogger(log.TestingLogger())
@@ -240,3 +240,41 @@ func TestBlockPoolRemovePeer(t *testing.T) {
 
 	assert.EqualValues(t, 0, pool.MaxPeerHeight())
 }
+
+func TestBlockPoolPeekBlocks(t *testing.T) {
+	requestsCh := make(chan BlockRequest, 100)
+	errorsCh := make(chan PeerError, 100)
+
+	pool := NewBlockPool(1, requestsCh, errorsCh)
+	pool.SetLogger(log.TestingLogger())
+	err := pool.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := pool.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	pool.SetPeerRange("a", 1, 2)
+	pool.SetPeerRange("b", 2, 5)
+	assert.ElementsMatch(t, []p2p.ID{"a"}, pool.PeersAtHeight(1))
+	assert.ElementsMatch(t, []p2p.ID{"a", "b"}, pool.PeersAtHeight(2))
+	assert.Empty(t, pool.PeersAtHeight(6))
+
+	// the blocks are returned up to the first missing one
+	for answered := 0; answered < 3; {
+		request := <-requestsCh
+		if request.Height == 3 || request.Height == 5 {
+			continue
+		}
+		block := &types.Block{Header: types.Header{Height: request.Height}}
+		pool.AddBlock(request.PeerID, block, 123)
+		answered++
+	}
+	require.Eventually(t, func() bool {
+		return len(pool.PeekBlocks(10)) == 2
+	}, time.Second, 10*time.Millisecond)
+	blocks := pool.PeekBlocks(1)
+	require.Len(t, blocks, 1)
+	assert.EqualValues(t, 1, blocks[0].Height)
+}
@@ -36,13 +36,14 @@ type consensusReactor interface {
 	SwitchToConsensus(state sm.State, skipWAL bool)
 }
 
-type peerError struct {
-	err    error
-	peerID p2p.ID
+// PeerError is an error caused by a peer, which gets disconnected.
+type PeerError struct {
+	Err    error
+	PeerID p2p.ID
 }
 
-func (e peerError) Error() string {
-	return fmt.Sprintf("error with peer %v: %s", e.peerID, e.err.Error())
+func (e PeerError) Error() string {
+	return fmt.Sprintf("error with peer %v: %s", e.PeerID, e.Err.Error())
 }
 
 // BlockchainReactor handles long-term catchup syncing.
@@ -58,7 +59,7 @@ type BlockchainReactor struct {
 	fastSync  bool
 
 	requestsCh <-chan BlockRequest
-	errorsCh   <-chan peerError
+	errorsCh   <-chan PeerError
 }
 
 // NewBlockchainReactor returns new reactor instance.
@@ -70,10 +71,10 @@ func NewBlockchainReactor(state sm.State, blockExec *sm.BlockExecutor, store *st
 			store.Height()))
 	}
 
-	requestsCh := make(chan BlockRequest, maxTotalRequesters)
+	requestsCh := make(chan BlockRequest, MaxTotalRequesters)
 
 	const capacity = 1000                      // must be bigger than peers count
-	errorsCh := make(chan peerError, capacity) // so we don't block in #Receive#pool.AddBlock
+	errorsCh := make(chan PeerError, capacity) // so we don't block in #Receive#pool.AddBlock
 
 	startHeight := store.Height() + 1
 	if startHeight == 1 {
@@ -297,7 +298,7 @@ func (bcR *BlockchainReactor) poolRoutine(stateSynced bool) {
 					bcR.Logger.Debug("Send queue is full, drop block request", "peer", peer.ID(), "height", request.Height)
 				}
 			case err := <-bcR.errorsCh:
-				peer := bcR.Switch.Peers().Get(err.peerID)
+				peer := bcR.Switch.Peers().Get(err.PeerID)
 				if peer != nil {
 					bcR.Switch.StopPeerForError(peer, err)
 				}
@@ -0,0 +1,533 @@
+package v3
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	bc "github.com/creatachain/augusteum/blockchain"
+	"github.com/creatachain/augusteum/light"
+	"github.com/creatachain/augusteum/p2p"
+	bcproto "github.com/creatachain/augusteum/proto/augusteum/blockchain"
+	tmproto "github.com/creatachain/augusteum/proto/augusteum/types"
+	sm "github.com/creatachain/augusteum/state"
+	"github.com/creatachain/augusteum/types"
+)
+
+const (
+	// maximum distance between the heights of the headers verified ahead, so
+	// that the blocks in between are authenticated within the window of
+	// blocks ahead of the one being applied
+	headerInterval = verificationWindow
+
+	// check if there are headers to verify up to the highest reported height
+	verifyHeadersIntervalMS = 100
+
+	// number of consecutive failures to get or verify the light blocks from
+	// the peers after which the headers are no longer verified first, as the
+	// peers may not serve light blocks
+	maxLightBlockFailures = 3
+
+	// how much the time of a header can drift into the future
+	maxClockDrift = 10 * time.Second
+)
+
+var (
+	// time to wait for a light block from a peer
+	lightBlockTimeout = 10 * time.Second // not const so we can override with tests
+
+	errNoLightBlock = errors.New("peer does not have the light block")
+	errNoPeers      = errors.New("no peer reported the height of the light block")
+)
+
+// errBlockMismatch is returned when a block doesn't match the ID of the
+// block at its height derived from the verified headers.
+type errBlockMismatch struct {
+	height int64
+}
+
+func (e errBlockMismatch) Error() string {
+	return fmt.Sprintf("block at height %d doesn't match the verified headers", e.height)
+}
+
+// headerChain holds the IDs of the blocks whose header was verified ahead by
+// the light client, and of the blocks authenticated below them by the last
+// block IDs of the blocks in between.
+type headerChain struct {
+	mtx      sync.Mutex
+	blockIDs map[int64]types.BlockID
+	tip      int64 // the height of the highest verified header
+	disabled bool  // the headers can't be verified from the state
+}
+
+func newHeaderChain() *headerChain {
+	return &headerChain{
+		blockIDs: make(map[int64]types.BlockID),
+	}
+}
+
+// add adds a verified light block.
+func (hc *headerChain) add(lb *types.LightBlock) {
+	hc.mtx.Lock()
+	defer hc.mtx.Unlock()
+
+	hc.blockIDs[lb.Height] = lb.Commit.BlockID
+	if lb.Height > hc.tip {
+		hc.tip = lb.Height
+	}
+}
+
+// verifiedTip returns the height of the highest verified header.
+func (hc *headerChain) verifiedTip() int64 {
+	hc.mtx.Lock()
+	defer hc.mtx.Unlock()
+	return hc.tip
+}
+
+// disable gives up on verifying the headers first, so that the blocks are
+// verified by their commit.
+func (hc *headerChain) disable() {
+	hc.mtx.Lock()
+	defer hc.mtx.Unlock()
+	hc.disabled = true
+}
+
+// caughtUp returns true if the blocks up to the highest verified header were
+// applied, given the height of the next block to apply.
+func (hc *headerChain) caughtUp(height int64) bool {
+	hc.mtx.Lock()
+	defer hc.mtx.Unlock()
+	return hc.disabled || (hc.tip > 0 && height >= hc.tip)
+}
+
+// authenticate returns the ID of the first of the consecutive blocks,
+// derived from the lowest verified header at or above it. It returns false if
+// there is no verified header up to the last block, and an errBlockMismatch
+// for the highest block which doesn't match its derived ID.
+func (hc *headerChain) authenticate(blocks []*types.Block) (types.BlockID, bool, error) {
+	hc.mtx.Lock()
+	defer hc.mtx.Unlock()
+
+	top := -1
+	for i, block := range blocks {
+		if _, ok := hc.blockIDs[block.Height]; ok {
+			top = i
+			break
+		}
+	}
+	if top < 0 {
+		return types.BlockID{}, false, nil
+	}
+
+	// the last block ID of an authenticated block is the ID of the previous one
+	blockID := hc.blockIDs[blocks[top].Height]
+	for i := top; i >= 0; i-- {
+		if !bytes.Equal(blocks[i].Hash(), blockID.Hash) {
+			return types.BlockID{}, true, errBlockMismatch{height: blocks[i].Height}
+		}
+		if i > 0 {
+			blockID = blocks[i].LastBlockID
+			hc.blockIDs[blocks[i-1].Height] = blockID
+		}
+	}
+	return blockID, true, nil
+}
+
+// forget forgets the ID of an applied block.
+func (hc *headerChain) forget(height int64) {
+	hc.mtx.Lock()
+	defer hc.mtx.Unlock()
+	delete(hc.blockIDs, height)
+}
+
+// lightBlockResult is the response of a peer to a light block request.
+type lightBlockResult struct {
+	lightBlock *types.LightBlock
+	err        error
+}
+
+type pendingLightBlock struct {
+	height int64
+	ch     chan lightBlockResult
+}
+
+// lightBlockRequests matches the light blocks sent by the peers with the
+// requests waiting for them, one per peer.
+type lightBlockRequests struct {
+	mtx     sync.Mutex
+	pending map[p2p.ID]pendingLightBlock
+}
+
+func newLightBlockRequests() *lightBlockRequests {
+	return &lightBlockRequests{
+		pending: make(map[p2p.ID]pendingLightBlock),
+	}
+}
+
+// request requests the light block at the given height from the peer, and
+// waits for it until the timeout or until quit is closed.
+func (r *lightBlockRequests) request(peer p2p.Peer, height int64,
+	quit <-chan struct{}) (*types.LightBlock, error) {
+
+	ch := make(chan lightBlockResult, 1)
+	r.mtx.Lock()
+	if _, ok := r.pending[peer.ID()]; ok {
+		r.mtx.Unlock()
+		return nil, fmt.Errorf("a light block was already requested from peer %v", peer.ID())
+	}
+	r.pending[peer.ID()] = pendingLightBlock{height: height, ch: ch}
+	r.mtx.Unlock()
+
+	defer func() {
+		r.mtx.Lock()
+		delete(r.pending, peer.ID())
+		r.mtx.Unlock()
+	}()
+
+	msgBytes, err := bc.EncodeMsg(&bcproto.LightBlockRequest{Height: height})
+	if err != nil {
+		return nil, err
+	}
+	if !peer.TrySend(BlockchainChannel, msgBytes) {
+		return nil, errors.New("send queue is full")
+	}
+
+	timer := time.NewTimer(lightBlockTimeout)
+	defer timer.Stop()
+	select {
+	case res := <-ch:
+		return res.lightBlock, res.err
+	case <-timer.C:
+		return nil, fmt.Errorf("timed out waiting for the light block at height %d", height)
+	case <-quit:
+		return nil, errors.New("quit")
+	}
+}
+
+// respond hands the light block sent by the peer to the request waiting for
+// it. A nil light block means the peer doesn't have it.
+func (r *lightBlockRequests) respond(peerID p2p.ID, lb *types.LightBlock) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	req, ok := r.pending[peerID]
+	if !ok {
+		return
+	}
+	delete(r.pending, peerID)
+
+	switch {
+	case lb == nil:
+		req.ch <- lightBlockResult{err: errNoLightBlock}
+	case lb.Height != req.height:
+		req.ch <- lightBlockResult{err: fmt.Errorf("expected light block at height %d, got %d",
+			req.height, lb.Height)}
+	default:
+		req.ch <- lightBlockResult{lightBlock: lb}
+	}
+}
+
+// cancel fails the request waiting for the light block of the peer.
+func (r *lightBlockRequests) cancel(peerID p2p.ID) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if req, ok := r.pending[peerID]; ok {
+		delete(r.pending, peerID)
+		req.ch <- lightBlockResult{err: errors.New("peer was removed")}
+	}
+}
+
+// loadLightBlock loads the light block at the given height, or returns nil if
+// the stores don't have it.
+func (bcR *BlockchainReactor) loadLightBlock(height int64) *types.LightBlock {
+	if bcR.stateStore == nil {
+		return nil
+	}
+
+	meta := bcR.store.LoadBlockMeta(height)
+	if meta == nil {
+		return nil
+	}
+	// the canonical commit is stored with the next block
+	commit := bcR.store.LoadBlockCommit(height)
+	if commit == nil {
+		commit = bcR.store.LoadSeenCommit(height)
+	}
+	if commit == nil {
+		return nil
+	}
+	vals, err := bcR.stateStore.LoadValidators(height)
+	if err != nil {
+		return nil
+	}
+
+	return &types.LightBlock{
+		SignedHeader: &types.SignedHeader{Header: &meta.Header, Commit: commit},
+		ValidatorSet: vals,
+	}
+}
+
+// respondLightBlockToPeer loads a light block and sends it to the requesting
+// peer, if we have it. Otherwise, we'll respond with an empty response.
+func (bcR *BlockchainReactor) respondLightBlockToPeer(msg *bcproto.LightBlockRequest,
+	src p2p.Peer) (queued bool) {
+
+	var pb *tmproto.LightBlock
+	if lb := bcR.loadLightBlock(msg.Height); lb != nil {
+		var err error
+		pb, err = lb.ToProto()
+		if err != nil {
+			bcR.Logger.Error("could not convert msg to protobuf", "err", err)
+			return false
+		}
+	} else {
+		bcR.Logger.Info("Peer asking for a light block we don't have", "src", src, "height", msg.Height)
+	}
+
+	msgBytes, err := bc.EncodeMsg(&bcproto.LightBlockResponse{LightBlock: pb})
+	if err != nil {
+		bcR.Logger.Error("could not marshal msg", "err", err)
+		return false
+	}
+
+	return src.TrySend(BlockchainChannel, msgBytes)
+}
+
+// fetchLightBlock requests the light block at the given height from the
+// peers which reported having it, until one sends a valid one.
+func (bcR *BlockchainReactor) fetchLightBlock(chainID string,
+	height int64) (*types.LightBlock, p2p.ID, error) {
+
+	requested := false
+	for _, peerID := range bcR.pool.PeersAtHeight(height) {
+		peer := bcR.Switch.Peers().Get(peerID)
+		if peer == nil {
+			continue
+		}
+		requested = true
+
+		lb, err := bcR.lightBlocks.request(peer, height, bcR.Quit())
+		if err != nil {
+			bcR.Logger.Debug("Failed to get light block", "peer", peerID, "height", height, "err", err)
+			continue
+		}
+		if err := lb.ValidateBasic(chainID); err != nil {
+			bcR.pool.SendError(fmt.Errorf("invalid light block: %w", err), peerID)
+			continue
+		}
+		return lb, peerID, nil
+	}
+
+	if !requested {
+		return nil, "", errNoPeers
+	}
+	return nil, "", fmt.Errorf("no peer sent the light block at height %d", height)
+}
+
+// trustedLightBlock returns the light block of the last block of the state,
+// which the headers are verified from. It is loaded from the stores, or
+// fetched from the peers and checked against the state if the state was
+// restored by state sync. At the initial height, the light block is verified
+// against the validators of the genesis.
+func (bcR *BlockchainReactor) trustedLightBlock(state sm.State) (*types.LightBlock, error) {
+	height := state.LastBlockHeight
+	if height == 0 {
+		lb, peerID, err := bcR.fetchLightBlock(state.ChainID, state.InitialHeight)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(lb.ValidatorsHash, state.Validators.Hash()) {
+			err = fmt.Errorf("expected validators hash %X of the genesis at the initial height, got %X",
+				state.Validators.Hash(), lb.ValidatorsHash)
+			bcR.pool.SendError(err, peerID)
+			return nil, err
+		}
+		err = state.Validators.VerifyCommitLight(state.ChainID, lb.Commit.BlockID, lb.Height, lb.Commit)
+		if err != nil {
+			bcR.pool.SendError(err, peerID)
+			return nil, err
+		}
+		return lb, nil
+	}
+
+	if meta := bcR.store.LoadBlockMeta(height); meta != nil {
+		if commit := bcR.store.LoadSeenCommit(height); commit != nil {
+			return &types.LightBlock{
+				SignedHeader: &types.SignedHeader{Header: &meta.Header, Commit: commit},
+				ValidatorSet: state.LastValidators,
+			}, nil
+		}
+	}
+
+	lb, peerID, err := bcR.fetchLightBlock(state.ChainID, height)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(lb.Hash(), state.LastBlockID.Hash) {
+		err = fmt.Errorf("expected hash %X of the last block of the state, got %X",
+			state.LastBlockID.Hash, lb.Hash())
+		bcR.pool.SendError(err, peerID)
+		return nil, err
+	}
+	return lb, nil
+}
+
+// verifyLightBlock verifies the light block at the given height from the
+// trusted one, skipping the headers in between when enough of the trusted
+// validators signed it, and bisecting otherwise. The light blocks verified
+// along the way are added to the header chain.
+func (bcR *BlockchainReactor) verifyLightBlock(trusted *types.LightBlock,
+	height int64) (*types.LightBlock, error) {
+
+	type untrustedLightBlock struct {
+		*types.LightBlock
+		peerID p2p.ID
+	}
+
+	lb, peerID, err := bcR.fetchLightBlock(trusted.ChainID, height)
+	if err != nil {
+		return nil, err
+	}
+	pending := []untrustedLightBlock{{lb, peerID}}
+	for len(pending) > 0 {
+		untrusted := pending[len(pending)-1]
+		err := light.Verify(trusted.SignedHeader, trusted.ValidatorSet,
+			untrusted.SignedHeader, untrusted.ValidatorSet,
+			bcR.trustPeriod, time.Now(), maxClockDrift, light.DefaultTrustLevel)
+		switch err.(type) {
+		case nil:
+			trusted = untrusted.LightBlock
+			pending = pending[:len(pending)-1]
+			bcR.headers.add(trusted)
+		case light.ErrNewValSetCantBeTrusted:
+			// the adjacent headers are verified by the next validators
+			pivot := (trusted.Height + untrusted.Height) / 2
+			lb, peerID, err := bcR.fetchLightBlock(trusted.ChainID, pivot)
+			if err != nil {
+				return nil, err
+			}
+			pending = append(pending, untrustedLightBlock{lb, peerID})
+		case light.ErrOldHeaderExpired:
+			// the trusted header expired, which is not the fault of the peer
+			return nil, err
+		default:
+			// the header, or its commit, is invalid
+			err = fmt.Errorf("failed to verify light block at height %d: %w", untrusted.Height, err)
+			bcR.pool.SendError(err, untrusted.peerID)
+			return nil, err
+		}
+	}
+
+	return trusted, nil
+}
+
+// headersRoutine verifies the headers up to the highest height reported by
+// the peers, one interval at a time, from the light block of the state. It
+// gives up if the state is outside the trust period, or if the light blocks
+// repeatedly can't be got or verified from the peers, so that the blocks are
+// verified by their commit.
+func (bcR *BlockchainReactor) headersRoutine(state sm.State) {
+	ticker := time.NewTicker(verifyHeadersIntervalMS * time.Millisecond)
+	defer ticker.Stop()
+
+	var (
+		trusted  *types.LightBlock
+		failures int
+	)
+	// failed returns true once too many attempts in a row failed. Attempts
+	// without a peer to ask don't count.
+	failed := func(err error) bool {
+		if errors.Is(err, errNoPeers) {
+			return false
+		}
+		if failures++; failures < maxLightBlockFailures {
+			return false
+		}
+		bcR.Logger.Info("Failed to get the light blocks from the peers, verifying the blocks by their commit",
+			"failures", failures, "err", err)
+		bcR.headers.disable()
+		return true
+	}
+
+	for {
+		select {
+		case <-bcR.Quit():
+			return
+		case <-bcR.pool.Quit():
+			return
+		case <-ticker.C:
+		}
+
+		if trusted == nil {
+			lb, err := bcR.trustedLightBlock(state)
+			if err != nil {
+				bcR.Logger.Debug("Failed to get the trusted light block", "err", err)
+				if failed(err) {
+					return
+				}
+				continue
+			}
+			if light.HeaderExpired(lb.SignedHeader, bcR.trustPeriod, time.Now()) {
+				bcR.Logger.Info("State is outside the trust period, verifying the blocks by their commit",
+					"height", lb.Height, "time", lb.Time, "trust_period", bcR.trustPeriod)
+				bcR.headers.disable()
+				return
+			}
+			trusted = lb
+			failures = 0
+			bcR.headers.add(trusted)
+		}
+
+		for target := bcR.pool.MaxPeerHeight(); trusted.Height < target && bcR.IsRunning(); {
+			height := trusted.Height + headerInterval
+			if height > target {
+				height = target
+			}
+			lb, err := bcR.verifyLightBlock(trusted, height)
+			if _, ok := err.(light.ErrOldHeaderExpired); ok {
+				bcR.Logger.Info("Verified headers are outside the trust period, verifying the blocks by their commit",
+					"height", trusted.Height, "time", trusted.Time, "trust_period", bcR.trustPeriod)
+				bcR.headers.disable()
+				return
+			}
+			if err != nil {
+				bcR.Logger.Error("Failed to verify the headers", "height", height, "err", err)
+				if failed(err) {
+					return
+				}
+				break
+			}
+			trusted = lb
+			failures = 0
+			bcR.metrics.VerifiedHeaderHeight.Set(float64(trusted.Height))
+			if trusted.Height == target {
+				bcR.Logger.Info("Verified the headers", "height", trusted.Height)
+			}
+		}
+	}
+}
+
+// authenticateBlock returns the parts and the ID of the first of the
+// consecutive blocks, authenticated by the verified headers. It returns false
+// if no verified header is among the blocks.
+func (bcR *BlockchainReactor) authenticateBlock(blocks []*types.Block) (*types.PartSet,
+	types.BlockID, bool, error) {
+
+	if bcR.headers == nil {
+		return nil, types.BlockID{}, false, nil
+	}
+	blockID, ok, err := bcR.headers.authenticate(blocks)
+	if !ok || err != nil {
+		return nil, types.BlockID{}, ok, err
+	}
+
+	first := blocks[0]
+	parts := first.MakePartSet(types.BlockPartSizeBytes)
+	if !parts.Header().Equals(blockID.PartSetHeader) {
+		return nil, types.BlockID{}, true, errBlockMismatch{height: first.Height}
+	}
+	return parts, blockID, true, nil
+}
@@ -0,0 +1,169 @@
+package v3
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/metrics/generic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bcv0 "github.com/creatachain/augusteum/blockchain/v0"
+	cfg "github.com/creatachain/augusteum/config"
+	"github.com/creatachain/augusteum/libs/log"
+	"github.com/creatachain/augusteum/p2p"
+	"github.com/creatachain/augusteum/types"
+)
+
+func TestVerifyHeadersFirst(t *testing.T) {
+	testCases := []struct {
+		name          string
+		trustPeriod   time.Duration
+		authenticated bool
+	}{
+		{"within trust period", time.Hour, true},
+		// the blocks are verified by their commit instead
+		{"trust period expired", time.Nanosecond, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			config = cfg.ResetTestRoot("blockchain_reactor_test")
+			defer os.RemoveAll(config.RootDir)
+			genDoc, privVals := randGenesisDoc(1, false, 30)
+
+			maxBlockHeight := int64(250)
+			metrics := NopMetrics()
+			authenticated := generic.NewCounter("authenticated_blocks")
+			metrics.AuthenticatedBlocks = authenticated
+
+			reactorPairs := []BlockchainReactorPair{
+				newBlockchainReactor(log.TestingLogger(), genDoc, privVals, maxBlockHeight),
+				newBlockchainReactor(log.TestingLogger(), genDoc, privVals, 0,
+					ReactorVerifyHeadersFirst(tc.trustPeriod), ReactorMetrics(metrics)),
+			}
+
+			p2p.MakeConnectedSwitches(config.P2P, 2, func(i int, s *p2p.Switch) *p2p.Switch {
+				s.AddReactor("BLOCKCHAIN", reactorPairs[i].reactor)
+				return s
+			}, p2p.Connect2Switches)
+
+			defer func() {
+				for _, r := range reactorPairs {
+					err := r.reactor.Stop()
+					require.NoError(t, err)
+					err = r.app.Stop()
+					require.NoError(t, err)
+				}
+			}()
+
+			bcR := reactorPairs[1].reactor
+			require.Eventually(t, func() bool {
+				height, _, _ := bcR.pool.GetStatus()
+				return bcR.pool.IsCaughtUp() && bcR.headers.caughtUp(height)
+			}, 30*time.Second, 10*time.Millisecond)
+
+			assert.EqualValues(t, maxBlockHeight-1, bcR.store.Height())
+			if tc.authenticated {
+				assert.Equal(t, maxBlockHeight, bcR.headers.verifiedTip())
+				assert.Greater(t, authenticated.Value(), float64(0))
+			} else {
+				assert.Zero(t, bcR.headers.verifiedTip())
+				assert.Zero(t, authenticated.Value())
+			}
+		})
+	}
+}
+
+func TestVerifyHeadersFirstWithoutLightBlocks(t *testing.T) {
+	config = cfg.ResetTestRoot("blockchain_reactor_test")
+	defer os.RemoveAll(config.RootDir)
+	genDoc, privVals := randGenesisDoc(1, false, 30)
+
+	defer func(timeout time.Duration) { lightBlockTimeout = timeout }(lightBlockTimeout)
+	lightBlockTimeout = 100 * time.Millisecond
+
+	// the peer runs v0, which drops the light block requests
+	maxBlockHeight := int64(50)
+	peerPair := newBlockchainReactor(log.TestingLogger(), genDoc, privVals, maxBlockHeight)
+	peerR := bcv0.NewBlockchainReactor(peerPair.reactor.initialState, peerPair.reactor.blockExec,
+		peerPair.reactor.store, true)
+	peerR.SetLogger(log.TestingLogger())
+	reactorPair := newBlockchainReactor(log.TestingLogger(), genDoc, privVals, 0,
+		ReactorVerifyHeadersFirst(time.Hour))
+	bcR := reactorPair.reactor
+
+	reactors := []p2p.Reactor{peerR, bcR}
+	p2p.MakeConnectedSwitches(config.P2P, 2, func(i int, s *p2p.Switch) *p2p.Switch {
+		s.AddReactor("BLOCKCHAIN", reactors[i])
+		return s
+	}, p2p.Connect2Switches)
+
+	defer func() {
+		for _, r := range reactors {
+			err := r.Stop()
+			require.NoError(t, err)
+		}
+		for _, pair := range []BlockchainReactorPair{peerPair, reactorPair} {
+			err := pair.app.Stop()
+			require.NoError(t, err)
+		}
+	}()
+
+	// we give up on the headers and verify the blocks by their commit
+	require.Eventually(t, func() bool {
+		height, _, _ := bcR.pool.GetStatus()
+		return bcR.pool.IsCaughtUp() && bcR.headers.caughtUp(height)
+	}, 30*time.Second, 10*time.Millisecond)
+
+	assert.EqualValues(t, maxBlockHeight-1, bcR.store.Height())
+	assert.Zero(t, bcR.headers.verifiedTip())
+}
+
+func TestHeaderChainAuthenticate(t *testing.T) {
+	config = cfg.ResetTestRoot("blockchain_reactor_test")
+	defer os.RemoveAll(config.RootDir)
+	genDoc, privVals := randGenesisDoc(1, false, 30)
+
+	pair := newBlockchainReactor(log.TestingLogger(), genDoc, privVals, 5)
+	defer pair.app.Stop() // nolint:errcheck
+	bs := pair.reactor.store
+
+	loadBlocks := func() []*types.Block {
+		blocks := make([]*types.Block, 0, 5)
+		for height := int64(1); height <= 5; height++ {
+			blocks = append(blocks, bs.LoadBlock(height))
+		}
+		return blocks
+	}
+	verifiedLightBlock := func(height int64) *types.LightBlock {
+		return &types.LightBlock{SignedHeader: &types.SignedHeader{
+			Header: &bs.LoadBlockMeta(height).Header,
+			Commit: bs.LoadSeenCommit(height),
+		}}
+	}
+
+	// no verified header among the blocks
+	hc := newHeaderChain()
+	_, ok, err := hc.authenticate(loadBlocks())
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// the first block is authenticated by the header verified at height 4
+	hc.add(verifiedLightBlock(4))
+	blockID, ok, err := hc.authenticate(loadBlocks())
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, bs.LoadBlockMeta(1).BlockID, blockID)
+
+	// a tampered block doesn't match the ID derived from the verified header
+	hc = newHeaderChain()
+	hc.add(verifiedLightBlock(4))
+	blocks := loadBlocks()
+	blocks[2].AppHash = []byte("tampered")
+	_, ok, err = hc.authenticate(blocks)
+	assert.True(t, ok)
+	assert.Equal(t, errBlockMismatch{height: 3}, err)
+}
@@ -0,0 +1,113 @@
+package v3
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "blockchain"
+)
+
+// Metrics contains metrics exposed by this package.
+//
+// The verification rate and the sync rate compare the throughput of the
+// parallel commit verification with the one of the sequential execution.
+type Metrics struct {
+	// Number of blocks synced.
+	BlocksSynced metrics.Counter
+	// Number of blocks whose commit was verified ahead, in parallel.
+	PreverifiedBlocks metrics.Counter
+	// Time spent verifying the commit of a block, in seconds.
+	VerificationTime metrics.Histogram
+	// Time spent applying a block, in seconds.
+	ApplyTime metrics.Histogram
+	// Commits verified per second.
+	VerificationRate metrics.Gauge
+	// Blocks synced per second.
+	SyncRate metrics.Gauge
+	// Height of the highest header verified ahead by the light client.
+	VerifiedHeaderHeight metrics.Gauge
+	// Number of blocks authenticated by the verified headers.
+	AuthenticatedBlocks metrics.Counter
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		BlocksSynced: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "blocks_synced",
+			Help:      "Number of blocks synced.",
+		}, labels).With(labelsAndValues...),
+		PreverifiedBlocks: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "preverified_blocks",
+			Help:      "Number of blocks whose commit was verified ahead, in parallel.",
+		}, labels).With(labelsAndValues...),
+		VerificationTime: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "block_verification_seconds",
+			Help:      "Time spent verifying the commit of a block, in seconds.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.0001, 2, 16),
+		}, labels).With(labelsAndValues...),
+		ApplyTime: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "block_apply_seconds",
+			Help:      "Time spent applying a block, in seconds.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.0001, 2, 16),
+		}, labels).With(labelsAndValues...),
+		VerificationRate: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "verification_rate",
+			Help:      "Commits verified per second.",
+		}, labels).With(labelsAndValues...),
+		SyncRate: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "sync_rate",
+			Help:      "Blocks synced per second.",
+		}, labels).With(labelsAndValues...),
+		VerifiedHeaderHeight: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "verified_header_height",
+			Help:      "Height of the highest header verified ahead by the light client.",
+		}, labels).With(labelsAndValues...),
+		AuthenticatedBlocks: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "authenticated_blocks",
+			Help:      "Number of blocks authenticated by the verified headers.",
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		BlocksSynced:         discard.NewCounter(),
+		PreverifiedBlocks:    discard.NewCounter(),
+		VerificationTime:     discard.NewHistogram(),
+		ApplyTime:            discard.NewHistogram(),
+		VerificationRate:     discard.NewGauge(),
+		SyncRate:             discard.NewGauge(),
+		VerifiedHeaderHeight: discard.NewGauge(),
+		AuthenticatedBlocks:  discard.NewCounter(),
+	}
+}
@@ -0,0 +1,534 @@
+package v3
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"time"
+
+	bc "github.com/creatachain/augusteum/blockchain"
+	bcv0 "github.com/creatachain/augusteum/blockchain/v0"
+	"github.com/creatachain/augusteum/libs/log"
+	"github.com/creatachain/augusteum/p2p"
+	bcproto "github.com/creatachain/augusteum/proto/augusteum/blockchain"
+	sm "github.com/creatachain/augusteum/state"
+	"github.com/creatachain/augusteum/store"
+	"github.com/creatachain/augusteum/types"
+)
+
+const (
+	// BlockchainChannel is a channel for blocks and status updates (`BlockStore` height)
+	BlockchainChannel = byte(0x40)
+
+	trySyncIntervalMS = 10
+
+	// stop syncing when last block's time is
+	// within this much of the system time.
+	// stopSyncingDurationMinutes = 10
+
+	// ask for best height every 10s
+	statusUpdateIntervalSeconds = 10
+	// check if we should switch to consensus reactor
+	switchToConsensusIntervalSeconds = 1
+
+	// number of blocks ahead of the one being applied whose commit is
+	// verified in parallel
+	verificationWindow = 100
+)
+
+type consensusReactor interface {
+	// for when we switch from blockchain reactor and fast sync to
+	// the consensus machine
+	SwitchToConsensus(state sm.State, skipWAL bool)
+}
+
+// BlockchainReactor handles long-term catchup syncing.
+//
+// Unlike v0, the commits of the blocks ahead of the one being applied are
+// verified in parallel, against the validator sets known at the height being
+// applied. Only the blocks are applied sequentially.
+//
+// With VerifyHeadersFirst, the headers up to the highest height reported by
+// the peers are verified first by the light client, and the blocks are
+// authenticated by the hashes of the verified headers instead of their commit.
+// Since the peers can't make us sync a fork or an invented height, we only
+// switch to consensus once the blocks up to the verified headers are applied.
+type BlockchainReactor struct {
+	p2p.BaseReactor
+
+	// immutable
+	initialState sm.State
+
+	blockExec  *sm.BlockExecutor
+	store      *store.BlockStore
+	stateStore sm.Store
+	pool       *bcv0.BlockPool
+	fastSync   bool
+
+	// headers first, nil if disabled
+	headers     *headerChain
+	trustPeriod time.Duration
+	lightBlocks *lightBlockRequests
+
+	requestsCh <-chan bcv0.BlockRequest
+	errorsCh   <-chan bcv0.PeerError
+
+	metrics *Metrics
+}
+
+// ReactorOption sets an optional parameter on the BlockchainReactor.
+type ReactorOption func(*BlockchainReactor)
+
+// NewBlockchainReactor returns new reactor instance.
+func NewBlockchainReactor(state sm.State, blockExec *sm.BlockExecutor, store *store.BlockStore,
+	fastSync bool, options ...ReactorOption) *BlockchainReactor {
+
+	if state.LastBlockHeight != store.Height() {
+		panic(fmt.Sprintf("state (%v) and store (%v) height mismatch", state.LastBlockHeight,
+			store.Height()))
+	}
+
+	requestsCh := make(chan bcv0.BlockRequest, bcv0.MaxTotalRequesters)
+
+	const capacity = 1000                           // must be bigger than peers count
+	errorsCh := make(chan bcv0.PeerError, capacity) // so we don't block in #Receive#pool.AddBlock
+
+	startHeight := store.Height() + 1
+	if startHeight == 1 {
+		startHeight = state.InitialHeight
+	}
+	pool := bcv0.NewBlockPool(startHeight, requestsCh, errorsCh)
+
+	bcR := &BlockchainReactor{
+		initialState: state,
+		blockExec:    blockExec,
+		store:        store,
+		pool:         pool,
+		fastSync:     fastSync,
+		requestsCh:   requestsCh,
+		errorsCh:     errorsCh,
+		lightBlocks:  newLightBlockRequests(),
+		metrics:      NopMetrics(),
+	}
+	bcR.BaseReactor = *p2p.NewBaseReactor("BlockchainReactor", bcR)
+	for _, option := range options {
+		option(bcR)
+	}
+	return bcR
+}
+
+// ReactorMetrics sets the metrics.
+func ReactorMetrics(metrics *Metrics) ReactorOption {
+	return func(bcR *BlockchainReactor) { bcR.metrics = metrics }
+}
+
+// ReactorStateStore sets the state store, which the validators of the light
+// blocks served to the peers are loaded from.
+func ReactorStateStore(stateStore sm.Store) ReactorOption {
+	return func(bcR *BlockchainReactor) { bcR.stateStore = stateStore }
+}
+
+// ReactorVerifyHeadersFirst enables verifying the headers first, from a state
+// within the given trust period.
+func ReactorVerifyHeadersFirst(trustPeriod time.Duration) ReactorOption {
+	return func(bcR *BlockchainReactor) {
+		bcR.headers = newHeaderChain()
+		bcR.trustPeriod = trustPeriod
+	}
+}
+
+// SetLogger implements service.Service by setting the logger on reactor and pool.
+func (bcR *BlockchainReactor) SetLogger(l log.Logger) {
+	bcR.BaseService.Logger = l
+	bcR.pool.Logger = l
+}
+
+// OnStart implements service.Service.
+func (bcR *BlockchainReactor) OnStart() error {
+	if bcR.fastSync {
+		err := bcR.pool.Start()
+		if err != nil {
+			return err
+		}
+		go bcR.poolRoutine(false)
+	}
+	return nil
+}
+
+// SwitchToFastSync is called by the state sync reactor when switching to fast sync.
+func (bcR *BlockchainReactor) SwitchToFastSync(state sm.State) error {
+	bcR.fastSync = true
+	bcR.initialState = state
+
+	bcR.pool.SetHeight(state.LastBlockHeight + 1)
+	err := bcR.pool.Start()
+	if err != nil {
+		return err
+	}
+	go bcR.poolRoutine(true)
+	return nil
+}
+
+// OnStop implements service.Service.
+func (bcR *BlockchainReactor) OnStop() {
+	if bcR.fastSync {
+		if err := bcR.pool.Stop(); err != nil {
+			bcR.Logger.Error("Error stopping pool", "err", err)
+		}
+	}
+}
+
+// GetChannels implements Reactor
+func (bcR *BlockchainReactor) GetChannels() []*p2p.ChannelDescriptor {
+	return []*p2p.ChannelDescriptor{
+		{
+			ID:                  BlockchainChannel,
+			Priority:            5,
+			SendQueueCapacity:   1000,
+			RecvBufferCapacity:  50 * 4096,
+			RecvMessageCapacity: bc.MaxMsgSize,
+		},
+	}
+}
+
+// AddPeer implements Reactor by sending our state to peer.
+func (bcR *BlockchainReactor) AddPeer(peer p2p.Peer) {
+	msgBytes, err := bc.EncodeMsg(&bcproto.StatusResponse{
+		Base:   bcR.store.Base(),
+		Height: bcR.store.Height()})
+	if err != nil {
+		bcR.Logger.Error("could not convert msg to protobuf", "err", err)
+		return
+	}
+
+	peer.Send(BlockchainChannel, msgBytes)
+	// it's OK if send fails. will try later in poolRoutine
+
+	// peer is added to the pool once we receive the first
+	// bcStatusResponseMessage from the peer and call pool.SetPeerRange
+}
+
+// RemovePeer implements Reactor by removing peer from the pool.
+func (bcR *BlockchainReactor) RemovePeer(peer p2p.Peer, reason interface{}) {
+	bcR.pool.RemovePeer(peer.ID())
+	bcR.lightBlocks.cancel(peer.ID())
+}
+
+// respondToPeer loads a block and sends it to the requesting peer,
+// if we have it. Otherwise, we'll respond saying we don't have it.
+func (bcR *BlockchainReactor) respondToPeer(msg *bcproto.BlockRequest,
+	src p2p.Peer) (queued bool) {
+
+	block := bcR.store.LoadBlock(msg.Height)
+	if block != nil {
+		bl, err := block.ToProto()
+		if err != nil {
+			bcR.Logger.Error("could not convert msg to protobuf", "err", err)
+			return false
+		}
+
+		msgBytes, err := bc.EncodeMsg(&bcproto.BlockResponse{Block: bl})
+		if err != nil {
+			bcR.Logger.Error("could not marshal msg", "err", err)
+			return false
+		}
+
+		return src.TrySend(BlockchainChannel, msgBytes)
+	}
+
+	bcR.Logger.Info("Peer asking for a block we don't have", "src", src, "height", msg.Height)
+
+	msgBytes, err := bc.EncodeMsg(&bcproto.NoBlockResponse{Height: msg.Height})
+	if err != nil {
+		bcR.Logger.Error("could not convert msg to protobuf", "err", err)
+		return false
+	}
+
+	return src.TrySend(BlockchainChannel, msgBytes)
+}
+
+// Receive implements Reactor by handling 6 types of messages (look below).
+func (bcR *BlockchainReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
+	msg, err := bc.DecodeMsg(msgBytes)
+	if err != nil {
+		bcR.Logger.Error("Error decoding message", "src", src, "chId", chID, "err", err)
+		bcR.Switch.StopPeerForError(src, err)
+		return
+	}
+
+	if err = bc.ValidateMsg(msg); err != nil {
+		bcR.Logger.Error("Peer sent us invalid msg", "peer", src, "msg", msg, "err", err)
+		bcR.Switch.StopPeerForError(src, err)
+		return
+	}
+
+	bcR.Logger.Debug("Receive", "src", src, "chID", chID, "msg", msg)
+
+	switch msg := msg.(type) {
+	case *bcproto.BlockRequest:
+		bcR.respondToPeer(msg, src)
+	case *bcproto.BlockResponse:
+		bi, err := types.BlockFromProto(msg.Block)
+		if err != nil {
+			bcR.Logger.Error("Block content is invalid", "err", err)
+			return
+		}
+		bcR.pool.AddBlock(src.ID(), bi, len(msgBytes))
+	case *bcproto.StatusRequest:
+		// Send peer our state.
+		msgBytes, err := bc.EncodeMsg(&bcproto.StatusResponse{
+			Height: bcR.store.Height(),
+			Base:   bcR.store.Base(),
+		})
+		if err != nil {
+			bcR.Logger.Error("could not convert msg to protobut", "err", err)
+			return
+		}
+		src.TrySend(BlockchainChannel, msgBytes)
+	case *bcproto.StatusResponse:
+		// Got a peer status. Unverified.
+		bcR.pool.SetPeerRange(src.ID(), msg.Base, msg.Height)
+	case *bcproto.NoBlockResponse:
+		bcR.Logger.Debug("Peer does not have requested block", "peer", src, "height", msg.Height)
+	case *bcproto.LightBlockRequest:
+		bcR.respondLightBlockToPeer(msg, src)
+	case *bcproto.LightBlockResponse:
+		var lb *types.LightBlock
+		if msg.LightBlock != nil {
+			lb, err = types.LightBlockFromProto(msg.LightBlock)
+			if err != nil {
+				bcR.Logger.Error("Light block content is invalid", "err", err)
+				return
+			}
+		}
+		bcR.lightBlocks.respond(src.ID(), lb)
+	default:
+		bcR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
+	}
+}
+
+// Handle messages from the poolReactor telling the reactor what to do.
+// NOTE: Don't sleep in the FOR_LOOP or otherwise slow it down!
+func (bcR *BlockchainReactor) poolRoutine(stateSynced bool) {
+
+	trySyncTicker := time.NewTicker(trySyncIntervalMS * time.Millisecond)
+	defer trySyncTicker.Stop()
+
+	statusUpdateTicker := time.NewTicker(statusUpdateIntervalSeconds * time.Second)
+	defer statusUpdateTicker.Stop()
+
+	switchToConsensusTicker := time.NewTicker(switchToConsensusIntervalSeconds * time.Second)
+	defer switchToConsensusTicker.Stop()
+
+	blocksSynced := uint64(0)
+
+	chainID := bcR.initialState.ChainID
+	state := bcR.initialState
+
+	lastHundred := time.Now()
+	lastRate := 0.0
+	lastVerified := uint64(0)
+
+	// verify the commits of the blocks ahead until we're done
+	verifier := newVerifier(chainID, verificationWindow, bcR.metrics)
+	verifierQuit := make(chan struct{})
+	defer close(verifierQuit)
+	verifier.start(runtime.NumCPU(), verifierQuit)
+	sets, valsHash := knownValidatorSets(state), state.Validators.Hash()
+
+	didProcessCh := make(chan struct{}, 1)
+
+	if bcR.headers != nil {
+		go bcR.headersRoutine(state)
+	}
+
+	go func() {
+		for {
+			select {
+			case <-bcR.Quit():
+				return
+			case <-bcR.pool.Quit():
+				return
+			case request := <-bcR.requestsCh:
+				peer := bcR.Switch.Peers().Get(request.PeerID)
+				if peer == nil {
+					continue
+				}
+				msgBytes, err := bc.EncodeMsg(&bcproto.BlockRequest{Height: request.Height})
+				if err != nil {
+					bcR.Logger.Error("could not convert msg to proto", "err", err)
+					continue
+				}
+
+				queued := peer.TrySend(BlockchainChannel, msgBytes)
+				if !queued {
+					bcR.Logger.Debug("Send queue is full, drop block request", "peer", peer.ID(), "height", request.Height)
+				}
+			case err := <-bcR.errorsCh:
+				peer := bcR.Switch.Peers().Get(err.PeerID)
+				if peer != nil {
+					bcR.Switch.StopPeerForError(peer, err)
+				}
+
+			case <-statusUpdateTicker.C:
+				// ask for status updates
+				go bcR.BroadcastStatusRequest() // nolint: errcheck
+
+			}
+		}
+	}()
+
+FOR_LOOP:
+	for {
+		select {
+		case <-switchToConsensusTicker.C:
+			height, numPending, lenRequesters := bcR.pool.GetStatus()
+			outbound, inbound, _ := bcR.Switch.NumPeers()
+			bcR.Logger.Debug("Consensus ticker", "numPending", numPending, "total", lenRequesters,
+				"outbound", outbound, "inbound", inbound)
+			if bcR.pool.IsCaughtUp() && (bcR.headers == nil || bcR.headers.caughtUp(height)) {
+				bcR.Logger.Info("Time to switch to consensus reactor!", "height", height)
+				if err := bcR.pool.Stop(); err != nil {
+					bcR.Logger.Error("Error stopping pool", "err", err)
+				}
+				conR, ok := bcR.Switch.Reactor("CONSENSUS").(consensusReactor)
+				if ok {
+					conR.SwitchToConsensus(state, blocksSynced > 0 || stateSynced)
+				}
+				// else {
+				// should only happen during testing
+				// }
+
+				break FOR_LOOP
+			}
+
+		case <-trySyncTicker.C: // chan time
+			select {
+			case didProcessCh <- struct{}{}:
+			default:
+			}
+
+		case <-didProcessCh:
+			// NOTE: It is a subtle mistake to process more than a single block
+			// at a time (e.g. 10) here, because we only TrySend 1 request per
+			// loop.  The ratio mismatch can result in starving of blocks, a
+			// sudden burst of requests and responses, and repeat.
+			// Consequently, it is better to split these routines rather than
+			// coupling them as it's written here.  TODO uncouple from request
+			// routine.
+
+			// See if there are any blocks to sync.
+			blocks := bcR.pool.PeekBlocks(verificationWindow + 1)
+			if len(blocks) < 2 {
+				// We need both to sync the first block.
+				continue FOR_LOOP
+			} else {
+				// Try again quickly next loop.
+				didProcessCh <- struct{}{}
+			}
+
+			// Authenticate the first block by the verified headers if possible.
+			// Otherwise, verify the commits of the blocks ahead, then verify the
+			// first block using the second's commit.
+			first, second := blocks[0], blocks[1]
+			firstParts, firstID, authenticated, err := bcR.authenticateBlock(blocks)
+			if mismatch, ok := err.(errBlockMismatch); ok {
+				bcR.Logger.Error("Error in authentication", "err", err)
+				peerID := bcR.pool.RedoRequest(mismatch.height)
+				if peer := bcR.Switch.Peers().Get(peerID); peer != nil {
+					bcR.Switch.StopPeerForError(peer, fmt.Errorf("blockchainReactor validation error: %v", err))
+				}
+				continue FOR_LOOP
+			}
+			if authenticated {
+				// The hash of the second block only authenticates its header, so its
+				// last commit, which is saved as the seen commit of the first block
+				// and turned into votes when switching to consensus, is verified too.
+				err = second.LastCommit.ValidateBasic()
+				if err == nil {
+					err = state.Validators.VerifyCommit(chainID, firstID, first.Height, second.LastCommit)
+				}
+			} else {
+				verifier.schedule(sets, blocks)
+				firstParts, firstID, err = verifier.verify(state.Validators, valsHash, first, second)
+			}
+			if err != nil {
+				bcR.Logger.Error("Error in validation", "err", err)
+				peerID := bcR.pool.RedoRequest(first.Height)
+				peer := bcR.Switch.Peers().Get(peerID)
+				if peer != nil {
+					// NOTE: we've already removed the peer's request, but we
+					// still need to clean up the rest.
+					bcR.Switch.StopPeerForError(peer, fmt.Errorf("blockchainReactor validation error: %v", err))
+				}
+				peerID2 := bcR.pool.RedoRequest(second.Height)
+				peer2 := bcR.Switch.Peers().Get(peerID2)
+				if peer2 != nil && peer2 != peer {
+					// NOTE: we've already removed the peer's request, but we
+					// still need to clean up the rest.
+					bcR.Switch.StopPeerForError(peer2, fmt.Errorf("blockchainReactor validation error: %v", err))
+				}
+				continue FOR_LOOP
+			} else {
+				bcR.pool.PopRequest()
+
+				// TODO: batch saves so we dont persist to disk every block
+				bcR.store.SaveBlock(first, firstParts, second.LastCommit)
+
+				// TODO: same thing for app - but we would need a way to
+				// get the hash without persisting the state
+				start := time.Now()
+				state, _, err = bcR.blockExec.ApplyBlock(state, firstID, first)
+				if err != nil {
+					// TODO This is bad, are we zombie?
+					panic(fmt.Sprintf("Failed to process committed block (%d:%X): %v", first.Height, first.Hash(), err))
+				}
+				bcR.metrics.ApplyTime.Observe(time.Since(start).Seconds())
+				blocksSynced++
+				bcR.metrics.BlocksSynced.Add(1)
+				if authenticated {
+					bcR.headers.forget(first.Height)
+					bcR.metrics.AuthenticatedBlocks.Add(1)
+				}
+
+				// the validator sets only change with validator updates
+				valsHash = state.Validators.Hash()
+				if !sets.contains(valsHash, state.NextValidators.Hash()) {
+					sets = knownValidatorSets(state)
+				}
+
+				if blocksSynced%100 == 0 {
+					elapsed := time.Since(lastHundred).Seconds()
+					lastRate = 0.9*lastRate + 0.1*(100/elapsed)
+					verified := verifier.verified()
+					verificationRate := float64(verified-lastVerified) / elapsed
+					height, _, _ := bcR.pool.GetStatus()
+					bcR.Logger.Info("Fast Sync Rate", "height", height,
+						"max_peer_height", bcR.pool.MaxPeerHeight(), "blocks/s", lastRate,
+						"verified/s", verificationRate)
+					bcR.metrics.SyncRate.Set(lastRate)
+					bcR.metrics.VerificationRate.Set(verificationRate)
+					lastHundred = time.Now()
+					lastVerified = verified
+				}
+			}
+			continue FOR_LOOP
+
+		case <-bcR.Quit():
+			break FOR_LOOP
+		}
+	}
+}
+
+// BroadcastStatusRequest broadcasts `BlockStore` base and height.
+func (bcR *BlockchainReactor) BroadcastStatusRequest() error {
+	bm, err := bc.EncodeMsg(&bcproto.StatusRequest{})
+	if err != nil {
+		bcR.Logger.Error("could not convert msg to proto", "err", err)
+		return fmt.Errorf("could not convert msg to proto: %w", err)
+	}
+
+	bcR.Switch.Broadcast(BlockchainChannel, bm)
+
+	return nil
+}
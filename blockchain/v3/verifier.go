@@ -0,0 +1,186 @@
+package v3
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	sm "github.com/creatachain/augusteum/state"
+	"github.com/creatachain/augusteum/types"
+)
+
+// verification is the verification of the commit of a block by the
+// LastCommit of the next block, against a validator set.
+type verification struct {
+	first, second *types.Block
+	vals          *types.ValidatorSet
+	valsHash      string
+
+	// closed once the fields below are set
+	done    chan struct{}
+	parts   *types.PartSet
+	blockID types.BlockID
+	err     error
+}
+
+// run verifies the commit, once.
+func (vf *verification) run(chainID string) {
+	vf.parts, vf.blockID, vf.err = verifyCommit(chainID, vf.vals, vf.first, vf.second)
+	close(vf.done)
+}
+
+// verifyCommit verifies the first block using the second's commit.
+// NOTE: calling first.Hash() doesn't verify the tx contents, so MakePartSet()
+// is necessary.
+func verifyCommit(chainID string, vals *types.ValidatorSet,
+	first, second *types.Block) (*types.PartSet, types.BlockID, error) {
+
+	parts := first.MakePartSet(types.BlockPartSizeBytes)
+	blockID := types.BlockID{Hash: first.Hash(), PartSetHeader: parts.Header()}
+	err := vals.VerifyCommitLight(chainID, blockID, first.Height, second.LastCommit)
+	return parts, blockID, err
+}
+
+// validatorSets are the validator sets known at a height, by hash.
+type validatorSets map[string]*types.ValidatorSet
+
+// knownValidatorSets returns the validator sets of the state, which are shared
+// with the verification workers. The sets are copied since the total voting
+// power is computed lazily.
+func knownValidatorSets(state sm.State) validatorSets {
+	sets := make(validatorSets, 2)
+	for _, vals := range []*types.ValidatorSet{state.Validators, state.NextValidators} {
+		if vals.IsNilOrEmpty() {
+			continue
+		}
+		vals = vals.Copy()
+		vals.TotalVotingPower()
+		sets[string(vals.Hash())] = vals
+	}
+	return sets
+}
+
+// contains returns true if the sets contain the sets with the given hashes.
+func (sets validatorSets) contains(hashes ...[]byte) bool {
+	for _, hash := range hashes {
+		if _, ok := sets[string(hash)]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// verifier verifies the commits of a window of blocks ahead of the one being
+// applied, in parallel, against the validator sets known at the height being
+// applied. Each block claims the hash of the validator set which signed it, so
+// it is verified ahead if that set is known. The verification is only used
+// once the block is applied if its validator set is still the one of the
+// state, which ValidateBlock checks against the block anyway.
+type verifier struct {
+	chainID string
+	metrics *Metrics
+	jobs    chan *verification
+
+	mtx           sync.Mutex
+	verifications map[int64]*verification // by height of the first block
+
+	// atomic
+	numVerified uint64
+}
+
+func newVerifier(chainID string, window int, metrics *Metrics) *verifier {
+	return &verifier{
+		chainID:       chainID,
+		metrics:       metrics,
+		jobs:          make(chan *verification, window),
+		verifications: make(map[int64]*verification),
+	}
+}
+
+// start spawns the given number of workers, until quit is closed.
+func (v *verifier) start(workers int, quit <-chan struct{}) {
+	for i := 0; i < workers; i++ {
+		go v.workerRoutine(quit)
+	}
+}
+
+func (v *verifier) workerRoutine(quit <-chan struct{}) {
+	for {
+		select {
+		case <-quit:
+			return
+		case vf := <-v.jobs:
+			v.run(vf)
+		}
+	}
+}
+
+func (v *verifier) run(vf *verification) {
+	start := time.Now()
+	vf.run(v.chainID)
+	v.metrics.VerificationTime.Observe(time.Since(start).Seconds())
+	atomic.AddUint64(&v.numVerified, 1)
+}
+
+// schedule queues the verification of the commits of the consecutive blocks
+// whose validator set is known, unless they're already verified or queued.
+// It returns once the queue is full.
+func (v *verifier) schedule(sets validatorSets, blocks []*types.Block) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	for i := 0; i+1 < len(blocks); i++ {
+		first, second := blocks[i], blocks[i+1]
+		if vf := v.verifications[first.Height]; vf != nil && vf.first == first && vf.second == second {
+			continue
+		}
+		vals, ok := sets[string(first.ValidatorsHash)]
+		if !ok {
+			continue
+		}
+
+		vf := &verification{
+			first:    first,
+			second:   second,
+			vals:     vals,
+			valsHash: string(first.ValidatorsHash),
+			done:     make(chan struct{}),
+		}
+		select {
+		case v.jobs <- vf:
+			v.verifications[first.Height] = vf
+		default:
+			return
+		}
+	}
+}
+
+// verify verifies the first block using the second's commit against vals, the
+// validator set of the state. The result of the verification scheduled for
+// the blocks is used if any, and the commit is verified otherwise.
+func (v *verifier) verify(vals *types.ValidatorSet, valsHash []byte,
+	first, second *types.Block) (*types.PartSet, types.BlockID, error) {
+
+	v.mtx.Lock()
+	vf := v.verifications[first.Height]
+	delete(v.verifications, first.Height)
+	v.mtx.Unlock()
+
+	if vf != nil && vf.first == first && vf.second == second && vf.valsHash == string(valsHash) {
+		// the workers run until the pool routine returns
+		<-vf.done
+		v.metrics.PreverifiedBlocks.Add(1)
+		return vf.parts, vf.blockID, vf.err
+	}
+
+	start := time.Now()
+	parts, blockID, err := verifyCommit(v.chainID, vals, first, second)
+	v.metrics.VerificationTime.Observe(time.Since(start).Seconds())
+	atomic.AddUint64(&v.numVerified, 1)
+	return parts, blockID, err
+}
+
+// verified returns the number of commits verified so far.
+func (v *verifier) verified() uint64 {
+	return atomic.LoadUint64(&v.numVerified)
+}
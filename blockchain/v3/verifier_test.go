@@ -0,0 +1,80 @@
+package v3
+
+import (
+	"os"
+	"testing"
+
+	"github.com/go-kit/kit/metrics/generic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/creatachain/augusteum/config"
+	"github.com/creatachain/augusteum/libs/log"
+	"github.com/creatachain/augusteum/types"
+)
+
+func TestVerifier(t *testing.T) {
+	config = cfg.ResetTestRoot("blockchain_verifier_test")
+	defer os.RemoveAll(config.RootDir)
+	genDoc, privVals := randGenesisDoc(1, false, 30)
+
+	maxBlockHeight := int64(10)
+	pair := newBlockchainReactor(log.TestingLogger(), genDoc, privVals, maxBlockHeight)
+	defer func() {
+		require.NoError(t, pair.app.Stop())
+	}()
+
+	blockStore := pair.reactor.store
+	blocks := make([]*types.Block, maxBlockHeight)
+	for i := range blocks {
+		blocks[i] = blockStore.LoadBlock(int64(i + 1))
+	}
+	state := pair.reactor.initialState
+	sets := knownValidatorSets(state)
+	valsHash := state.Validators.Hash()
+	require.True(t, sets.contains(valsHash))
+
+	metrics := NopMetrics()
+	preverified := generic.NewCounter("preverified_blocks")
+	metrics.PreverifiedBlocks = preverified
+
+	v := newVerifier(state.ChainID, verificationWindow, metrics)
+	quit := make(chan struct{})
+	defer close(quit)
+	v.start(2, quit)
+	v.schedule(sets, blocks)
+
+	// all the commits but the one of the last block are verified ahead
+	for i := 0; i+1 < len(blocks); i++ {
+		_, blockID, err := v.verify(state.Validators, valsHash, blocks[i], blocks[i+1])
+		require.NoError(t, err)
+		assert.Equal(t, blockStore.LoadBlockMeta(blocks[i].Height).BlockID, blockID)
+	}
+	assert.EqualValues(t, maxBlockHeight-1, preverified.Value())
+	assert.EqualValues(t, maxBlockHeight-1, v.verified())
+
+	// the commit of a block replaced after being scheduled is verified again
+	v.schedule(sets, blocks[:2])
+	other := blockStore.LoadBlock(blocks[1].Height)
+	_, _, err := v.verify(state.Validators, valsHash, blocks[0], other)
+	require.NoError(t, err)
+	assert.EqualValues(t, maxBlockHeight-1, preverified.Value())
+
+	// the commits of the blocks of unknown validator sets are verified against
+	// the validator set of the state
+	otherGenDoc, _ := randGenesisDoc(1, false, 30)
+	otherVals := types.NewValidatorSet([]*types.Validator{
+		types.NewValidator(otherGenDoc.Validators[0].PubKey, otherGenDoc.Validators[0].Power),
+	})
+	v.schedule(validatorSets{}, blocks[:2])
+	_, _, err = v.verify(otherVals, otherVals.Hash(), blocks[0], blocks[1])
+	assert.Error(t, err)
+
+	// the verification ahead isn't used if the validator set of the state
+	// changed
+	v.schedule(sets, blocks[:2])
+	<-v.verifications[blocks[0].Height].done
+	_, _, err = v.verify(otherVals, otherVals.Hash(), blocks[0], blocks[1])
+	assert.Error(t, err)
+	assert.EqualValues(t, maxBlockHeight-1, preverified.Value())
+}
@@ -0,0 +1,198 @@
+package commands
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/creatachain/augusteum/p2p"
+	"github.com/creatachain/augusteum/p2p/pex"
+)
+
+// AddrBookCmd groups commands which inspect and modify the address book
+// offline. The node must not be running, since it periodically overwrites the
+// address book file.
+var AddrBookCmd = &cobra.Command{
+	Use:   "addrbook",
+	Short: "Inspect and maintain the node's address book (node must be stopped)",
+}
+
+var (
+	addrBookShowJSON     bool
+	addrBookPruneOlder   time.Duration
+	addrBookImportStrict bool
+)
+
+func init() {
+	addrBookShowCmd.Flags().BoolVar(&addrBookShowJSON, "json", false, "print addresses as JSON")
+	addrBookPruneCmd.Flags().DurationVar(&addrBookPruneOlder, "older-than", 0,
+		"also remove addresses not dialed or verified within this period (0 keeps them)")
+	addrBookImportCmd.Flags().BoolVar(&addrBookImportStrict, "strict", true,
+		"reject non-routable addresses (overrides addr_book_strict)")
+
+	AddrBookCmd.AddCommand(addrBookShowCmd)
+	AddrBookCmd.AddCommand(addrBookImportCmd)
+	AddrBookCmd.AddCommand(addrBookPruneCmd)
+}
+
+var addrBookShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Print address book entries and statistics",
+	RunE:  showAddrBook,
+}
+
+var addrBookImportCmd = &cobra.Command{
+	Use:   "import [file]",
+	Short: "Import addresses from another address book or a list of id@host:port lines",
+	Args:  cobra.ExactArgs(1),
+	RunE:  importAddrBook,
+}
+
+var addrBookPruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Remove bad and, optionally, stale addresses from the address book",
+	RunE:  pruneAddrBook,
+}
+
+func loadAddrBook(strict bool) (pex.AddrBook, error) {
+	book, err := pex.LoadAddrBook(config.P2P.AddrBookFile(), strict)
+	if err != nil {
+		return nil, err
+	}
+	book.SetLogger(logger.With("book", config.P2P.AddrBookFile()))
+	return book, nil
+}
+
+func showAddrBook(cmd *cobra.Command, args []string) error {
+	book, err := loadAddrBook(config.P2P.AddrBookStrict)
+	if err != nil {
+		return err
+	}
+
+	infos := book.KnownAddresses()
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Addr.String() < infos[j].Addr.String()
+	})
+
+	if addrBookShowJSON {
+		bz, err := json.MarshalIndent(infos, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(bz))
+		return nil
+	}
+
+	var nOld, nVerified int
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ADDRESS\tBUCKET\tATTEMPTS\tLAST SUCCESS\tLAST VERIFIED\tLATENCY\tVERSION")
+	for _, info := range infos {
+		if info.BucketType == "old" {
+			nOld++
+		}
+		if !info.LastVerified.IsZero() {
+			nVerified++
+		}
+		fmt.Fprintf(w, "%v\t%s\t%d\t%s\t%s\t%v\t%s\n",
+			info.Addr, info.BucketType, info.Attempts, formatAddrBookTime(info.LastSuccess),
+			formatAddrBookTime(info.LastVerified), info.Latency, info.Version)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\ntotal: %d, old: %d, new: %d, verified: %d\n",
+		len(infos), nOld, len(infos)-nOld, nVerified)
+	return nil
+}
+
+func formatAddrBookTime(t time.Time) string {
+	if t.IsZero() {
+		return "never"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func importAddrBook(cmd *cobra.Command, args []string) error {
+	addrs, err := readImportAddrs(args[0])
+	if err != nil {
+		return err
+	}
+
+	book, err := loadAddrBook(addrBookImportStrict)
+	if err != nil {
+		return err
+	}
+
+	imported := 0
+	for _, addr := range addrs {
+		// imported addresses are their own source, like inbound peers
+		if err := book.AddAddress(addr, addr); err != nil {
+			logger.Info("Skipping address", "addr", addr, "err", err)
+			continue
+		}
+		imported++
+	}
+	book.Save()
+
+	logger.Info("Imported addresses", "imported", imported, "total", len(addrs), "size", book.Size())
+	return nil
+}
+
+// readImportAddrs reads addresses either from another node's address book
+// file or from a file with one id@host:port address per line.
+func readImportAddrs(file string) ([]*p2p.NetAddress, error) {
+	if _, err := os.Stat(file); err != nil {
+		return nil, err
+	}
+
+	if other, err := pex.LoadAddrBook(file, false); err == nil {
+		infos := other.KnownAddresses()
+		addrs := make([]*p2p.NetAddress, 0, len(infos))
+		for _, info := range infos {
+			addrs = append(addrs, info.Addr)
+		}
+		return addrs, nil
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var addrs []*p2p.NetAddress
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		addr, err := p2p.NewNetAddressString(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %q: %w", line, err)
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, scanner.Err()
+}
+
+func pruneAddrBook(cmd *cobra.Command, args []string) error {
+	book, err := loadAddrBook(config.P2P.AddrBookStrict)
+	if err != nil {
+		return err
+	}
+
+	pruned := book.Prune(addrBookPruneOlder)
+	book.Save()
+
+	logger.Info("Pruned address book", "pruned", pruned, "size", book.Size())
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -16,13 +17,36 @@ import (
 	rpchttp "github.com/creatachain/augusteum/rpc/client/http"
 )
 
+var (
+	since       time.Duration
+	walMessages int
+	logFile     string
+	logLines    int
+
+	flagSince       = "since"
+	flagWALMessages = "wal-messages"
+	flagLogFile     = "log-file"
+	flagLogLines    = "log-lines"
+)
+
+// number of transactions whose hash is included in the mempool summary
+const mempoolTxHashes = 100
+
 var dumpCmd = &cobra.Command{
 	Use:   "dump [output-directory]",
 	Short: "Continuously poll a Augusteum process and dump debugging data into a single location",
 	Long: `Continuously poll a Augusteum process and dump debugging data into a single
 location at a specified frequency. At each frequency interval, an archived and compressed
-file will contain node debugging information including the goroutine and heap profiles
-if enabled.`,
+file will contain node debugging information including the goroutine, heap and mutex
+profiles if enabled, the last consensus WAL messages decoded, a mempool summary, the
+address book, the configuration and the last lines of the log file if given.
+
+With --since, the data is collected once, and packaged into a single archive along
+with the dumps written to the output directory within the time window. The WAL
+messages and the log lines are then the ones written within the time window.
+
+Example:
+$ augusteum debug dump /path/to/dumps --since 1h --log-file /var/log/augusteum.log`,
 	Args: cobra.ExactArgs(1),
 	RunE: dumpCmdHandler,
 }
@@ -41,6 +65,34 @@ func init() {
 		"",
 		"the profiling server address (<host>:<port>)",
 	)
+
+	dumpCmd.Flags().DurationVar(
+		&since,
+		flagSince,
+		0,
+		"package the data and the dumps of this time window (e.g. 1h) into a single archive, and exit",
+	)
+
+	dumpCmd.Flags().IntVar(
+		&walMessages,
+		flagWALMessages,
+		1000,
+		"the number of the last consensus WAL messages to decode",
+	)
+
+	dumpCmd.Flags().StringVar(
+		&logFile,
+		flagLogFile,
+		"",
+		"the file the Augusteum process logs to",
+	)
+
+	dumpCmd.Flags().IntVar(
+		&logLines,
+		flagLogLines,
+		1000,
+		"the number of the last lines of the log file to include",
+	)
 }
 
 func dumpCmdHandler(_ *cobra.Command, args []string) error {
@@ -53,6 +105,10 @@ func dumpCmdHandler(_ *cobra.Command, args []string) error {
 		return errors.New("frequency must be positive")
 	}
 
+	if since < 0 {
+		return errors.New("since must be positive")
+	}
+
 	if _, err := os.Stat(outDir); os.IsNotExist(err) {
 		if err := os.Mkdir(outDir, os.ModePerm); err != nil {
 			return fmt.Errorf("failed to create output directory: %w", err)
@@ -69,6 +125,10 @@ func dumpCmdHandler(_ *cobra.Command, args []string) error {
 	conf = conf.SetRoot(home)
 	cfg.EnsureRoot(conf.RootDir)
 
+	if since > 0 {
+		return dumpSince(outDir, conf, rpc, since)
+	}
+
 	dumpDebugData(outDir, conf, rpc)
 
 	ticker := time.NewTicker(time.Duration(frequency) * time.Second)
@@ -89,46 +149,168 @@ func dumpDebugData(outDir string, conf *cfg.Config, rpc *rpchttp.HTTP) {
 	}
 	defer os.RemoveAll(tmpDir)
 
+	if err := collectDebugData(tmpDir, conf, rpc, time.Time{}); err != nil {
+		return
+	}
+
+	outFile := filepath.Join(outDir, fmt.Sprintf("%s.zip", start.Format(time.RFC3339)))
+	if err := zipDir(tmpDir, outFile); err != nil {
+		logger.Error("failed to create and compress archive", "file", outFile, "error", err)
+	}
+}
+
+// dumpSince packages the debug data collected now, with the WAL messages and
+// the log lines written since the start of the time window, and the dumps
+// written to outDir within the time window into a single archive.
+func dumpSince(outDir string, conf *cfg.Config, rpc *rpchttp.HTTP, window time.Duration) error {
+	start := time.Now().UTC()
+	cutoff := start.Add(-window)
+
+	tmpDir, err := ioutil.TempDir(outDir, "augusteum_debug_tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	if err := collectDebugData(tmpDir, conf, rpc, cutoff); err != nil {
+		return err
+	}
+
+	dumps, err := listDumps(outDir, cutoff)
+	if err != nil {
+		return fmt.Errorf("failed to list dumps: %w", err)
+	}
+	logger.Info("extracting previous dumps...", "count", len(dumps))
+	for _, dump := range dumps {
+		if err := unzipFile(dump, filepath.Join(tmpDir, "dumps")); err != nil {
+			return fmt.Errorf("failed to extract dump %s: %w", dump, err)
+		}
+	}
+
+	outFile := filepath.Join(outDir, fmt.Sprintf("%s_since_%s.zip", start.Format(time.RFC3339), window))
+	logger.Info("archiving and compressing debug directory...", "file", outFile)
+	return zipDir(tmpDir, outFile)
+}
+
+// collectDebugData writes the node debugging data into dir. The WAL messages
+// and the log lines are the last ones written at or after since. It returns
+// an error if the node data can't be collected.
+func collectDebugData(dir string, conf *cfg.Config, rpc *rpchttp.HTTP, since time.Time) error {
 	logger.Info("getting node status...")
-	if err := dumpStatus(rpc, tmpDir, "status.json"); err != nil {
+	if err := dumpStatus(rpc, dir, "status.json"); err != nil {
 		logger.Error("failed to dump node status", "error", err)
-		return
+		return err
 	}
 
 	logger.Info("getting node network info...")
-	if err := dumpNetInfo(rpc, tmpDir, "net_info.json"); err != nil {
+	if err := dumpNetInfo(rpc, dir, "net_info.json"); err != nil {
 		logger.Error("failed to dump node network info", "error", err)
-		return
+		return err
 	}
 
 	logger.Info("getting node consensus state...")
-	if err := dumpConsensusState(rpc, tmpDir, "consensus_state.json"); err != nil {
+	if err := dumpConsensusState(rpc, dir, "consensus_state.json"); err != nil {
 		logger.Error("failed to dump node consensus state", "error", err)
-		return
+		return err
+	}
+
+	logger.Info("getting node mempool...")
+	if err := dumpMempool(rpc, dir, "mempool.json", mempoolTxHashes); err != nil {
+		logger.Error("failed to dump node mempool", "error", err)
+		return err
 	}
 
 	logger.Info("copying node WAL...")
-	if err := copyWAL(conf, tmpDir); err != nil {
+	if err := copyWAL(conf, dir); err != nil {
 		logger.Error("failed to copy node WAL", "error", err)
-		return
+		return err
+	}
+
+	logger.Info("copying node configuration...")
+	if err := copyConfig(conf.RootDir, dir); err != nil {
+		logger.Error("failed to copy node configuration", "error", err)
+		return err
+	}
+
+	// a corrupted WAL tail is part of the debugging data, so the messages
+	// before it are still dumped
+	logger.Info("decoding node WAL messages...")
+	msgs, err := tailWAL(conf.Consensus.WalFile(), walMessages, since)
+	if err != nil {
+		logger.Error("failed to decode node WAL", "error", err)
+	}
+	if err := writeWALTail(msgs, dir, "wal_tail.json"); err != nil {
+		logger.Error("failed to dump node WAL messages", "error", err)
+		return err
+	}
+
+	// the address book only exists when PEX is enabled
+	logger.Info("copying node address book...")
+	if err := copyAddrBook(conf, dir); err != nil {
+		logger.Error("failed to copy node address book", "error", err)
+	}
+
+	if logFile != "" {
+		logger.Info("getting node log lines...")
+		lines, err := tailLog(logFile, logLines, since)
+		if err != nil {
+			logger.Error("failed to read node log", "error", err)
+			return err
+		}
+		var content strings.Builder
+		for _, line := range lines {
+			content.WriteString(line)
+			content.WriteByte('\n')
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, "node.log"), []byte(content.String()), os.ModePerm); err != nil {
+			logger.Error("failed to dump node log", "error", err)
+			return err
+		}
 	}
 
 	if profAddr != "" {
 		logger.Info("getting node goroutine profile...")
-		if err := dumpProfile(tmpDir, profAddr, "goroutine", 2); err != nil {
+		if err := dumpProfile(dir, profAddr, "goroutine", 2); err != nil {
 			logger.Error("failed to dump goroutine profile", "error", err)
-			return
+			return err
 		}
 
 		logger.Info("getting node heap profile...")
-		if err := dumpProfile(tmpDir, profAddr, "heap", 2); err != nil {
+		if err := dumpProfile(dir, profAddr, "heap", 2); err != nil {
 			logger.Error("failed to dump heap profile", "error", err)
-			return
+			return err
+		}
+
+		logger.Info("getting node mutex profile...")
+		if err := dumpProfile(dir, profAddr, "mutex", 1); err != nil {
+			logger.Error("failed to dump mutex profile", "error", err)
+			return err
 		}
 	}
 
-	outFile := filepath.Join(outDir, fmt.Sprintf("%s.zip", start.Format(time.RFC3339)))
-	if err := zipDir(tmpDir, outFile); err != nil {
-		logger.Error("failed to create and compress archive", "file", outFile, "error", err)
+	return nil
+}
+
+// listDumps returns the archives written by dump to dir at or after since,
+// oldest first.
+func listDumps(dir string, since time.Time) ([]string, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// the names of the archives are their UTC time, so they sort by time
+	var dumps []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || filepath.Ext(name) != ".zip" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, strings.TrimSuffix(name, ".zip"))
+		if err != nil || t.Before(since) {
+			continue
+		}
+		dumps = append(dumps, filepath.Join(dir, name))
 	}
+	return dumps, nil
 }
@@ -71,6 +71,57 @@ func zipDir(src, dest string) error {
 
 }
 
+// unzipFile extracts all the contents of the zip file src into the directory
+// dest. It returns an error upon failure, or if an entry would be extracted
+// outside of dest.
+func unzipFile(src, dest string) error {
+	zipReader, err := zip.OpenReader(src)
+	if err != nil {
+		return err
+	}
+	defer zipReader.Close()
+
+	for _, file := range zipReader.File {
+		target := filepath.Join(dest, file.Name) // nolint: gosec
+		if !strings.HasPrefix(target, filepath.Clean(dest)+string(os.PathSeparator)) {
+			return fmt.Errorf("invalid file path %s in %s", file.Name, src)
+		}
+
+		if file.FileInfo().IsDir() {
+			if err := os.MkdirAll(target, os.ModePerm); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := os.MkdirAll(filepath.Dir(target), os.ModePerm); err != nil {
+			return err
+		}
+		if err := extractZipFile(file, target); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func extractZipFile(file *zip.File, dest string) error {
+	reader, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	destFile, err := os.OpenFile(dest, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, file.Mode())
+	if err != nil {
+		return err
+	}
+	defer destFile.Close()
+
+	_, err = io.Copy(destFile, reader) // nolint: gosec
+	return err
+}
+
 // copyFile copies a file from src to dest and returns an error upon failure. The
 // copied file retains the source file's permissions.
 func copyFile(src, dest string) error {
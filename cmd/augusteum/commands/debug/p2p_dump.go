@@ -0,0 +1,156 @@
+package debug
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+
+	bcv0 "github.com/creatachain/augusteum/blockchain/v0"
+	cs "github.com/creatachain/augusteum/consensus"
+	"github.com/creatachain/augusteum/evidence"
+	mempl "github.com/creatachain/augusteum/mempool"
+	"github.com/creatachain/augusteum/p2p"
+	"github.com/creatachain/augusteum/p2p/pex"
+	bcproto "github.com/creatachain/augusteum/proto/augusteum/blockchain"
+	tmcons "github.com/creatachain/augusteum/proto/augusteum/consensus"
+	memproto "github.com/creatachain/augusteum/proto/augusteum/mempool"
+	tmp2p "github.com/creatachain/augusteum/proto/augusteum/p2p"
+	ssproto "github.com/creatachain/augusteum/proto/augusteum/statesync"
+	tmproto "github.com/creatachain/augusteum/proto/augusteum/types"
+	"github.com/creatachain/augusteum/statesync"
+)
+
+var (
+	p2pDumpPeer    string
+	p2pDumpChannel int
+	p2pDumpJSON    bool
+	p2pDumpVerbose bool
+
+	flagPeer    = "peer"
+	flagChannel = "channel"
+	flagJSON    = "json"
+	flagVerbose = "verbose"
+)
+
+var p2pDumpCmd = &cobra.Command{
+	Use:   "p2p-dump [capture-file]",
+	Short: "Decode the p2p messages captured by a Augusteum node",
+	Long: `Decode the p2p messages captured by a Augusteum node with p2p.capture_file set.
+Each captured envelope is printed with its time, direction, peer, channel, size
+and message type, decoded using the reactor protobuf types.`,
+	Args: cobra.ExactArgs(1),
+	RunE: p2pDumpCmdHandler,
+}
+
+func init() {
+	p2pDumpCmd.Flags().StringVar(&p2pDumpPeer, flagPeer, "", "only show messages exchanged with this peer ID")
+	p2pDumpCmd.Flags().IntVar(&p2pDumpChannel, flagChannel, -1, "only show messages on this channel ID")
+	p2pDumpCmd.Flags().BoolVar(&p2pDumpJSON, flagJSON, false, "print envelopes and decoded messages as JSON")
+	p2pDumpCmd.Flags().BoolVar(&p2pDumpVerbose, flagVerbose, false, "also print the decoded messages")
+}
+
+// channelMessages maps the channels of the built-in reactors to the type of
+// their messages. All fast sync versions share the same message type.
+var channelMessages = map[byte]func() proto.Message{
+	pex.PexChannel:            func() proto.Message { return &tmp2p.Message{} },
+	cs.StateChannel:           func() proto.Message { return &tmcons.Message{} },
+	cs.DataChannel:            func() proto.Message { return &tmcons.Message{} },
+	cs.VoteChannel:            func() proto.Message { return &tmcons.Message{} },
+	cs.VoteSetBitsChannel:     func() proto.Message { return &tmcons.Message{} },
+	mempl.MempoolChannel:      func() proto.Message { return &memproto.Message{} },
+	evidence.EvidenceChannel:  func() proto.Message { return &tmproto.EvidenceList{} },
+	bcv0.BlockchainChannel:    func() proto.Message { return &bcproto.Message{} },
+	statesync.SnapshotChannel: func() proto.Message { return &ssproto.Message{} },
+	statesync.ChunkChannel:    func() proto.Message { return &ssproto.Message{} },
+}
+
+// decodedEnvelope is a captured envelope with its decoded message.
+type decodedEnvelope struct {
+	Time      time.Time     `json:"time"`
+	Peer      p2p.ID        `json:"peer"`
+	Direction string        `json:"direction"`
+	ChannelID byte          `json:"channel_id"`
+	Size      int           `json:"size"`
+	Type      string        `json:"type"`
+	Msg       proto.Message `json:"msg,omitempty"`
+	Error     string        `json:"error,omitempty"`
+}
+
+func p2pDumpCmdHandler(_ *cobra.Command, args []string) error {
+	return p2p.ReadCapture(args[0], func(env p2p.CaptureEnvelope) error {
+		if p2pDumpPeer != "" && string(env.Peer) != p2pDumpPeer {
+			return nil
+		}
+		if p2pDumpChannel >= 0 && int(env.ChannelID) != p2pDumpChannel {
+			return nil
+		}
+
+		denv := decodeEnvelope(env)
+		if p2pDumpJSON {
+			bz, err := json.Marshal(denv)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(bz))
+			return nil
+		}
+
+		fmt.Printf("%s %s %s %#x %dB %s\n", denv.Time.UTC().Format(time.RFC3339Nano),
+			denv.Direction, denv.Peer, denv.ChannelID, denv.Size, denv.Type)
+		if denv.Error != "" {
+			fmt.Printf("\terror: %s\n", denv.Error)
+		} else if p2pDumpVerbose {
+			fmt.Printf("\t%v\n", denv.Msg)
+		}
+		return nil
+	})
+}
+
+// decodeEnvelope decodes the message of env using the type of its channel.
+// Messages which can't be decoded are reported with an error instead.
+func decodeEnvelope(env p2p.CaptureEnvelope) decodedEnvelope {
+	denv := decodedEnvelope{
+		Time:      env.Time,
+		Peer:      env.Peer,
+		Direction: env.Direction,
+		ChannelID: env.ChannelID,
+		Size:      env.Size,
+		Type:      "unknown",
+	}
+
+	newMsg, ok := channelMessages[env.ChannelID]
+	if !ok {
+		denv.Error = fmt.Sprintf("unknown channel %#x", env.ChannelID)
+		return denv
+	}
+	msg := newMsg()
+	if err := proto.Unmarshal(env.Msg, msg); err != nil {
+		denv.Error = fmt.Sprintf("failed to decode %s: %v", proto.MessageName(msg), err)
+		return denv
+	}
+	denv.Msg = msg
+	denv.Type = messageType(msg)
+	return denv
+}
+
+// messageType returns the name of the message, or, for the oneof Message
+// wrappers of the reactors, the name of the wrapped message.
+func messageType(msg proto.Message) string {
+	sum := reflect.ValueOf(msg).Elem().FieldByName("Sum")
+	if !sum.IsValid() || sum.IsNil() {
+		return proto.MessageName(msg)
+	}
+	// sum holds a pointer to a oneof wrapper struct with a single field
+	wrapper := sum.Elem().Elem()
+	if wrapper.Kind() != reflect.Struct || wrapper.NumField() != 1 {
+		return proto.MessageName(msg)
+	}
+	if inner, ok := wrapper.Field(0).Interface().(proto.Message); ok {
+		return proto.MessageName(inner)
+	}
+	return proto.MessageName(msg)
+}
@@ -0,0 +1,129 @@
+package debug
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	cs "github.com/creatachain/augusteum/consensus"
+	tmjson "github.com/creatachain/augusteum/libs/json"
+)
+
+const (
+	// the time format of the plain log lines, e.g. I[2006-01-02|15:04:05.000]
+	plainLogTimeFormat = "2006-01-02|15:04:05.000"
+
+	maxLogLineSize = 1024 * 1024
+)
+
+// tailWAL decodes the last n messages of the WAL group with the given head
+// written at or after since. The messages decoded before an error, e.g. a
+// corrupted tail, are returned along with it.
+func tailWAL(head string, n int, since time.Time) ([]*cs.TimedWALMessage, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+
+	ring := make([]*cs.TimedWALMessage, n)
+	count := 0
+	err := readWAL(head, func(msg *cs.TimedWALMessage) error {
+		if msg.Time.Before(since) {
+			return nil
+		}
+		ring[count%n] = msg
+		count++
+		return nil
+	})
+
+	if count <= n {
+		return ring[:count], err
+	}
+	return append(ring[count%n:], ring[:count%n]...), err
+}
+
+// writeWALTail encodes the WAL messages to JSON, one per line, and writes
+// them to file. It returns an error upon failure.
+func writeWALTail(msgs []*cs.TimedWALMessage, dir, filename string) error {
+	var sb strings.Builder
+	for _, msg := range msgs {
+		bz, err := tmjson.Marshal(msg)
+		if err != nil {
+			return fmt.Errorf("failed to marshal msg: %w", err)
+		}
+		sb.Write(bz)
+		sb.WriteByte('\n')
+	}
+
+	return ioutil.WriteFile(path.Join(dir, filename), []byte(sb.String()), os.ModePerm)
+}
+
+// tailLog returns the last n lines of the log file written at or after
+// since. The lines without a time, e.g. the ones of a stack trace, go with the
+// previous line.
+func tailLog(logPath string, n int, since time.Time) ([]string, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+
+	f, err := os.Open(logPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var (
+		ring  = make([]string, n)
+		count = 0
+		keep  = true
+	)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), maxLogLineSize)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if t, ok := logLineTime(line); ok {
+			keep = !t.Before(since)
+		}
+		if !keep {
+			continue
+		}
+		ring[count%n] = line
+		count++
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if count <= n {
+		return ring[:count], nil
+	}
+	return append(ring[count%n:], ring[:count%n]...), nil
+}
+
+// logLineTime returns the time of a log line in either the plain or the JSON
+// log format, and false if the line has no time.
+func logLineTime(line string) (time.Time, bool) {
+	if strings.HasPrefix(line, "{") {
+		var entry struct {
+			TS time.Time `json:"ts"`
+		}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil || entry.TS.IsZero() {
+			return time.Time{}, false
+		}
+		return entry.TS, true
+	}
+
+	end := len(plainLogTimeFormat) + 2
+	if len(line) <= end || line[1] != '[' || line[end] != ']' {
+		return time.Time{}, false
+	}
+	t, err := time.ParseInLocation(plainLogTimeFormat, line[2:end], time.Local)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
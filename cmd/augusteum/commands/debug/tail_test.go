@@ -0,0 +1,112 @@
+package debug
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cs "github.com/creatachain/augusteum/consensus"
+)
+
+func TestTailWAL(t *testing.T) {
+	head := filepath.Join(t.TempDir(), "wal")
+	writeWALFile(t, head+".000", 1, 2)
+	writeWALFile(t, head, 3, 4)
+
+	msgs, err := tailWAL(head, 4, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, msgs, 4)
+	// the last message of height 3, then the messages of height 4
+	assert.Equal(t, cs.EndHeightMessage{Height: 3}, msgs[0].Msg)
+	assert.Equal(t, cs.EndHeightMessage{Height: 4}, msgs[3].Msg)
+
+	msgs, err = tailWAL(head, 100, time.Time{})
+	require.NoError(t, err)
+	assert.Len(t, msgs, 12)
+
+	// no message was written after now
+	msgs, err = tailWAL(head, 100, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, msgs)
+}
+
+func TestTailLog(t *testing.T) {
+	now := time.Now()
+	old := now.Add(-time.Hour)
+	logPath := filepath.Join(t.TempDir(), "node.log")
+	lines := []string{
+		"I[" + old.Format(plainLogTimeFormat) + "] old line",
+		"\tstack trace of the old line",
+		"I[" + now.Format(plainLogTimeFormat) + "] new line",
+		"\tstack trace of the new line",
+		`{"_msg":"new json line","ts":"` + now.UTC().Format(time.RFC3339Nano) + `"}`,
+	}
+	require.NoError(t, ioutil.WriteFile(logPath, []byte(strings.Join(lines, "\n")+"\n"), 0600))
+
+	tail, err := tailLog(logPath, 2, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, lines[3:], tail)
+
+	tail, err = tailLog(logPath, 100, now.Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, lines[2:], tail)
+
+	_, err = tailLog(filepath.Join(t.TempDir(), "missing.log"), 100, time.Time{})
+	assert.Error(t, err)
+}
+
+func TestLogLineTime(t *testing.T) {
+	utc := time.Date(2021, 6, 7, 12, 34, 56, 789000000, time.UTC)
+	local := time.Date(2021, 6, 7, 12, 34, 56, 789000000, time.Local)
+
+	testCases := []struct {
+		line string
+		time time.Time
+		ok   bool
+	}{
+		{"I[2021-06-07|12:34:56.789] Executed block    module=state height=1", local, true},
+		{`{"_msg":"Executed block","level":"info","ts":"2021-06-07T12:34:56.789Z"}`, utc, true},
+		{"goroutine 1 [running]:", time.Time{}, false},
+		{"I[not a time] msg", time.Time{}, false},
+		{`{"_msg":"no time"}`, time.Time{}, false},
+		{"", time.Time{}, false},
+	}
+	for _, tc := range testCases {
+		tm, ok := logLineTime(tc.line)
+		assert.Equal(t, tc.ok, ok, tc.line)
+		assert.True(t, tc.time.Equal(tm), tc.line)
+	}
+}
+
+func TestListAndExtractDumps(t *testing.T) {
+	outDir := t.TempDir()
+	now := time.Now().UTC()
+
+	for _, tm := range []time.Time{now.Add(-2 * time.Hour), now.Add(-time.Minute), now} {
+		src := t.TempDir()
+		require.NoError(t, ioutil.WriteFile(filepath.Join(src, "status.json"), []byte("{}"), 0600))
+		require.NoError(t, zipDir(src, filepath.Join(outDir, tm.Format(time.RFC3339)+".zip")))
+	}
+	// not dumps
+	require.NoError(t, ioutil.WriteFile(filepath.Join(outDir, "other.zip"), nil, 0600))
+	require.NoError(t, os.Mkdir(filepath.Join(outDir, "augusteum_debug_tmp"), 0700))
+
+	dumps, err := listDumps(outDir, now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Len(t, dumps, 2)
+	assert.Equal(t, filepath.Join(outDir, now.Add(-time.Minute).Format(time.RFC3339)+".zip"), dumps[0])
+
+	dest := filepath.Join(t.TempDir(), "dumps")
+	for _, dump := range dumps {
+		require.NoError(t, unzipFile(dump, dest))
+	}
+	for _, tm := range []time.Time{now.Add(-time.Minute), now} {
+		assert.FileExists(t, filepath.Join(dest, tm.Format(time.RFC3339), "status.json"))
+	}
+}
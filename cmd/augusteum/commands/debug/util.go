@@ -46,6 +46,35 @@ func dumpConsensusState(rpc *rpchttp.HTTP, dir, filename string) error {
 	return writeStateJSONToFile(consDump, dir, filename)
 }
 
+// mempoolSummary is the size of the mempool, along with the hashes of the
+// first transactions.
+type mempoolSummary struct {
+	Count      int      `json:"n_txs"`
+	TotalBytes int64    `json:"total_bytes"`
+	TxHashes   []string `json:"tx_hashes"`
+}
+
+// dumpMempool gets a summary of the mempool from the Augusteum RPC, with the
+// hashes of at most limit transactions, and writes it to file. It returns an
+// error upon failure.
+func dumpMempool(rpc *rpchttp.HTTP, dir, filename string, limit int) error {
+	res, err := rpc.UnconfirmedTxs(context.Background(), &limit)
+	if err != nil {
+		return fmt.Errorf("failed to get node mempool: %w", err)
+	}
+
+	summary := mempoolSummary{
+		Count:      res.Total,
+		TotalBytes: res.TotalBytes,
+		TxHashes:   make([]string, len(res.Txs)),
+	}
+	for i, tx := range res.Txs {
+		summary.TxHashes[i] = fmt.Sprintf("%X", tx.Hash())
+	}
+
+	return writeStateJSONToFile(summary, dir, filename)
+}
+
 // copyWAL copies the Augusteum node's WAL file. It returns an error if the
 // WAL file cannot be read or copied.
 func copyWAL(conf *cfg.Config, dir string) error {
@@ -55,6 +84,15 @@ func copyWAL(conf *cfg.Config, dir string) error {
 	return copyFile(walPath, filepath.Join(dir, walFile))
 }
 
+// copyAddrBook copies the Augusteum node's address book. It returns an error
+// if the address book cannot be read or copied.
+func copyAddrBook(conf *cfg.Config, dir string) error {
+	addrBookPath := conf.P2P.AddrBookFile()
+	addrBookFile := filepath.Base(addrBookPath)
+
+	return copyFile(addrBookPath, filepath.Join(dir, addrBookFile))
+}
+
 // copyConfig copies the Augusteum node's config file. It returns an error if
 // the config file cannot be read or copied.
 func copyConfig(home, dir string) error {
@@ -0,0 +1,316 @@
+package debug
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	cs "github.com/creatachain/augusteum/consensus"
+	tmjson "github.com/creatachain/augusteum/libs/json"
+)
+
+var (
+	walListMessages bool
+	walHeight       int64
+
+	flagMessages = "messages"
+	flagHeight   = "height"
+)
+
+var walCmd = &cobra.Command{
+	Use:   "wal",
+	Short: "Inspect and repair the consensus WAL of a stopped Augusteum node",
+	Long: `Inspect and repair the consensus write-ahead log (WAL) of a Augusteum node.
+The WAL path is the one of consensus.wal_file, e.g. $AUGUSTEUMHOME/data/cs.wal/wal.
+Rotated files of the WAL (wal.000, wal.001, ...) are read along with it. The
+node must not be running while the WAL is truncated or repaired.`,
+}
+
+var walListCmd = &cobra.Command{
+	Use:   "list [wal-file]",
+	Short: "List the heights, and optionally the messages, of the WAL",
+	Args:  cobra.ExactArgs(1),
+	RunE:  walListCmdHandler,
+}
+
+var walJSONCmd = &cobra.Command{
+	Use:   "json [wal-file]",
+	Short: "Decode the messages of the WAL to JSON, one per line",
+	Args:  cobra.ExactArgs(1),
+	RunE:  walJSONCmdHandler,
+}
+
+var walTruncateCmd = &cobra.Command{
+	Use:   "truncate [wal-file]",
+	Short: "Remove the messages of the WAL after the end of the given height",
+	Long: `Remove the messages of the WAL after the end of the given height, so that the
+node replays consensus from the following height. The original WAL files are
+kept with a .bak suffix.`,
+	Args: cobra.ExactArgs(1),
+	RunE: walTruncateCmdHandler,
+}
+
+var walVerifyCmd = &cobra.Command{
+	Use:   "verify [wal-file]",
+	Short: "Check the checksums and encoding of every message of the WAL",
+	Args:  cobra.ExactArgs(1),
+	RunE:  walVerifyCmdHandler,
+}
+
+var walRepairCmd = &cobra.Command{
+	Use:   "repair [wal-file]",
+	Short: "Remove the corrupted tail of the WAL",
+	Long: `Remove every message of the WAL from the first corrupted one onwards. The
+original WAL files are kept with a .bak suffix.`,
+	Args: cobra.ExactArgs(1),
+	RunE: walRepairCmdHandler,
+}
+
+func init() {
+	walListCmd.Flags().BoolVar(&walListMessages, flagMessages, false, "also list the messages of every height")
+	walTruncateCmd.Flags().Int64Var(&walHeight, flagHeight, 0, "last height to keep in the WAL")
+	_ = walTruncateCmd.MarkFlagRequired(flagHeight)
+
+	walCmd.AddCommand(walListCmd)
+	walCmd.AddCommand(walJSONCmd)
+	walCmd.AddCommand(walTruncateCmd)
+	walCmd.AddCommand(walVerifyCmd)
+	walCmd.AddCommand(walRepairCmd)
+}
+
+func walListCmdHandler(_ *cobra.Command, args []string) error {
+	var (
+		height int64 = -1 // before the first EndHeightMessage
+		count  int
+	)
+	err := readWAL(args[0], func(msg *cs.TimedWALMessage) error {
+		if end, ok := msg.Msg.(cs.EndHeightMessage); ok {
+			if height >= 0 {
+				fmt.Printf("height %d: %d messages\n", height, count)
+			}
+			height, count = end.Height+1, 0
+			return nil
+		}
+		count++
+		if walListMessages {
+			fmt.Printf("\t%s %s\n", msg.Time.UTC().Format(time.RFC3339Nano), walMessageType(msg.Msg))
+		}
+		return nil
+	})
+	if height >= 0 {
+		fmt.Printf("height %d: %d messages (in progress)\n", height, count)
+	}
+	return err
+}
+
+func walJSONCmdHandler(_ *cobra.Command, args []string) error {
+	return readWAL(args[0], func(msg *cs.TimedWALMessage) error {
+		bz, err := tmjson.Marshal(msg)
+		if err != nil {
+			return fmt.Errorf("failed to marshal msg: %w", err)
+		}
+		fmt.Println(string(bz))
+		return nil
+	})
+}
+
+func walTruncateCmdHandler(_ *cobra.Command, args []string) error {
+	n, err := truncateWAL(args[0], walHeight)
+	if err != nil {
+		return err
+	}
+	logger.Info("truncated WAL", "height", walHeight, "removed", n)
+	return nil
+}
+
+func walVerifyCmdHandler(_ *cobra.Command, args []string) error {
+	n := 0
+	err := readWAL(args[0], func(*cs.TimedWALMessage) error {
+		n++
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("WAL is corrupted after %d valid messages: %w", n, err)
+	}
+	logger.Info("WAL is valid", "messages", n)
+	return nil
+}
+
+func walRepairCmdHandler(_ *cobra.Command, args []string) error {
+	kept, err := repairWAL(args[0])
+	if err != nil {
+		return err
+	}
+	logger.Info("repaired WAL", "kept", kept)
+	return nil
+}
+
+// walFiles returns the files of the WAL group with the given head, oldest
+// first. The head, which is written last, is always the last file.
+func walFiles(head string) ([]string, error) {
+	if _, err := os.Stat(head); err != nil {
+		return nil, err
+	}
+	matches, err := filepath.Glob(head + ".*")
+	if err != nil {
+		return nil, err
+	}
+	indexed := regexp.MustCompile(`\.[0-9]{3,}$`)
+	files := make([]string, 0, len(matches)+1)
+	for _, match := range matches {
+		if indexed.MatchString(match) {
+			files = append(files, match)
+		}
+	}
+	// indexes are zero padded, so sorting the names sorts the indexes unless
+	// there are more than 1000 files
+	sort.Slice(files, func(i, j int) bool {
+		if len(files[i]) != len(files[j]) {
+			return len(files[i]) < len(files[j])
+		}
+		return files[i] < files[j]
+	})
+	return append(files, head), nil
+}
+
+// readWAL decodes the messages of the WAL group with the given head in order,
+// calling fn for each one. It stops at the first message which can't be
+// decoded, returning a cs.DataCorruptionError.
+func readWAL(head string, fn func(*cs.TimedWALMessage) error) error {
+	files, err := walFiles(head)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if err := readWALFile(file, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readWALFile(path string, fn func(*cs.TimedWALMessage) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	dec := cs.NewWALDecoder(f)
+	for {
+		msg, err := dec.Decode()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		if err := fn(msg); err != nil {
+			return err
+		}
+	}
+}
+
+// truncateWAL rewrites the WAL group with the given head so that it ends with
+// the EndHeightMessage of the given height. It returns the number of messages
+// removed.
+func truncateWAL(head string, height int64) (int, error) {
+	var (
+		kept    []*cs.TimedWALMessage
+		found   bool
+		removed int
+	)
+	err := readWAL(head, func(msg *cs.TimedWALMessage) error {
+		if found {
+			removed++
+			return nil
+		}
+		kept = append(kept, msg)
+		if end, ok := msg.Msg.(cs.EndHeightMessage); ok && end.Height == height {
+			found = true
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, fmt.Errorf("end of height %d not found in WAL", height)
+	}
+	return removed, rewriteWAL(head, kept)
+}
+
+// repairWAL rewrites the WAL group with the given head without the messages
+// from the first corrupted one onwards. It returns the number of messages
+// kept. A WAL which is not corrupted is left untouched.
+func repairWAL(head string) (int, error) {
+	var kept []*cs.TimedWALMessage
+	err := readWAL(head, func(msg *cs.TimedWALMessage) error {
+		kept = append(kept, msg)
+		return nil
+	})
+	var corruption cs.DataCorruptionError
+	switch {
+	case err == nil:
+		return len(kept), nil
+	case !errors.As(err, &corruption):
+		return 0, err
+	}
+	logger.Info("found corrupted WAL message", "err", err)
+	return len(kept), rewriteWAL(head, kept)
+}
+
+// rewriteWAL replaces the WAL group with the given head by a single head file
+// holding msgs. The original files are kept with a .bak suffix.
+func rewriteWAL(head string, msgs []*cs.TimedWALMessage) error {
+	files, err := walFiles(head)
+	if err != nil {
+		return err
+	}
+
+	tmp := head + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	enc := cs.NewWALEncoder(f)
+	for _, msg := range msgs {
+		if err := enc.Encode(msg); err != nil {
+			f.Close()
+			return fmt.Errorf("failed to encode msg: %w", err)
+		}
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if err := os.Rename(file, file+".bak"); err != nil {
+			return err
+		}
+	}
+	return os.Rename(tmp, head)
+}
+
+// walMessageType returns the type of a WAL message, including the type of the
+// consensus message wrapped by a msgInfo.
+func walMessageType(msg cs.WALMessage) string {
+	v := reflect.ValueOf(msg)
+	if v.Kind() == reflect.Struct {
+		if inner := v.FieldByName("Msg"); inner.IsValid() && inner.CanInterface() {
+			return fmt.Sprintf("%T(%T)", msg, inner.Interface())
+		}
+	}
+	return fmt.Sprintf("%T", msg)
+}
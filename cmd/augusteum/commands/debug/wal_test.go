@@ -0,0 +1,99 @@
+package debug
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cs "github.com/creatachain/augusteum/consensus"
+	"github.com/creatachain/augusteum/types"
+)
+
+// writeWALFile writes a WAL file with the messages of the given heights, each
+// closed by its EndHeightMessage.
+func writeWALFile(t *testing.T, path string, heights ...int64) {
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	enc := cs.NewWALEncoder(f)
+	for _, h := range heights {
+		for _, msg := range []cs.WALMessage{
+			types.EventDataRoundState{Height: h, Round: 0, Step: "RoundStepPropose"},
+			types.EventDataRoundState{Height: h, Round: 0, Step: "RoundStepCommit"},
+			cs.EndHeightMessage{Height: h},
+		} {
+			require.NoError(t, enc.Encode(&cs.TimedWALMessage{Time: time.Now(), Msg: msg}))
+		}
+	}
+}
+
+func walEndHeights(t *testing.T, head string) []int64 {
+	var heights []int64
+	require.NoError(t, readWAL(head, func(msg *cs.TimedWALMessage) error {
+		if end, ok := msg.Msg.(cs.EndHeightMessage); ok {
+			heights = append(heights, end.Height)
+		}
+		return nil
+	}))
+	return heights
+}
+
+func TestReadWALGroup(t *testing.T) {
+	head := filepath.Join(t.TempDir(), "wal")
+	writeWALFile(t, head+".000", 1, 2)
+	writeWALFile(t, head+".001", 3)
+	writeWALFile(t, head, 4)
+
+	assert.Equal(t, []int64{1, 2, 3, 4}, walEndHeights(t, head))
+}
+
+func TestTruncateWAL(t *testing.T) {
+	head := filepath.Join(t.TempDir(), "wal")
+	writeWALFile(t, head+".000", 1, 2)
+	writeWALFile(t, head, 3, 4)
+
+	removed, err := truncateWAL(head, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 6, removed)
+	assert.Equal(t, []int64{1, 2}, walEndHeights(t, head))
+
+	// the original files are backed up, and the rotated one is gone
+	assert.FileExists(t, head+".bak")
+	assert.FileExists(t, head+".000.bak")
+	assert.NoFileExists(t, head+".000")
+
+	_, err = truncateWAL(head, 5)
+	assert.Error(t, err)
+}
+
+func TestRepairWAL(t *testing.T) {
+	head := filepath.Join(t.TempDir(), "wal")
+	writeWALFile(t, head, 1, 2)
+
+	// a valid WAL is left untouched
+	kept, err := repairWAL(head)
+	require.NoError(t, err)
+	assert.Equal(t, 6, kept)
+	assert.NoFileExists(t, head+".bak")
+
+	// corrupt the tail
+	f, err := os.OpenFile(head, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.Write([]byte("not a WAL message"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	err = readWAL(head, func(*cs.TimedWALMessage) error { return nil })
+	require.Error(t, err)
+
+	kept, err = repairWAL(head)
+	require.NoError(t, err)
+	assert.Equal(t, 6, kept)
+	assert.Equal(t, []int64{1, 2}, walEndHeights(t, head))
+	assert.FileExists(t, head+".bak")
+}
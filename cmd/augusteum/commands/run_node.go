@@ -40,6 +40,9 @@ func AddNodeFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64("consensus.double_sign_check_height", config.Consensus.DoubleSignCheckHeight,
 		"how many blocks to look back to check existence of the node's "+
 			"consensus votes before joining consensus")
+	cmd.Flags().Bool("consensus.double_sign_guard", config.Consensus.DoubleSignGuard,
+		"do not sign until a full height passes without the node's validator key "+
+			"voting on the network; set to false to override")
 
 	// msm flags
 	cmd.Flags().String(
@@ -61,6 +64,7 @@ func AddNodeFlags(cmd *cobra.Command) {
 	cmd.Flags().String("rpc.pprof_laddr", config.RPC.PprofListenAddress, "pprof listen address (https://golang.org/pkg/net/http/pprof)")
 
 	// p2p flags
+	cmd.Flags().String("p2p.mode", config.P2P.Mode, "node role in a sentry topology: full, sentry or validator")
 	cmd.Flags().String(
 		"p2p.laddr",
 		config.P2P.ListenAddress,
@@ -73,6 +77,8 @@ func AddNodeFlags(cmd *cobra.Command) {
 	cmd.Flags().Bool("p2p.pex", config.P2P.PexReactor, "enable/disable Peer-Exchange")
 	cmd.Flags().Bool("p2p.seed_mode", config.P2P.SeedMode, "enable/disable seed mode")
 	cmd.Flags().String("p2p.private_peer_ids", config.P2P.PrivatePeerIDs, "comma-delimited private peer IDs")
+	cmd.Flags().String("p2p.validator_peer_ids", config.P2P.ValidatorPeerIDs,
+		"comma-delimited IDs of the validators behind this sentry")
 
 	// consensus flags
 	cmd.Flags().Bool(
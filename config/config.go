@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -204,9 +206,23 @@ type BaseConfig struct { //nolint: maligned
 	PrivValidatorState string `mapstructure:"priv_validator_state_file"`
 
 	// TCP or UNIX socket address for Augusteum to listen on for
-	// connections from an external PrivValidator process
+	// connections from an external PrivValidator process, or grpc:// address
+	// of a remote signer for Augusteum to connect to
 	PrivValidatorListenAddr string `mapstructure:"priv_validator_laddr"`
 
+	// Number of signers which must produce the same signature when
+	// PrivValidatorListenAddr holds several addresses. 0 means a majority.
+	PrivValidatorQuorum int `mapstructure:"priv_validator_quorum"`
+
+	// Path to the certificate and key Augusteum authenticates with to a remote
+	// signer with a grpc:// address
+	PrivValidatorClientCertificate string `mapstructure:"priv_validator_client_certificate_file"`
+	PrivValidatorClientKey         string `mapstructure:"priv_validator_client_key_file"`
+
+	// Path to the root CA the certificate of a remote signer with a grpc://
+	// address must be signed by
+	PrivValidatorRootCA string `mapstructure:"priv_validator_root_ca_file"`
+
 	// A JSON file containing the private key to use for p2p authenticated encryption
 	NodeKey string `mapstructure:"node_key_file"`
 
@@ -266,6 +282,24 @@ func (cfg BaseConfig) PrivValidatorStateFile() string {
 	return rootify(cfg.PrivValidatorState, cfg.RootDir)
 }
 
+// PrivValidatorClientCertificateFile returns the full path to the certificate
+// used to connect to a gRPC remote signer
+func (cfg BaseConfig) PrivValidatorClientCertificateFile() string {
+	return rootify(cfg.PrivValidatorClientCertificate, cfg.RootDir)
+}
+
+// PrivValidatorClientKeyFile returns the full path to the key used to connect
+// to a gRPC remote signer
+func (cfg BaseConfig) PrivValidatorClientKeyFile() string {
+	return rootify(cfg.PrivValidatorClientKey, cfg.RootDir)
+}
+
+// PrivValidatorRootCAFile returns the full path to the root CA of a gRPC
+// remote signer
+func (cfg BaseConfig) PrivValidatorRootCAFile() string {
+	return rootify(cfg.PrivValidatorRootCA, cfg.RootDir)
+}
+
 // NodeKeyFile returns the full path to the node_key.json file
 func (cfg BaseConfig) NodeKeyFile() string {
 	return rootify(cfg.NodeKey, cfg.RootDir)
@@ -284,6 +318,28 @@ func (cfg BaseConfig) ValidateBasic() error {
 	default:
 		return errors.New("unknown log_format (must be 'plain' or 'json')")
 	}
+	if strings.HasPrefix(cfg.PrivValidatorListenAddr, "grpc://") {
+		if cfg.PrivValidatorClientCertificate == "" || cfg.PrivValidatorClientKey == "" ||
+			cfg.PrivValidatorRootCA == "" {
+			return errors.New("priv_validator_client_certificate_file, priv_validator_client_key_file and " +
+				"priv_validator_root_ca_file are required with a grpc:// priv_validator_laddr")
+		}
+	}
+	if cfg.PrivValidatorQuorum < 0 {
+		return errors.New("priv_validator_quorum can't be negative")
+	}
+	if strings.Contains(cfg.PrivValidatorListenAddr, ",") {
+		addrs := strings.Split(cfg.PrivValidatorListenAddr, ",")
+		for _, addr := range addrs {
+			if strings.HasPrefix(strings.TrimSpace(addr), "grpc://") {
+				return errors.New("grpc:// addresses can't be combined in priv_validator_laddr")
+			}
+		}
+		if cfg.PrivValidatorQuorum > len(addrs) {
+			return fmt.Errorf("priv_validator_quorum must not exceed the %d addresses of priv_validator_laddr",
+				len(addrs))
+		}
+	}
 	return nil
 }
 
@@ -310,7 +366,8 @@ type RPCConfig struct {
 	CORSAllowedHeaders []string `mapstructure:"cors_allowed_headers"`
 
 	// TCP or UNIX socket address for the gRPC server to listen on
-	// NOTE: This server only supports /broadcast_tx_commit
+	// NOTE: This server supports /broadcast_tx_commit, the queries of the RPC
+	// (blocks, txs, validators, status, ...) and streaming events
 	GRPCListenAddress string `mapstructure:"grpc_laddr"`
 
 	// Maximum number of simultaneous connections.
@@ -348,6 +405,50 @@ type RPCConfig struct {
 	// See https://github.com/creatachain/augusteum/issues/3435
 	TimeoutBroadcastTxCommit time.Duration `mapstructure:"timeout_broadcast_tx_commit"`
 
+	// How long the events are retained by the event log read by /events. 0
+	// disables the event log.
+	EventLogWindowSize time.Duration `mapstructure:"event_log_window_size"`
+
+	// Maximum number of events retained by the event log. 0 means no limit
+	// other than the window size.
+	EventLogMaxItems int `mapstructure:"event_log_max_items"`
+
+	// Number of results of /block, /block_results, /commit and /validators
+	// for past heights kept in memory, as they never change. 0 disables the
+	// cache.
+	ResponseCacheSize int `mapstructure:"response_cache_size"`
+
+	// Maximum number of blocks returned by /blocks_range.
+	MaxBlocksRange int `mapstructure:"max_blocks_range"`
+
+	// Maximum total size of the blocks returned by /blocks_range, in bytes. At
+	// least one block is returned, whatever its size.
+	MaxBlocksRangeBytes int64 `mapstructure:"max_blocks_range_bytes"`
+
+	// Number of calls per second each client IP can make to the RPC server
+	// (HTTP&WebSocket), on average. 0 means no limit.
+	RateLimit float64 `mapstructure:"rate_limit"`
+
+	// Number of calls a client IP can make at once, before being limited to
+	// rate_limit calls per second.
+	RateLimitBurst float64 `mapstructure:"rate_limit_burst"`
+
+	// Number of calls each call to a method counts for in the rate limits, as
+	// "method=cost" entries. Methods not listed cost 1.
+	MethodCosts []string `mapstructure:"method_costs"`
+
+	// If not empty, only these methods can be called.
+	AllowedMethods []string `mapstructure:"allowed_methods"`
+
+	// Methods which can't be called.
+	DeniedMethods []string `mapstructure:"denied_methods"`
+
+	// API keys clients can send in the X-API-Key header, which replace the rate
+	// limit of their IP with the quota of the key, as "key=rate:burst" entries.
+	// A rate of 0 means no limit. Browser clients need X-API-Key in
+	// cors_allowed_headers.
+	APIKeys []string `mapstructure:"api_keys"`
+
 	// Maximum size of request body, in bytes
 	MaxBodyBytes int64 `mapstructure:"max_body_bytes"`
 
@@ -393,6 +494,21 @@ func DefaultRPCConfig() *RPCConfig {
 		MaxSubscriptionsPerClient: 5,
 		TimeoutBroadcastTxCommit:  10 * time.Second,
 
+		EventLogWindowSize: 30 * time.Second,
+		EventLogMaxItems:   0,
+
+		ResponseCacheSize: 100,
+
+		MaxBlocksRange:      20,
+		MaxBlocksRangeBytes: 10485760, // 10MB
+
+		RateLimit:      0,
+		RateLimitBurst: 20,
+		MethodCosts:    []string{"tx_search=10", "blockchain=5"},
+		AllowedMethods: []string{},
+		DeniedMethods:  []string{},
+		APIKeys:        []string{},
+
 		MaxBodyBytes:   int64(1000000), // 1MB
 		MaxHeaderBytes: 1 << 20,        // same as the net/http default
 
@@ -428,6 +544,33 @@ func (cfg *RPCConfig) ValidateBasic() error {
 	if cfg.TimeoutBroadcastTxCommit < 0 {
 		return errors.New("timeout_broadcast_tx_commit can't be negative")
 	}
+	if cfg.EventLogWindowSize < 0 {
+		return errors.New("event_log_window_size can't be negative")
+	}
+	if cfg.EventLogMaxItems < 0 {
+		return errors.New("event_log_max_items can't be negative")
+	}
+	if cfg.ResponseCacheSize < 0 {
+		return errors.New("response_cache_size can't be negative")
+	}
+	if cfg.MaxBlocksRange <= 0 {
+		return errors.New("max_blocks_range must be positive")
+	}
+	if cfg.MaxBlocksRangeBytes <= 0 {
+		return errors.New("max_blocks_range_bytes must be positive")
+	}
+	if cfg.RateLimit < 0 {
+		return errors.New("rate_limit can't be negative")
+	}
+	if cfg.RateLimit > 0 && cfg.RateLimitBurst < 1 {
+		return errors.New("rate_limit_burst must be at least 1 when rate_limit is set")
+	}
+	if _, err := cfg.MethodCostMap(); err != nil {
+		return fmt.Errorf("method_costs: %w", err)
+	}
+	if _, err := cfg.APIKeyQuotas(); err != nil {
+		return fmt.Errorf("api_keys: %w", err)
+	}
 	if cfg.MaxBodyBytes < 0 {
 		return errors.New("max_body_bytes can't be negative")
 	}
@@ -437,6 +580,70 @@ func (cfg *RPCConfig) ValidateBasic() error {
 	return nil
 }
 
+// RPCQuota is the rate, in calls per second, and burst of an API key.
+type RPCQuota struct {
+	Rate  float64
+	Burst float64
+}
+
+// MethodCostMap parses MethodCosts.
+func (cfg *RPCConfig) MethodCostMap() (map[string]float64, error) {
+	costs := make(map[string]float64, len(cfg.MethodCosts))
+	for _, entry := range cfg.MethodCosts {
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid entry %q, expected method=cost", entry)
+		}
+		method, cost := parts[0], parts[1]
+		c, err := strconv.ParseFloat(cost, 64)
+		if err != nil || c < 0 {
+			return nil, fmt.Errorf("invalid cost of method %s: %q", method, cost)
+		}
+		costs[method] = c
+	}
+	return costs, nil
+}
+
+// APIKeyQuotas parses APIKeys.
+func (cfg *RPCConfig) APIKeyQuotas() (map[string]RPCQuota, error) {
+	quotas := make(map[string]RPCQuota, len(cfg.APIKeys))
+	for i, entry := range cfg.APIKeys {
+		// the keys are secrets, so they're not part of the errors
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid entry #%d, expected key=rate:burst", i)
+		}
+		key := parts[0]
+		parts = strings.SplitN(parts[1], ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid quota of entry #%d, expected rate:burst", i)
+		}
+		rate, burst := parts[0], parts[1]
+		var (
+			q   RPCQuota
+			err error
+		)
+		if q.Rate, err = strconv.ParseFloat(rate, 64); err != nil || q.Rate < 0 {
+			return nil, fmt.Errorf("invalid rate of entry #%d: %q", i, rate)
+		}
+		if q.Burst, err = strconv.ParseFloat(burst, 64); err != nil || (q.Rate > 0 && q.Burst < 1) {
+			return nil, fmt.Errorf("invalid burst of entry #%d: %q", i, burst)
+		}
+		if _, ok := quotas[key]; ok {
+			return nil, fmt.Errorf("duplicate entry #%d", i)
+		}
+		quotas[key] = q
+	}
+	return quotas, nil
+}
+
+// IsAccessControlEnabled returns true if the calls to the RPC server are rate
+// limited or restricted to some methods.
+func (cfg *RPCConfig) IsAccessControlEnabled() bool {
+	return cfg.RateLimit > 0 || len(cfg.AllowedMethods) > 0 || len(cfg.DeniedMethods) > 0 ||
+		len(cfg.APIKeys) > 0
+}
+
 // IsCorsEnabled returns true if cross-origin resource sharing is enabled.
 func (cfg *RPCConfig) IsCorsEnabled() bool {
 	return len(cfg.CORSAllowedOrigins) != 0
@@ -465,10 +672,25 @@ func (cfg RPCConfig) IsTLSEnabled() bool {
 //-----------------------------------------------------------------------------
 // P2PConfig
 
+const (
+	// P2PModeFull is the default mode, in which the node takes part in the
+	// network like any other peer.
+	P2PModeFull = "full"
+	// P2PModeSentry shields the validators listed in validator_peer_ids: they
+	// are always accepted and never gossiped.
+	P2PModeSentry = "sentry"
+	// P2PModeValidator only connects to the sentries listed in
+	// persistent_peers and never gossips its own address.
+	P2PModeValidator = "validator"
+)
+
 // P2PConfig defines the configuration options for the Augusteum peer-to-peer networking layer
 type P2PConfig struct { //nolint: maligned
 	RootDir string `mapstructure:"home"`
 
+	// Role of the node in a sentry topology: "full", "sentry" or "validator"
+	Mode string `mapstructure:"mode"`
+
 	// Address to listen for incoming connections
 	ListenAddress string `mapstructure:"laddr"`
 
@@ -477,8 +699,13 @@ type P2PConfig struct { //nolint: maligned
 
 	// Comma separated list of seed nodes to connect to
 	// We only use these if we can’t connect to peers in the addrbook
+	// Entries of the form dns://<name> are resolved via the TXT records of
+	// <name> (id@host:port lists) and SRV records of _augusteum._tcp.<name>
 	Seeds string `mapstructure:"seeds"`
 
+	// How often DNS seeds are resolved again
+	DNSSeedRefreshPeriod time.Duration `mapstructure:"dns_seed_refresh_period"`
+
 	// Comma separated list of nodes to keep persistent connections to
 	PersistentPeers string `mapstructure:"persistent_peers"`
 
@@ -529,6 +756,10 @@ type P2PConfig struct { //nolint: maligned
 	// other peers)
 	PrivatePeerIDs string `mapstructure:"private_peer_ids"`
 
+	// Comma separated list of the IDs of the validators behind this sentry.
+	// They are private and unconditional peers. Only used in sentry mode.
+	ValidatorPeerIDs string `mapstructure:"validator_peer_ids"`
+
 	// Toggle to disable guard against peers connecting from the same ip.
 	AllowDuplicateIP bool `mapstructure:"allow_duplicate_ip"`
 
@@ -536,6 +767,14 @@ type P2PConfig struct { //nolint: maligned
 	HandshakeTimeout time.Duration `mapstructure:"handshake_timeout"`
 	DialTimeout      time.Duration `mapstructure:"dial_timeout"`
 
+	// Path to the file capturing all messages exchanged with peers, for
+	// debugging purposes. Capturing is disabled if empty.
+	CaptureFile string `mapstructure:"capture_file"`
+
+	// Maximum total size of the capture files, in bytes. The oldest files are
+	// removed once it's exceeded. 0 means unlimited.
+	CaptureMaxSize int64 `mapstructure:"capture_max_size"`
+
 	// Testing params.
 	// Force dial to fail
 	TestDialFail bool `mapstructure:"test_dial_fail"`
@@ -547,9 +786,11 @@ type P2PConfig struct { //nolint: maligned
 // DefaultP2PConfig returns a default configuration for the peer-to-peer layer
 func DefaultP2PConfig() *P2PConfig {
 	return &P2PConfig{
+		Mode:                         P2PModeFull,
 		ListenAddress:                "tcp://0.0.0.0:26656",
 		ExternalAddress:              "",
 		UPNP:                         false,
+		DNSSeedRefreshPeriod:         30 * time.Minute,
 		AddrBook:                     defaultAddrBookPath,
 		AddrBookStrict:               true,
 		MaxNumInboundPeers:           40,
@@ -564,6 +805,8 @@ func DefaultP2PConfig() *P2PConfig {
 		AllowDuplicateIP:             false,
 		HandshakeTimeout:             20 * time.Second,
 		DialTimeout:                  3 * time.Second,
+		CaptureFile:                  "",
+		CaptureMaxSize:               1024 * 1024 * 1024, // 1 GB
 		TestDialFail:                 false,
 		TestFuzz:                     false,
 		TestFuzzConfig:               DefaultFuzzConnConfig(),
@@ -584,9 +827,38 @@ func (cfg *P2PConfig) AddrBookFile() string {
 	return rootify(cfg.AddrBook, cfg.RootDir)
 }
 
+// PexEnabled returns true if the peer-exchange reactor should run. It's always
+// disabled in validator mode, so that the validator never gossips its address.
+func (cfg *P2PConfig) PexEnabled() bool {
+	return cfg.PexReactor && cfg.Mode != P2PModeValidator
+}
+
+// CaptureFilePath returns the full path to the p2p capture file, or an empty
+// string if capturing is disabled.
+func (cfg *P2PConfig) CaptureFilePath() string {
+	if cfg.CaptureFile == "" {
+		return ""
+	}
+	return rootify(cfg.CaptureFile, cfg.RootDir)
+}
+
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *P2PConfig) ValidateBasic() error {
+	switch cfg.Mode {
+	case P2PModeFull:
+	case P2PModeSentry:
+		if cfg.ValidatorPeerIDs == "" {
+			return errors.New("validator_peer_ids can't be empty in sentry mode")
+		}
+	case P2PModeValidator:
+		if cfg.PersistentPeers == "" {
+			return errors.New("persistent_peers must list the sentries in validator mode")
+		}
+	default:
+		return fmt.Errorf("unknown mode %q, expected %q, %q or %q",
+			cfg.Mode, P2PModeFull, P2PModeSentry, P2PModeValidator)
+	}
 	if cfg.MaxNumInboundPeers < 0 {
 		return errors.New("max_num_inbound_peers can't be negative")
 	}
@@ -596,6 +868,9 @@ func (cfg *P2PConfig) ValidateBasic() error {
 	if cfg.FlushThrottleTimeout < 0 {
 		return errors.New("flush_throttle_timeout can't be negative")
 	}
+	if cfg.DNSSeedRefreshPeriod < 0 {
+		return errors.New("dns_seed_refresh_period can't be negative")
+	}
 	if cfg.PersistentPeersMaxDialPeriod < 0 {
 		return errors.New("persistent_peers_max_dial_period can't be negative")
 	}
@@ -608,6 +883,9 @@ func (cfg *P2PConfig) ValidateBasic() error {
 	if cfg.RecvRate < 0 {
 		return errors.New("recv_rate can't be negative")
 	}
+	if cfg.CaptureMaxSize < 0 {
+		return errors.New("capture_max_size can't be negative")
+	}
 	return nil
 }
 
@@ -784,12 +1062,18 @@ func (cfg *StateSyncConfig) ValidateBasic() error {
 // FastSyncConfig defines the configuration for the Augusteum fast sync service
 type FastSyncConfig struct {
 	Version string `mapstructure:"version"`
+
+	// Verify the headers up to the network tip with the light client before
+	// fetching the blocks (v3 only)
+	VerifyHeadersFirst bool          `mapstructure:"verify_headers_first"`
+	TrustPeriod        time.Duration `mapstructure:"trust_period"`
 }
 
 // DefaultFastSyncConfig returns a default configuration for the fast sync service
 func DefaultFastSyncConfig() *FastSyncConfig {
 	return &FastSyncConfig{
-		Version: "v0",
+		Version:     "v0",
+		TrustPeriod: 168 * time.Hour,
 	}
 }
 
@@ -801,15 +1085,18 @@ func TestFastSyncConfig() *FastSyncConfig {
 // ValidateBasic performs basic validation.
 func (cfg *FastSyncConfig) ValidateBasic() error {
 	switch cfg.Version {
-	case "v0":
-		return nil
-	case "v1":
-		return nil
-	case "v2":
-		return nil
+	case "v0", "v1", "v2":
+		if cfg.VerifyHeadersFirst {
+			return fmt.Errorf("verify_headers_first is not supported by fastsync version %s", cfg.Version)
+		}
+	case "v3":
+		if cfg.VerifyHeadersFirst && cfg.TrustPeriod <= 0 {
+			return errors.New("trust_period is required to verify the headers first")
+		}
 	default:
 		return fmt.Errorf("unknown fastsync version %s", cfg.Version)
 	}
+	return nil
 }
 
 //-----------------------------------------------------------------------------
@@ -822,6 +1109,9 @@ type ConsensusConfig struct {
 	WalPath string `mapstructure:"wal_file"`
 	walFile string // overrides WalPath if set
 
+	// The timeouts below are fallbacks for those not set in the Timeout
+	// section of the consensus params.
+
 	// How long we wait for a proposal block before prevoting nil
 	TimeoutPropose time.Duration `mapstructure:"timeout_propose"`
 	// How much timeout_propose increases with each round
@@ -852,6 +1142,11 @@ type ConsensusConfig struct {
 	PeerQueryMaj23SleepDuration time.Duration `mapstructure:"peer_query_maj23_sleep_duration"`
 
 	DoubleSignCheckHeight int64 `mapstructure:"double_sign_check_height"`
+
+	// When true, the node asks its peers for the votes of its validator key
+	// after starting, and does not sign until one of them answered and it has
+	// observed a full height without any vote of its key.
+	DoubleSignGuard bool `mapstructure:"double_sign_guard"`
 }
 
 // DefaultConsensusConfig returns a default configuration for the consensus service
@@ -871,6 +1166,7 @@ func DefaultConsensusConfig() *ConsensusConfig {
 		PeerGossipSleepDuration:     100 * time.Millisecond,
 		PeerQueryMaj23SleepDuration: 2000 * time.Millisecond,
 		DoubleSignCheckHeight:       int64(0),
+		DoubleSignGuard:             false,
 	}
 }
 
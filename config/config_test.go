@@ -62,6 +62,25 @@ func TestBaseConfigValidateBasic(t *testing.T) {
 	// tamper with log format
 	cfg.LogFormat = "invalid"
 	assert.Error(t, cfg.ValidateBasic())
+
+	// a gRPC remote signer requires mutual TLS
+	cfg = TestBaseConfig()
+	cfg.PrivValidatorListenAddr = "grpc://127.0.0.1:26659"
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.PrivValidatorClientCertificate = "config/client.crt"
+	cfg.PrivValidatorClientKey = "config/client.key"
+	cfg.PrivValidatorRootCA = "config/ca.crt"
+	assert.NoError(t, cfg.ValidateBasic())
+
+	// the quorum of several signers
+	cfg = TestBaseConfig()
+	cfg.PrivValidatorListenAddr = "tcp://127.0.0.1:26659,tcp://127.0.0.1:26660,tcp://127.0.0.1:26661"
+	cfg.PrivValidatorQuorum = 2
+	assert.NoError(t, cfg.ValidateBasic())
+	cfg.PrivValidatorQuorum = 4
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.PrivValidatorQuorum = -1
+	assert.Error(t, cfg.ValidateBasic())
 }
 
 func TestRPCConfigValidateBasic(t *testing.T) {
@@ -74,6 +93,9 @@ func TestRPCConfigValidateBasic(t *testing.T) {
 		"MaxSubscriptionClients",
 		"MaxSubscriptionsPerClient",
 		"TimeoutBroadcastTxCommit",
+		"EventLogWindowSize",
+		"EventLogMaxItems",
+		"ResponseCacheSize",
 		"MaxBodyBytes",
 		"MaxHeaderBytes",
 	}
@@ -83,6 +105,41 @@ func TestRPCConfigValidateBasic(t *testing.T) {
 		assert.Error(t, cfg.ValidateBasic())
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(0)
 	}
+
+	cfg.MaxBlocksRange = 0
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.MaxBlocksRange = 20
+	cfg.MaxBlocksRangeBytes = 0
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.MaxBlocksRangeBytes = 1
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.RateLimit = -1
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.RateLimit = 10
+	cfg.RateLimitBurst = 0
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.RateLimitBurst = 20
+	assert.NoError(t, cfg.ValidateBasic())
+
+	for _, costs := range [][]string{{"tx_search"}, {"=1"}, {"tx_search=-1"}, {"tx_search=x"}} {
+		cfg.MethodCosts = costs
+		assert.Error(t, cfg.ValidateBasic(), costs)
+	}
+	cfg.MethodCosts = []string{"tx_search=10", "status=0.5"}
+	costs, err := cfg.MethodCostMap()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"tx_search": 10, "status": 0.5}, costs)
+
+	for _, keys := range [][]string{{"key"}, {"key=1"}, {"=1:1"}, {"key=-1:1"}, {"key=1:0"}, {"key=1:1", "key=2:2"}} {
+		cfg.APIKeys = keys
+		assert.Error(t, cfg.ValidateBasic(), keys)
+	}
+	cfg.APIKeys = []string{"a=0:0", "b=10:20"}
+	quotas, err := cfg.APIKeyQuotas()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]RPCQuota{"a": {}, "b": {Rate: 10, Burst: 20}}, quotas)
+	assert.NoError(t, cfg.ValidateBasic())
 }
 
 func TestP2PConfigValidateBasic(t *testing.T) {
@@ -93,9 +150,11 @@ func TestP2PConfigValidateBasic(t *testing.T) {
 		"MaxNumInboundPeers",
 		"MaxNumOutboundPeers",
 		"FlushThrottleTimeout",
+		"DNSSeedRefreshPeriod",
 		"MaxPacketMsgPayloadSize",
 		"SendRate",
 		"RecvRate",
+		"CaptureMaxSize",
 	}
 
 	for _, fieldName := range fieldsToTest {
@@ -103,6 +162,19 @@ func TestP2PConfigValidateBasic(t *testing.T) {
 		assert.Error(t, cfg.ValidateBasic())
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(0)
 	}
+
+	cfg.Mode = "unknown"
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg.Mode = P2PModeSentry
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.ValidatorPeerIDs = "ed3dfd27bfc4af18f67a49862f04cc100696e84d"
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.Mode = P2PModeValidator
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.PersistentPeers = "d824b13cb5d40fa1d8a614e089357c7eff31b670@127.0.0.1:26656"
+	assert.NoError(t, cfg.ValidateBasic())
 }
 
 func TestMempoolConfigValidateBasic(t *testing.T) {
@@ -138,6 +210,17 @@ func TestFastSyncConfigValidateBasic(t *testing.T) {
 
 	cfg.Version = "invalid"
 	assert.Error(t, cfg.ValidateBasic())
+
+	// headers first is only supported by v3, with a trust period
+	cfg.Version = "v0"
+	cfg.VerifyHeadersFirst = true
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg.Version = "v3"
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.TrustPeriod = 0
+	assert.Error(t, cfg.ValidateBasic())
 }
 
 func TestConsensusConfig_ValidateBasic(t *testing.T) {
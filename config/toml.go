@@ -134,9 +134,28 @@ priv_validator_key_file = "{{ js .BaseConfig.PrivValidatorKey }}"
 priv_validator_state_file = "{{ js .BaseConfig.PrivValidatorState }}"
 
 # TCP or UNIX socket address for Augusteum to listen on for
-# connections from an external PrivValidator process
+# connections from an external PrivValidator process.
+# With the grpc:// scheme (e.g. "grpc://127.0.0.1:26659"), it is instead the
+# address of a remote signer serving gRPC, which Augusteum connects to using
+# mutual TLS.
+# Several comma-separated socket addresses make Augusteum listen for several
+# signers holding the same key, and only sign once a quorum of them produce the
+# same signature. Signers lagging behind the last signed height/round/step
+# catch up at the next one.
 priv_validator_laddr = "{{ .BaseConfig.PrivValidatorListenAddr }}"
 
+# Number of signers which must agree when priv_validator_laddr holds several
+# addresses. 0 means a majority of them.
+priv_validator_quorum = {{ .BaseConfig.PrivValidatorQuorum }}
+
+# Path to the certificate and key Augusteum authenticates with to a gRPC
+# remote signer
+priv_validator_client_certificate_file = "{{ js .BaseConfig.PrivValidatorClientCertificate }}"
+priv_validator_client_key_file = "{{ js .BaseConfig.PrivValidatorClientKey }}"
+
+# Path to the root CA the certificate of a gRPC remote signer must be signed by
+priv_validator_root_ca_file = "{{ js .BaseConfig.PrivValidatorRootCA }}"
+
 # Path to the JSON file containing the private key to use for node authentication in the p2p protocol
 node_key_file = "{{ js .BaseConfig.NodeKey }}"
 
@@ -172,7 +191,8 @@ cors_allowed_methods = [{{ range .RPC.CORSAllowedMethods }}{{ printf "%q, " . }}
 cors_allowed_headers = [{{ range .RPC.CORSAllowedHeaders }}{{ printf "%q, " . }}{{end}}]
 
 # TCP or UNIX socket address for the gRPC server to listen on
-# NOTE: This server only supports /broadcast_tx_commit
+# NOTE: This server supports /broadcast_tx_commit, the queries of the RPC
+# (blocks, txs, validators, status, ...) and streaming events
 grpc_laddr = "{{ .RPC.GRPCListenAddress }}"
 
 # Maximum number of simultaneous connections.
@@ -212,6 +232,52 @@ max_subscriptions_per_client = {{ .RPC.MaxSubscriptionsPerClient }}
 # See https://github.com/creatachain/augusteum/issues/3435
 timeout_broadcast_tx_commit = "{{ .RPC.TimeoutBroadcastTxCommit }}"
 
+# How long the events are retained by the event log read by /events, which
+# lets clients resume reading events from a cursor without missing any.
+# 0 disables the event log.
+event_log_window_size = "{{ .RPC.EventLogWindowSize }}"
+
+# Maximum number of events retained by the event log. 0 means no limit other
+# than event_log_window_size.
+event_log_max_items = {{ .RPC.EventLogMaxItems }}
+
+# Number of results of /block, /block_results, /commit and /validators for
+# past heights kept in memory, as they never change. Such responses are also
+# sent with Cache-Control and ETag headers, so that HTTP caches in front of
+# the node can store them. 0 disables the cache.
+response_cache_size = {{ .RPC.ResponseCacheSize }}
+
+# Maximum number of blocks returned by /blocks_range.
+max_blocks_range = {{ .RPC.MaxBlocksRange }}
+
+# Maximum total size of the blocks returned by /blocks_range, in bytes. At
+# least one block is returned, whatever its size.
+max_blocks_range_bytes = {{ .RPC.MaxBlocksRangeBytes }}
+
+# Number of calls per second each client IP can make to the RPC server
+# (HTTP&WebSocket), on average. 0 means no limit.
+rate_limit = {{ .RPC.RateLimit }}
+
+# Number of calls a client IP can make at once, before being limited to
+# rate_limit calls per second.
+rate_limit_burst = {{ .RPC.RateLimitBurst }}
+
+# Number of calls each call to a method counts for in the rate limits, as
+# "method=cost" entries. Methods not listed cost 1.
+method_costs = [{{ range .RPC.MethodCosts }}{{ printf "%q, " . }}{{end}}]
+
+# If not empty, only these methods can be called.
+allowed_methods = [{{ range .RPC.AllowedMethods }}{{ printf "%q, " . }}{{end}}]
+
+# Methods which can't be called, e.g. '["tx_search", "blockchain"]'.
+denied_methods = [{{ range .RPC.DeniedMethods }}{{ printf "%q, " . }}{{end}}]
+
+# API keys clients can send in the X-API-Key header, which replace the rate
+# limit of their IP with the quota of the key, as "key=rate:burst" entries.
+# A rate of 0 means no limit, e.g. '["s3cr3t=0:0", "partner=100:200"]'.
+# Browser clients need X-API-Key in cors_allowed_headers.
+api_keys = [{{ range .RPC.APIKeys }}{{ printf "%q, " . }}{{end}}]
+
 # Maximum size of request body, in bytes
 max_body_bytes = {{ .RPC.MaxBodyBytes }}
 
@@ -241,6 +307,14 @@ pprof_laddr = "{{ .RPC.PprofListenAddress }}"
 #######################################################
 [p2p]
 
+# Role of the node in a sentry topology:
+#   - "full": takes part in the network like any other peer
+#   - "sentry": shields the validators listed in validator_peer_ids, which are
+#     always accepted and never gossiped to other peers
+#   - "validator": only accepts the sentries listed in persistent_peers and
+#     never gossips its own address (pex is disabled)
+mode = "{{ .P2P.Mode }}"
+
 # Address to listen for incoming connections
 laddr = "{{ .P2P.ListenAddress }}"
 
@@ -251,8 +325,14 @@ laddr = "{{ .P2P.ListenAddress }}"
 external_address = "{{ .P2P.ExternalAddress }}"
 
 # Comma separated list of seed nodes to connect to
+# Entries of the form dns://<name> are DNS seeds: the TXT records of <name>
+# list id@host:port addresses and the SRV records of _augusteum._tcp.<name>
+# point to <id>.<host> targets. Malformed entries are skipped.
 seeds = "{{ .P2P.Seeds }}"
 
+# How often DNS seeds are resolved again
+dns_seed_refresh_period = "{{ .P2P.DNSSeedRefreshPeriod }}"
+
 # Comma separated list of nodes to keep persistent connections to
 persistent_peers = "{{ .P2P.PersistentPeers }}"
 
@@ -302,6 +382,10 @@ seed_mode = {{ .P2P.SeedMode }}
 # Comma separated list of peer IDs to keep private (will not be gossiped to other peers)
 private_peer_ids = "{{ .P2P.PrivatePeerIDs }}"
 
+# Comma separated list of the IDs of the validators behind this sentry. They
+# are kept private and unconditional. Only used in sentry mode.
+validator_peer_ids = "{{ .P2P.ValidatorPeerIDs }}"
+
 # Toggle to disable guard against peers connecting from the same ip.
 allow_duplicate_ip = {{ .P2P.AllowDuplicateIP }}
 
@@ -309,6 +393,14 @@ allow_duplicate_ip = {{ .P2P.AllowDuplicateIP }}
 handshake_timeout = "{{ .P2P.HandshakeTimeout }}"
 dial_timeout = "{{ .P2P.DialTimeout }}"
 
+# Path to the file capturing all messages exchanged with peers, for debugging
+# purposes. Capturing is disabled if empty. Decode it with
+# "augusteum debug p2p-dump".
+capture_file = "{{ js .P2P.CaptureFile }}"
+
+# Maximum total size of the capture files, in bytes (0 means unlimited).
+capture_max_size = {{ .P2P.CaptureMaxSize }}
+
 #######################################################
 ###          Mempool Configuration Option          ###
 #######################################################
@@ -381,8 +473,22 @@ temp_dir = "{{ .StateSync.TempDir }}"
 #   1) "v0" (default) - the legacy fast sync implementation
 #   2) "v1" - refactor of v0 version for better testability
 #   2) "v2" - complete redesign of v0, optimized for testability & readability
+#   3) "v3" - v0 verifying the commits of the blocks ahead in parallel, for a
+#      CPU-bound catch up on long chains
 version = "{{ .FastSync.Version }}"
 
+# Verify the headers up to the network tip first (v3 only). The light client
+# verifies the headers ahead from the state of the node, skipping the ones it
+# can, and the blocks are then checked against the hashes of the verified
+# headers instead of the heights reported by the peers. The state must be more
+# recent than trust_period (e.g. restored by state sync), or the blocks are
+# verified one by one.
+verify_headers_first = {{ .FastSync.VerifyHeadersFirst }}
+
+# Period during which the validators of the state of the node can be trusted,
+# to verify the headers first. Should usually be about 2/3 of the unbonding time.
+trust_period = "{{ .FastSync.TrustPeriod }}"
+
 #######################################################
 ###         Consensus Configuration Options         ###
 #######################################################
@@ -390,6 +496,9 @@ version = "{{ .FastSync.Version }}"
 
 wal_file = "{{ js .Consensus.WalPath }}"
 
+# The timeouts below are only used when they are not set in the timeout
+# section of the consensus params of the chain.
+
 # How long we wait for a proposal block before prevoting nil
 timeout_propose = "{{ .Consensus.TimeoutPropose }}"
 # How much timeout_propose increases with each round
@@ -413,6 +522,16 @@ timeout_commit = "{{ .Consensus.TimeoutCommit }}"
 # So, validators should stop the state machine, wait for some blocks, and then restart the state machine to avoid panic.
 double_sign_check_height = {{ .Consensus.DoubleSignCheckHeight }}
 
+# When true, the node asks its peers for the votes of its validator key after
+# starting, and does not sign any vote or proposal until one of them answered and
+# a full height has been committed without any vote of its key received from its
+# peers. This protects against running a validator key moved to a new
+# machine while the old one is still signing, or with a stale
+# priv_validator_state.json. As the node does not sign meanwhile, the guard must
+# not be enabled on validators needed by the rest of the network to commit.
+# It can be overridden for a single start with --consensus.double_sign_guard=false.
+double_sign_guard = {{ .Consensus.DoubleSignGuard }}
+
 # Make progress as soon as we have all the precommits (as if TimeoutCommit = 0)
 skip_timeout_commit = {{ .Consensus.SkipTimeoutCommit }}
 
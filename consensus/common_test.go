@@ -108,6 +108,7 @@ func (vs *validatorStub) signVote(
 	v := vote.ToProto()
 	err = vs.PrivValidator.SignVote(config.ChainID(), v)
 	vote.Signature = v.Signature
+	vote.ExtensionSignature = v.ExtensionSignature
 
 	return vote, err
 }
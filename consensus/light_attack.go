@@ -0,0 +1,171 @@
+package consensus
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/creatachain/augusteum/light"
+	"github.com/creatachain/augusteum/p2p"
+	"github.com/creatachain/augusteum/types"
+)
+
+// lightAttackMaxLookback is the maximum number of heights below the height of
+// a conflicting light block searched for a common height from which it can be
+// verified.
+const lightAttackMaxLookback = 10
+
+// errNoConflict is returned by lightClientAttackEvidence for a light block
+// which matches the block committed at its height.
+var errNoConflict = errors.New("light block does not conflict with the committed block")
+
+// errInvalidLightBlock is returned by lightClientAttackEvidence for a light
+// block which is invalid, rather than one which can't be checked against our
+// state, so that its sender can be punished.
+type errInvalidLightBlock struct {
+	err error
+}
+
+func (e errInvalidLightBlock) Error() string {
+	return fmt.Sprintf("invalid light block: %v", e.err)
+}
+
+func (e errInvalidLightBlock) Unwrap() error {
+	return e.err
+}
+
+// handleConflictingLightBlock turns a light block received from a peer which
+// conflicts with the block committed at the same height into
+// LightClientAttackEvidence and submits it to the evidence pool. It returns an
+// error if the light block is invalid.
+//
+// It only reads the committed state, so it is safe to call outside of the
+// receive routine.
+func (cs *State) handleConflictingLightBlock(lb *types.LightBlock, peerID p2p.ID) error {
+	ev, err := cs.lightClientAttackEvidence(lb)
+	if invalid, ok := err.(errInvalidLightBlock); ok {
+		return invalid
+	}
+	if err != nil {
+		cs.Logger.Debug("ignoring light block", "height", lb.Height, "peer", peerID, "err", err)
+		return nil
+	}
+	cs.Logger.Info("detected conflicting light block", "height", lb.Height, "peer", peerID,
+		"commonHeight", ev.CommonHeight, "byzantineValidators", len(ev.ByzantineValidators))
+	if err := cs.evpool.AddEvidence(ev); err != nil {
+		cs.Logger.Error("failed to add light client attack evidence", "err", err)
+	}
+	return nil
+}
+
+// lightClientAttackEvidence builds LightClientAttackEvidence from a light
+// block conflicting with the block committed at the same height.
+//
+// A conflicting header which is correctly derived from the previous state
+// (equivocation or amnesia) must be signed by +2/3 of the validators at its
+// height, and its common height is its own height. An invalid header
+// (lunatic) must be verifiable, skipping from a lower committed height with at
+// least 1/3 of the validators of that height having signed it. The highest
+// such height is the common height.
+//
+// Only heights for which the block store holds the commit of the chain are
+// considered, as it is the commit the evidence pool verifies against. An
+// errInvalidLightBlock is returned for a light block which is invalid at every
+// height it can be checked from. Not being signed by enough of the validators
+// of a height doesn't make it invalid, as it may be verifiable from another.
+func (cs *State) lightClientAttackEvidence(lb *types.LightBlock) (*types.LightClientAttackEvidence, error) {
+	state := cs.GetState()
+	if lb.ChainID != state.ChainID {
+		return nil, errInvalidLightBlock{fmt.Errorf("wrong chain ID %q", lb.ChainID)}
+	}
+	if err := lb.ValidateBasic(state.ChainID); err != nil {
+		return nil, errInvalidLightBlock{err}
+	}
+
+	trusted, err := cs.loadSignedHeader(lb.Height)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(trusted.Hash(), lb.Hash()) {
+		return nil, errNoConflict
+	}
+
+	ev := &types.LightClientAttackEvidence{ConflictingBlock: lb}
+	if !ev.ConflictingHeaderIsInvalid(trusted.Header) {
+		// equivocation or amnesia
+		vals, err := cs.blockExec.Store().LoadValidators(lb.Height)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(vals.Hash(), lb.ValidatorSet.Hash()) {
+			return nil, errInvalidLightBlock{
+				errors.New("validator set of correctly derived header differs from ours")}
+		}
+		if err := lb.ValidatorSet.VerifyCommitLight(state.ChainID, lb.Commit.BlockID, lb.Height, lb.Commit); err != nil {
+			return nil, errInvalidLightBlock{fmt.Errorf("invalid commit: %w", err)}
+		}
+		ev.CommonHeight = lb.Height
+		ev.ByzantineValidators = ev.GetByzantineValidators(vals, trusted)
+		ev.TotalVotingPower = vals.TotalVotingPower()
+		ev.Timestamp = trusted.Time
+		return ev, nil
+	}
+
+	// lunatic: find the highest height from which the conflicting header can
+	// be verified. The adjacent height is skipped, as verifying from it requires
+	// the validator set of the conflicting header to be the one committed.
+	var (
+		trustPeriod = state.ConsensusParams.Evidence.MaxAgeDuration
+		minHeight   = lb.Height - lightAttackMaxLookback
+		checked     = false // the header failed verification from a height within the trust period
+	)
+	if base := cs.blockStore.Base(); minHeight < base {
+		minHeight = base
+	}
+	for height := lb.Height - 2; height >= minHeight; height-- {
+		common, err := cs.loadSignedHeader(height)
+		if err != nil {
+			return nil, err
+		}
+		vals, err := cs.blockExec.Store().LoadValidators(height)
+		if err != nil {
+			return nil, err
+		}
+		err = light.Verify(common, vals, lb.SignedHeader, lb.ValidatorSet, trustPeriod, state.LastBlockTime, 0,
+			light.DefaultTrustLevel)
+		if _, ok := err.(light.ErrOldHeaderExpired); ok {
+			break
+		}
+		if _, ok := err.(light.ErrNewValSetCantBeTrusted); ok {
+			continue
+		}
+		if err != nil {
+			checked = true
+			continue
+		}
+		ev.CommonHeight = height
+		ev.ByzantineValidators = ev.GetByzantineValidators(vals, trusted)
+		ev.TotalVotingPower = vals.TotalVotingPower()
+		ev.Timestamp = common.Time
+		return ev, nil
+	}
+	err = fmt.Errorf("invalid header can't be verified from heights %d to %d", minHeight, lb.Height-2)
+	if checked {
+		return nil, errInvalidLightBlock{err}
+	}
+	return nil, err
+}
+
+// loadSignedHeader returns the header committed at the given height along with
+// its commit from the following block.
+func (cs *State) loadSignedHeader(height int64) (*types.SignedHeader, error) {
+	meta := cs.blockStore.LoadBlockMeta(height)
+	if meta == nil {
+		return nil, fmt.Errorf("no block at height %d", height)
+	}
+	commit := cs.blockStore.LoadBlockCommit(height)
+	if commit == nil {
+		return nil, fmt.Errorf("no commit for height %d", height)
+	}
+	return &types.SignedHeader{Header: &meta.Header, Commit: commit}, nil
+}
@@ -0,0 +1,176 @@
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/creatachain/augusteum/crypto/tmhash"
+	"github.com/creatachain/augusteum/evidence"
+	tmrand "github.com/creatachain/augusteum/libs/rand"
+	tmproto "github.com/creatachain/augusteum/proto/augusteum/types"
+	"github.com/creatachain/augusteum/types"
+)
+
+type lightAttackEvidencePool struct {
+	evidence []types.Evidence
+}
+
+func (p *lightAttackEvidencePool) ReportConflictingVotes(voteA, voteB *types.Vote) {}
+
+func (p *lightAttackEvidencePool) AddEvidence(ev types.Evidence) error {
+	p.evidence = append(p.evidence, ev)
+	return nil
+}
+
+// signLightBlock makes a light block of the header and the validator set,
+// signed in round 0 by the given private validators.
+func signLightBlock(t *testing.T, header *types.Header, vals *types.ValidatorSet,
+	privVals ...types.PrivValidator) *types.LightBlock {
+	blockID := types.BlockID{
+		Hash:          header.Hash(),
+		PartSetHeader: types.PartSetHeader{Total: 1, Hash: tmrand.Bytes(tmhash.Size)},
+	}
+	voteSet := types.NewVoteSet(header.ChainID, header.Height, 0, tmproto.PrecommitType, vals)
+	for _, privVal := range privVals {
+		pubKey, err := privVal.GetPubKey()
+		require.NoError(t, err)
+		idx, _ := vals.GetByAddress(pubKey.Address())
+		vote := &types.Vote{
+			ValidatorAddress: pubKey.Address(),
+			ValidatorIndex:   idx,
+			Height:           header.Height,
+			Round:            0,
+			Type:             tmproto.PrecommitType,
+			BlockID:          blockID,
+			Timestamp:        header.Time,
+		}
+		v := vote.ToProto()
+		require.NoError(t, privVal.SignVote(header.ChainID, v))
+		vote.Signature = v.Signature
+		_, err = voteSet.AddVote(vote)
+		require.NoError(t, err)
+	}
+	return &types.LightBlock{
+		SignedHeader: &types.SignedHeader{Header: header, Commit: voteSet.MakeCommit()},
+		ValidatorSet: vals,
+	}
+}
+
+func TestStateLightClientAttackEvidence(t *testing.T) {
+	cs1, vss := randState(1)
+	evpool := &lightAttackEvidencePool{}
+	cs1.evpool = evpool
+	newBlockCh := subscribe(cs1.eventBus, types.EventQueryNewBlock)
+
+	startTestRound(cs1, cs1.Height, 0)
+	for height := int64(1); height <= 5; height++ {
+		ensureNewBlock(newBlockCh, height)
+	}
+
+	const height = 4
+	state := cs1.GetState()
+	vals := state.Validators
+	trusted, err := cs1.loadSignedHeader(height)
+	require.NoError(t, err)
+
+	t.Run("committed block", func(t *testing.T) {
+		lb := signLightBlock(t, trusted.Header, vals, vss[0].PrivValidator)
+		_, err := cs1.lightClientAttackEvidence(lb)
+		assert.Equal(t, errNoConflict, err)
+	})
+
+	t.Run("future height", func(t *testing.T) {
+		header := *trusted.Header
+		header.Height = cs1.blockStore.Height() + 100
+		lb := signLightBlock(t, &header, vals, vss[0].PrivValidator)
+		_, err := cs1.lightClientAttackEvidence(lb)
+		require.Error(t, err)
+		// not invalid, only not committed yet
+		_, invalid := err.(errInvalidLightBlock)
+		assert.False(t, invalid)
+		assert.NoError(t, cs1.handleConflictingLightBlock(lb, "peer"))
+	})
+
+	t.Run("equivocation", func(t *testing.T) {
+		header := *trusted.Header
+		header.Time = header.Time.Add(time.Millisecond)
+		lb := signLightBlock(t, &header, vals, vss[0].PrivValidator)
+
+		ev, err := cs1.lightClientAttackEvidence(lb)
+		require.NoError(t, err)
+		assert.EqualValues(t, height, ev.CommonHeight)
+		assert.Equal(t, trusted.Time, ev.Timestamp)
+		require.Len(t, ev.ByzantineValidators, 1)
+		assert.Equal(t, vals.Validators[0].Address, ev.ByzantineValidators[0].Address)
+		require.NoError(t, ev.ValidateBasic())
+		assert.NoError(t, evidence.VerifyLightClientAttack(ev, trusted, trusted, vals, state.LastBlockTime,
+			state.ConsensusParams.Evidence.MaxAgeDuration))
+	})
+
+	t.Run("lunatic", func(t *testing.T) {
+		fakeVal, fakePrivVal := types.RandValidator(false, 10)
+		lunaticVals := types.NewValidatorSet([]*types.Validator{vals.Validators[0].Copy(), fakeVal})
+		header := *trusted.Header
+		header.ValidatorsHash = lunaticVals.Hash()
+		header.NextValidatorsHash = lunaticVals.Hash()
+		header.AppHash = tmrand.Bytes(tmhash.Size)
+		lb := signLightBlock(t, &header, lunaticVals, vss[0].PrivValidator, fakePrivVal)
+
+		ev, err := cs1.lightClientAttackEvidence(lb)
+		require.NoError(t, err)
+		assert.EqualValues(t, height-2, ev.CommonHeight)
+		common, err := cs1.loadSignedHeader(ev.CommonHeight)
+		require.NoError(t, err)
+		assert.Equal(t, common.Time, ev.Timestamp)
+		require.Len(t, ev.ByzantineValidators, 1)
+		assert.Equal(t, vals.Validators[0].Address, ev.ByzantineValidators[0].Address)
+		require.NoError(t, ev.ValidateBasic())
+		assert.NoError(t, evidence.VerifyLightClientAttack(ev, common, trusted, vals, state.LastBlockTime,
+			state.ConsensusParams.Evidence.MaxAgeDuration))
+
+		// the reactor hands the light block over to the evidence pool
+		msg, err := decodeMsg(MustEncode(&LightBlockMessage{LightBlock: lb}))
+		require.NoError(t, err)
+		require.NoError(t, cs1.handleConflictingLightBlock(msg.(*LightBlockMessage).LightBlock, "peer"))
+		require.Len(t, evpool.evidence, 1)
+		assert.Equal(t, ev.Hash(), evpool.evidence[0].Hash())
+	})
+
+	t.Run("lunatic without 1/3 of common validators", func(t *testing.T) {
+		fakeVal, fakePrivVal := types.RandValidator(false, 10)
+		lunaticVals := types.NewValidatorSet([]*types.Validator{fakeVal})
+		header := *trusted.Header
+		header.ValidatorsHash = lunaticVals.Hash()
+		header.NextValidatorsHash = lunaticVals.Hash()
+		lb := signLightBlock(t, &header, lunaticVals, fakePrivVal)
+		_, err := cs1.lightClientAttackEvidence(lb)
+		require.Error(t, err)
+		// it may be verifiable from a height we don't have
+		_, invalid := err.(errInvalidLightBlock)
+		assert.False(t, invalid)
+		assert.NoError(t, cs1.handleConflictingLightBlock(lb, "peer"))
+	})
+
+	t.Run("lunatic with an invalid commit", func(t *testing.T) {
+		fakeVal, fakePrivVal := types.RandValidator(false, 10)
+		lunaticVals := types.NewValidatorSet([]*types.Validator{vals.Validators[0].Copy(), fakeVal})
+		header := *trusted.Header
+		header.ValidatorsHash = lunaticVals.Hash()
+		header.NextValidatorsHash = lunaticVals.Hash()
+		lb := signLightBlock(t, &header, lunaticVals, vss[0].PrivValidator, fakePrivVal)
+		idx, _ := lunaticVals.GetByAddress(fakeVal.Address)
+		lb.Commit.Signatures[idx].Signature = tmrand.Bytes(64)
+
+		_, err := cs1.lightClientAttackEvidence(lb)
+		assert.IsType(t, errInvalidLightBlock{}, err)
+		assert.Error(t, cs1.handleConflictingLightBlock(lb, "peer"))
+	})
+}
+
+func TestLightBlockMessageValidateBasic(t *testing.T) {
+	assert.Error(t, (&LightBlockMessage{}).ValidateBasic())
+	assert.Error(t, (&LightBlockMessage{LightBlock: &types.LightBlock{}}).ValidateBasic())
+}
@@ -60,6 +60,17 @@ type Metrics struct {
 
 	// Number of blockparts transmitted by peer.
 	BlockParts metrics.Counter
+
+	// Time spent in each consensus step.
+	StepDurationSeconds metrics.Histogram
+	// Time between the start of a round and receiving its proposal.
+	ProposalReceiveLatencySeconds metrics.Histogram
+	// Time between the start of a round and receiving the whole proposal block.
+	ProposalBlockReceiveLatencySeconds metrics.Histogram
+	// Time between the start of a round and receiving a validator's vote.
+	VoteArrivalLatencySeconds metrics.Histogram
+	// Time between the start of a round and receiving +2/3 of the votes.
+	QuorumLatencySeconds metrics.Histogram
 }
 
 // PrometheusMetrics returns Metrics build using Prometheus client library.
@@ -186,6 +197,36 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "block_parts",
 			Help:      "Number of blockparts transmitted by peer.",
 		}, append(labels, "peer_id")).With(labelsAndValues...),
+		StepDurationSeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "step_duration_seconds",
+			Help:      "Time spent in each consensus step.",
+		}, append(labels, "step")).With(labelsAndValues...),
+		ProposalReceiveLatencySeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "proposal_receive_latency_seconds",
+			Help:      "Time between the start of a round and receiving its proposal.",
+		}, labels).With(labelsAndValues...),
+		ProposalBlockReceiveLatencySeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "proposal_block_receive_latency_seconds",
+			Help:      "Time between the start of a round and receiving the whole proposal block.",
+		}, labels).With(labelsAndValues...),
+		VoteArrivalLatencySeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "vote_arrival_latency_seconds",
+			Help:      "Time between the start of a round and receiving a validator's vote.",
+		}, append(labels, "vote_type", "validator_address")).With(labelsAndValues...),
+		QuorumLatencySeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "quorum_latency_seconds",
+			Help:      "Time between the start of a round and receiving +2/3 of the votes.",
+		}, append(labels, "vote_type")).With(labelsAndValues...),
 	}
 }
 
@@ -216,5 +257,11 @@ func NopMetrics() *Metrics {
 		FastSyncing:     discard.NewGauge(),
 		StateSyncing:    discard.NewGauge(),
 		BlockParts:      discard.NewCounter(),
+
+		StepDurationSeconds:                discard.NewHistogram(),
+		ProposalReceiveLatencySeconds:      discard.NewHistogram(),
+		ProposalBlockReceiveLatencySeconds: discard.NewHistogram(),
+		VoteArrivalLatencySeconds:          discard.NewHistogram(),
+		QuorumLatencySeconds:               discard.NewHistogram(),
 	}
 }
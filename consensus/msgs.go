@@ -136,6 +136,41 @@ func MsgToProto(msg Message) (*tmcons.Message, error) {
 			Sum: vsb,
 		}
 
+	case *LightBlockMessage:
+		lb, err := msg.LightBlock.ToProto()
+		if err != nil {
+			return nil, fmt.Errorf("msg to proto error: %w", err)
+		}
+		pb = tmcons.Message{
+			Sum: &tmcons.Message_LightBlock{
+				LightBlock: &tmcons.LightBlock{
+					LightBlock: lb,
+				},
+			},
+		}
+
+	case *VotesRequestMessage:
+		pb = tmcons.Message{
+			Sum: &tmcons.Message_VotesRequest{
+				VotesRequest: &tmcons.VotesRequest{
+					ValidatorAddress: msg.ValidatorAddress,
+				},
+			},
+		}
+
+	case *VotesResponseMessage:
+		votes := make([]*tmproto.Vote, len(msg.Votes))
+		for i, vote := range msg.Votes {
+			votes[i] = vote.ToProto()
+		}
+		pb = tmcons.Message{
+			Sum: &tmcons.Message_VotesResponse{
+				VotesResponse: &tmcons.VotesResponse{
+					Votes: votes,
+				},
+			},
+		}
+
 	default:
 		return nil, fmt.Errorf("consensus: message not recognized: %T", msg)
 	}
@@ -249,6 +284,30 @@ func MsgFromProto(msg *tmcons.Message) (Message, error) {
 			BlockID: *bi,
 			Votes:   bits,
 		}
+	case *tmcons.Message_LightBlock:
+		lb, err := types.LightBlockFromProto(msg.LightBlock.LightBlock)
+		if err != nil {
+			return nil, fmt.Errorf("lightBlock msg to proto error: %w", err)
+		}
+		pb = &LightBlockMessage{
+			LightBlock: lb,
+		}
+	case *tmcons.Message_VotesRequest:
+		pb = &VotesRequestMessage{
+			ValidatorAddress: msg.VotesRequest.ValidatorAddress,
+		}
+	case *tmcons.Message_VotesResponse:
+		votes := make([]*types.Vote, len(msg.VotesResponse.Votes))
+		for i, v := range msg.VotesResponse.Votes {
+			vote, err := types.VoteFromProto(v)
+			if err != nil {
+				return nil, fmt.Errorf("votes msg to proto error: %w", err)
+			}
+			votes[i] = vote
+		}
+		pb = &VotesResponseMessage{
+			Votes: votes,
+		}
 	default:
 		return nil, fmt.Errorf("consensus: message not recognized: %T", msg)
 	}
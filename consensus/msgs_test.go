@@ -184,6 +184,24 @@ func TestMsgToProto(t *testing.T) {
 				},
 			},
 		}, false},
+		{"successful VotesRequestMessage", &VotesRequestMessage{
+			ValidatorAddress: vote.ValidatorAddress,
+		}, &tmcons.Message{
+			Sum: &tmcons.Message_VotesRequest{
+				VotesRequest: &tmcons.VotesRequest{
+					ValidatorAddress: vote.ValidatorAddress,
+				},
+			},
+		}, false},
+		{"successful VotesResponseMessage", &VotesResponseMessage{
+			Votes: []*types.Vote{vote},
+		}, &tmcons.Message{
+			Sum: &tmcons.Message_VotesResponse{
+				VotesResponse: &tmcons.VotesResponse{
+					Votes: []*tmproto.Vote{pbVote},
+				},
+			},
+		}, false},
 		{"failure", nil, &tmcons.Message{}, true},
 	}
 	for _, tt := range testsCases {
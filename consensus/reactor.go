@@ -10,6 +10,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 
 	cstypes "github.com/creatachain/augusteum/consensus/types"
+	"github.com/creatachain/augusteum/crypto"
 	"github.com/creatachain/augusteum/libs/bits"
 	tmevents "github.com/creatachain/augusteum/libs/events"
 	tmjson "github.com/creatachain/augusteum/libs/json"
@@ -33,6 +34,14 @@ const (
 
 	blocksToContributeToBecomeGoodPeer = 10000
 	votesToContributeToBecomeGoodPeer  = 10000
+
+	// maximum number of light blocks waiting to be turned into evidence. Each
+	// peer has at most one of them queued.
+	lightBlockQueueSize = 10
+
+	// maximum number of votes sent in response to a VotesRequestMessage. A
+	// validator signs at most two votes per round.
+	maxVotesResponseSize = 100
 )
 
 //-----------------------------------------------------------------------------
@@ -47,18 +56,27 @@ type Reactor struct {
 	waitSync bool
 	eventBus *types.EventBus
 
+	lightBlocks chan lightBlockInfo
+
 	Metrics *Metrics
 }
 
+// lightBlockInfo is a light block received from a peer.
+type lightBlockInfo struct {
+	lightBlock *types.LightBlock
+	peer       p2p.Peer
+}
+
 type ReactorOption func(*Reactor)
 
 // NewReactor returns a new Reactor with the given
 // consensusState.
 func NewReactor(consensusState *State, waitSync bool, options ...ReactorOption) *Reactor {
 	conR := &Reactor{
-		conS:     consensusState,
-		waitSync: waitSync,
-		Metrics:  NopMetrics(),
+		conS:        consensusState,
+		waitSync:    waitSync,
+		lightBlocks: make(chan lightBlockInfo, lightBlockQueueSize),
+		Metrics:     NopMetrics(),
 	}
 	conR.BaseReactor = *p2p.NewBaseReactor("Consensus", conR)
 
@@ -77,6 +95,9 @@ func (conR *Reactor) OnStart() error {
 	// start routine that computes peer statistics for evaluating peer quality
 	go conR.peerStatsRoutine()
 
+	// start routine that turns conflicting light blocks into evidence
+	go conR.lightBlockRoutine()
+
 	conR.subscribeToBroadcastEvents()
 
 	if !conR.WaitSync() {
@@ -134,6 +155,10 @@ conS:
 conR:
 %+v`, err, conR.conS, conR))
 	}
+
+	for _, peer := range conR.Switch.Peers().List() {
+		conR.sendVotesRequest(peer)
+	}
 }
 
 // GetChannels implements Reactor
@@ -198,6 +223,7 @@ func (conR *Reactor) AddPeer(peer p2p.Peer) {
 	// If we're fast_syncing, broadcast a RoundStepMessage later upon SwitchToConsensus().
 	if !conR.WaitSync() {
 		conR.sendNewRoundStepMessage(peer)
+		conR.sendVotesRequest(peer)
 	}
 }
 
@@ -296,6 +322,14 @@ func (conR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 				BlockID: msg.BlockID,
 				Votes:   ourVotes,
 			}))
+		case *VotesRequestMessage:
+			if conR.WaitSync() {
+				conR.Logger.Info("Ignoring message received during sync", "msg", msg)
+				return
+			}
+			src.TrySend(VoteChannel, MustEncode(&VotesResponseMessage{
+				Votes: conR.conS.votesOf(msg.ValidatorAddress),
+			}))
 		default:
 			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
 		}
@@ -315,6 +349,21 @@ func (conR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 			ps.SetHasProposalBlockPart(msg.Height, msg.Round, int(msg.Part.Index))
 			conR.Metrics.BlockParts.With("peer_id", string(src.ID())).Add(1)
 			conR.conS.peerMsgQueue <- msgInfo{msg, src.ID()}
+		case *LightBlockMessage:
+			// verifying the light block may take several light client verifications,
+			// so it is queued instead of blocking the other messages of the peer
+			if !ps.queueLightBlock() {
+				conR.Logger.Debug("Dropping light block, the peer already has one queued",
+					"peer", src, "height", msg.LightBlock.Height)
+				return
+			}
+			select {
+			case conR.lightBlocks <- lightBlockInfo{msg.LightBlock, src}:
+			default:
+				ps.lightBlockDone()
+				conR.Logger.Debug("Dropping light block, the queue is full",
+					"peer", src, "height", msg.LightBlock.Height)
+			}
 		default:
 			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
 		}
@@ -336,6 +385,9 @@ func (conR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 
 			cs.peerMsgQueue <- msgInfo{msg, src.ID()}
 
+		case *VotesResponseMessage:
+			conR.conS.peerMsgQueue <- msgInfo{msg, src.ID()}
+
 		default:
 			// don't punish (leave room for soft upgrades)
 			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
@@ -487,6 +539,14 @@ func (conR *Reactor) sendNewRoundStepMessage(peer p2p.Peer) {
 	peer.Send(StateChannel, MustEncode(nrsMsg))
 }
 
+// sendVotesRequest asks the peer for the votes of our validator key while the
+// double sign guard is waiting for them.
+func (conR *Reactor) sendVotesRequest(peer p2p.Peer) {
+	if address := conR.conS.doubleSignGuardAddress(); address != nil {
+		peer.Send(StateChannel, MustEncode(&VotesRequestMessage{ValidatorAddress: address}))
+	}
+}
+
 func (conR *Reactor) gossipDataRoutine(peer p2p.Peer, ps *PeerState) {
 	logger := conR.Logger.With("peer", peer)
 
@@ -890,6 +950,28 @@ func (conR *Reactor) peerStatsRoutine() {
 	}
 }
 
+// lightBlockRoutine turns the conflicting light blocks received from the peers
+// into evidence, one at a time, and stops the peers which sent invalid ones.
+func (conR *Reactor) lightBlockRoutine() {
+	for {
+		select {
+		case info := <-conR.lightBlocks:
+			err := conR.conS.handleConflictingLightBlock(info.lightBlock, info.peer.ID())
+			if ps, ok := info.peer.Get(types.PeerStateKey).(*PeerState); ok {
+				ps.lightBlockDone()
+			}
+			if err != nil {
+				conR.Logger.Error("Peer sent us invalid light block", "peer", info.peer,
+					"height", info.lightBlock.Height, "err", err)
+				conR.Switch.StopPeerForError(info.peer, err)
+			}
+
+		case <-conR.Quit():
+			return
+		}
+	}
+}
+
 // String returns a string representation of the Reactor.
 // NOTE: For now, it is just a hard-coded string to avoid accessing unprotected shared variables.
 // TODO: improve!
@@ -936,6 +1018,8 @@ type PeerState struct {
 	mtx   sync.Mutex             // NOTE: Modify below using setters, never directly.
 	PRS   cstypes.PeerRoundState `json:"round_state"` // Exposed.
 	Stats *peerStateStats        `json:"stats"`       // Exposed.
+
+	lightBlockQueued bool // a light block of the peer waits to be verified
 }
 
 // peerStateStats holds internal statistics for a peer.
@@ -1210,6 +1294,27 @@ func (ps *PeerState) RecordVote() int {
 	return ps.Stats.Votes
 }
 
+// queueLightBlock marks a light block of the peer as queued. It returns false
+// if one is already queued.
+func (ps *PeerState) queueLightBlock() bool {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if ps.lightBlockQueued {
+		return false
+	}
+	ps.lightBlockQueued = true
+	return true
+}
+
+// lightBlockDone marks the light block queued for the peer as handled.
+func (ps *PeerState) lightBlockDone() {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	ps.lightBlockQueued = false
+}
+
 // VotesSent returns the number of blocks for which peer has been sending us
 // votes.
 func (ps *PeerState) VotesSent() int {
@@ -1418,6 +1523,9 @@ func init() {
 	tmjson.RegisterType(&HasVoteMessage{}, "augusteum/HasVote")
 	tmjson.RegisterType(&VoteSetMaj23Message{}, "augusteum/VoteSetMaj23")
 	tmjson.RegisterType(&VoteSetBitsMessage{}, "augusteum/VoteSetBits")
+	tmjson.RegisterType(&LightBlockMessage{}, "augusteum/LightBlock")
+	tmjson.RegisterType(&VotesRequestMessage{}, "augusteum/VotesRequest")
+	tmjson.RegisterType(&VotesResponseMessage{}, "augusteum/VotesResponse")
 }
 
 func decodeMsg(bz []byte) (msg Message, err error) {
@@ -1724,3 +1832,81 @@ func (m *VoteSetBitsMessage) String() string {
 }
 
 //-------------------------------------
+
+// LightBlockMessage is sent to report a signed header conflicting with the one
+// committed by the chain at the same height. Full nodes receiving it turn it
+// into LightClientAttackEvidence.
+type LightBlockMessage struct {
+	LightBlock *types.LightBlock
+}
+
+// ValidateBasic performs basic validation.
+func (m *LightBlockMessage) ValidateBasic() error {
+	if m.LightBlock == nil {
+		return errors.New("nil LightBlock")
+	}
+	if m.LightBlock.SignedHeader == nil || m.LightBlock.Header == nil {
+		return errors.New("missing SignedHeader")
+	}
+	if err := m.LightBlock.ValidateBasic(m.LightBlock.ChainID); err != nil {
+		return fmt.Errorf("wrong LightBlock: %v", err)
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *LightBlockMessage) String() string {
+	return fmt.Sprintf("[LightBlock %v/%X]", m.LightBlock.Height, m.LightBlock.Hash())
+}
+
+//-------------------------------------
+
+// VotesRequestMessage asks a peer for the votes of a validator it has for its
+// current height and last commit. It is sent by nodes which don't sign before
+// making sure their validator key isn't used by another node.
+type VotesRequestMessage struct {
+	ValidatorAddress crypto.Address
+}
+
+// ValidateBasic performs basic validation.
+func (m *VotesRequestMessage) ValidateBasic() error {
+	if len(m.ValidatorAddress) != crypto.AddressSize {
+		return fmt.Errorf("expected ValidatorAddress size to be %d bytes, got %d bytes",
+			crypto.AddressSize,
+			len(m.ValidatorAddress),
+		)
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *VotesRequestMessage) String() string {
+	return fmt.Sprintf("[VotesRequest %X]", m.ValidatorAddress)
+}
+
+//-------------------------------------
+
+// VotesResponseMessage is sent in response to a VotesRequestMessage.
+type VotesResponseMessage struct {
+	Votes []*types.Vote
+}
+
+// ValidateBasic performs basic validation.
+func (m *VotesResponseMessage) ValidateBasic() error {
+	if len(m.Votes) > maxVotesResponseSize {
+		return fmt.Errorf("too many votes: %d, max: %d", len(m.Votes), maxVotesResponseSize)
+	}
+	for i, vote := range m.Votes {
+		if err := vote.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid vote #%d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *VotesResponseMessage) String() string {
+	return fmt.Sprintf("[VotesResponse %v]", m.Votes)
+}
+
+//-------------------------------------
@@ -276,6 +276,31 @@ func TestReactorReceivePanicsIfInitPeerHasntBeenCalledYet(t *testing.T) {
 	})
 }
 
+// Test a node with the double sign guard asks its peers for the votes of its key
+// and signs again once a full height passed without any.
+func TestReactorDoubleSignGuard(t *testing.T) {
+	N := 4
+	// the guarded validator doesn't propose, so the others need to time out
+	css, cleanup := randConsensusNet(N, "consensus_reactor_test", NewTimeoutTicker, newCounter)
+	defer cleanup()
+	css[0].config.DoubleSignGuard = true
+	reactors, blocksSubs, eventBuses := startConsensusNet(t, css, N)
+	defer stopConsensusNet(log.TestingLogger(), reactors, eventBuses)
+
+	// the other validators have enough voting power to commit without the
+	// guarded one
+	for i := 0; i < 3; i++ {
+		timeoutWaitGroup(t, N, func(j int) {
+			<-blocksSubs[j].Out()
+		}, css)
+	}
+
+	css[0].mtx.RLock()
+	defer css[0].mtx.RUnlock()
+	assert.True(t, css[0].doubleSignGuardAnswered)
+	assert.Zero(t, css[0].doubleSignGuardHeight)
+}
+
 // Test we record stats about votes and block parts from other peers.
 func TestReactorRecordsVotesAndBlockParts(t *testing.T) {
 	N := 4
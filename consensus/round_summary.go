@@ -0,0 +1,195 @@
+package consensus
+
+import (
+	"time"
+
+	cstypes "github.com/creatachain/augusteum/consensus/types"
+	tmproto "github.com/creatachain/augusteum/proto/augusteum/types"
+	"github.com/creatachain/augusteum/types"
+	tmtime "github.com/creatachain/augusteum/types/time"
+)
+
+// number of decided heights whose summaries are kept
+const maxHeightSummaries = 100
+
+// roundTimings tracks the timings of the height in progress and keeps the
+// summaries of the last decided heights in a ring buffer.
+// NOTE: Not thread safe, guarded by the consensus state mutex.
+type roundTimings struct {
+	history []cstypes.HeightSummary
+	next    int // index of the oldest summary once the buffer is full
+
+	current   cstypes.HeightSummary
+	step      cstypes.RoundStepType
+	stepStart time.Time
+}
+
+func newRoundTimings(size int) *roundTimings {
+	return &roundTimings{history: make([]cstypes.HeightSummary, 0, size)}
+}
+
+func (rt *roundTimings) push(summary cstypes.HeightSummary) {
+	if len(rt.history) < cap(rt.history) {
+		rt.history = append(rt.history, summary)
+		return
+	}
+	rt.history[rt.next] = summary
+	rt.next = (rt.next + 1) % len(rt.history)
+}
+
+// last returns up to limit summaries, most recent first.
+func (rt *roundTimings) last(limit int) []cstypes.HeightSummary {
+	if limit <= 0 || limit > len(rt.history) {
+		limit = len(rt.history)
+	}
+	summaries := make([]cstypes.HeightSummary, 0, limit)
+	for i := 0; i < limit; i++ {
+		idx := (rt.next - 1 - i + 2*len(rt.history)) % len(rt.history)
+		summaries = append(summaries, rt.history[idx])
+	}
+	return summaries
+}
+
+// round returns the summary of the given round of the height in progress or
+// of one of the kept decided heights, or nil if the round wasn't entered.
+func (rt *roundTimings) round(height int64, round int32) *cstypes.RoundSummary {
+	summary := &rt.current
+	if height != rt.current.Height {
+		if summary = rt.height(height); summary == nil {
+			return nil
+		}
+	}
+	for i := len(summary.Rounds) - 1; i >= 0; i-- {
+		if summary.Rounds[i].Round == round {
+			return &summary.Rounds[i]
+		}
+	}
+	return nil
+}
+
+// height returns the summary of the given decided height, or nil if it isn't
+// kept.
+func (rt *roundTimings) height(height int64) *cstypes.HeightSummary {
+	for i := range rt.history {
+		if rt.history[i].Height == height {
+			return &rt.history[i]
+		}
+	}
+	return nil
+}
+
+// GetHeightSummaries returns the round summaries of up to limit of the last
+// decided heights, most recent first. All kept summaries are returned if limit
+// is not positive.
+func (cs *State) GetHeightSummaries(limit int) []cstypes.HeightSummary {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+	return cs.roundTimings.last(limit)
+}
+
+// recordStep closes the timing of the previous step and opens the one of
+// cs.Step. It's called on every step transition.
+func (cs *State) recordStep() {
+	if cs.replayMode {
+		return
+	}
+
+	rt, now := cs.roundTimings, tmtime.Now()
+	if !rt.stepStart.IsZero() {
+		d := now.Sub(rt.stepStart)
+		cs.metrics.StepDurationSeconds.With("step", rt.step.String()).Observe(d.Seconds())
+		if n := len(rt.current.Rounds); n > 0 {
+			round := &rt.current.Rounds[n-1]
+			round.Steps = append(round.Steps, cstypes.StepDuration{Step: rt.step.String(), Duration: d})
+		}
+	}
+	rt.step, rt.stepStart = cs.Step, now
+
+	switch cs.Step {
+	case cstypes.RoundStepNewHeight:
+		if !rt.current.CommitTime.IsZero() {
+			rt.push(rt.current)
+		}
+		rt.current = cstypes.HeightSummary{Height: cs.Height}
+
+	case cstypes.RoundStepNewRound:
+		if rt.current.Height != cs.Height {
+			rt.current = cstypes.HeightSummary{Height: cs.Height}
+		}
+		summary := cstypes.RoundSummary{Round: cs.Round, StartTime: now}
+		if cs.Validators != nil {
+			summary.Proposer = cs.Validators.GetProposer().Address
+		}
+		rt.current.Rounds = append(rt.current.Rounds, summary)
+
+	case cstypes.RoundStepCommit:
+		rt.current.CommitTime = cs.CommitTime
+		rt.current.CommitRound = cs.CommitRound
+	}
+}
+
+// recordProposal records when the proposal of the current round was received.
+func (cs *State) recordProposal() {
+	round := cs.roundTimings.round(cs.Height, cs.Round)
+	if cs.replayMode || round == nil || round.ProposalLatency != 0 {
+		return
+	}
+	round.ProposalLatency = tmtime.Now().Sub(round.StartTime)
+	cs.metrics.ProposalReceiveLatencySeconds.Observe(round.ProposalLatency.Seconds())
+}
+
+// recordProposalBlock records when the last part of the proposal block of the
+// current round was received.
+func (cs *State) recordProposalBlock() {
+	round := cs.roundTimings.round(cs.Height, cs.Round)
+	if cs.replayMode || round == nil || round.ProposalBlockLatency != 0 {
+		return
+	}
+	round.ProposalBlockLatency = tmtime.Now().Sub(round.StartTime)
+	cs.metrics.ProposalBlockReceiveLatencySeconds.Observe(round.ProposalBlockLatency.Seconds())
+}
+
+// recordVote records the arrival of a vote of the current height, or a
+// precommit of the last commit, whether it completed +2/3 of the votes of its
+// type or arrived after them.
+func (cs *State) recordVote(vote *types.Vote) {
+	round := cs.roundTimings.round(vote.Height, vote.Round)
+	if cs.replayMode || round == nil {
+		return
+	}
+
+	var (
+		voteType string
+		voteSet  *types.VoteSet
+		quorum   *time.Duration
+	)
+	switch vote.Type {
+	case tmproto.PrevoteType:
+		voteType, voteSet, quorum = "prevote", cs.Votes.Prevotes(vote.Round), &round.PrevoteQuorumLatency
+	case tmproto.PrecommitType:
+		voteType, voteSet, quorum = "precommit", cs.Votes.Precommits(vote.Round), &round.PrecommitQuorumLatency
+		if vote.Height != cs.Height {
+			voteSet = cs.LastCommit
+		}
+	default:
+		return
+	}
+
+	latency := tmtime.Now().Sub(round.StartTime)
+	cs.metrics.VoteArrivalLatencySeconds.With(
+		"vote_type", voteType,
+		"validator_address", vote.ValidatorAddress.String(),
+	).Observe(latency.Seconds())
+
+	switch {
+	case *quorum != 0:
+		round.LateVotes = append(round.LateVotes, cstypes.LateVote{
+			ValidatorAddress: vote.ValidatorAddress,
+			Type:             vote.Type,
+			Delay:            latency - *quorum,
+		})
+	case voteSet.HasTwoThirdsAny():
+		*quorum = latency
+		cs.metrics.QuorumLatencySeconds.With("vote_type", voteType).Observe(latency.Seconds())
+	}
+}
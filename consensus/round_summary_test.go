@@ -0,0 +1,116 @@
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cstypes "github.com/creatachain/augusteum/consensus/types"
+	tmproto "github.com/creatachain/augusteum/proto/augusteum/types"
+	"github.com/creatachain/augusteum/types"
+)
+
+func TestRoundTimingsRingBuffer(t *testing.T) {
+	rt := newRoundTimings(3)
+	assert.Empty(t, rt.last(0))
+
+	for h := int64(1); h <= 5; h++ {
+		rt.push(cstypes.HeightSummary{Height: h})
+	}
+
+	heights := func(summaries []cstypes.HeightSummary) []int64 {
+		hs := make([]int64, 0, len(summaries))
+		for _, s := range summaries {
+			hs = append(hs, s.Height)
+		}
+		return hs
+	}
+	assert.Equal(t, []int64{5, 4, 3}, heights(rt.last(0)))
+	assert.Equal(t, []int64{5, 4}, heights(rt.last(2)))
+	assert.Equal(t, []int64{5, 4, 3}, heights(rt.last(10)))
+}
+
+func TestStateHeightSummaries(t *testing.T) {
+	cs, _ := randState(1)
+	height, round := cs.Height, cs.Round
+
+	newRoundCh := subscribe(cs.eventBus, types.EventQueryNewRound)
+	startTestRound(cs, height, round)
+	ensureNewRound(newRoundCh, height, round)
+
+	// a single validator decides every height in one round
+	ensureNewRound(newRoundCh, height+1, 0)
+
+	summaries := cs.GetHeightSummaries(0)
+	require.Len(t, summaries, 1)
+	summary := summaries[0]
+	assert.Equal(t, height, summary.Height)
+	assert.Equal(t, int32(0), summary.CommitRound)
+	assert.False(t, summary.CommitTime.IsZero())
+
+	require.Len(t, summary.Rounds, 1)
+	r := summary.Rounds[0]
+	assert.Equal(t, round, r.Round)
+	assert.Equal(t, cs.privValidatorPubKey.Address(), types.Address(r.Proposer))
+	assert.NotZero(t, r.ProposalLatency)
+	assert.NotZero(t, r.ProposalBlockLatency)
+	assert.NotZero(t, r.PrevoteQuorumLatency)
+	assert.NotZero(t, r.PrecommitQuorumLatency)
+	assert.Empty(t, r.LateVotes)
+
+	steps := make([]string, 0, len(r.Steps))
+	for _, s := range r.Steps {
+		steps = append(steps, s.Step)
+	}
+	assert.Equal(t, []string{
+		cstypes.RoundStepNewRound.String(),
+		cstypes.RoundStepPropose.String(),
+		cstypes.RoundStepPrevote.String(),
+		cstypes.RoundStepPrecommit.String(),
+		cstypes.RoundStepCommit.String(),
+	}, steps)
+}
+
+func TestStateHeightSummariesLastCommitLateVote(t *testing.T) {
+	cs1, vss := randState(4)
+	height, round := cs1.Height, cs1.Round
+	// wait in the new height step for the last precommit
+	cs1.config.TimeoutCommit = time.Hour
+
+	voteCh := subscribeUnBuffered(cs1.eventBus, types.EventQueryVote)
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+	newBlockCh := subscribe(cs1.eventBus, types.EventQueryNewBlock)
+
+	startTestRound(cs1, height, round)
+	ensureNewRound(newRoundCh, height, round)
+	ensurePrevote(voteCh, height, round)
+	rs := cs1.GetRoundState()
+	propBlockHash, propPartSetHeader := rs.ProposalBlock.Hash(), rs.ProposalBlockParts.Header()
+
+	signAddVotes(cs1, tmproto.PrevoteType, propBlockHash, propPartSetHeader, vss[1:3]...)
+	ensurePrevote(voteCh, height, round)
+	ensurePrevote(voteCh, height, round)
+	ensurePrecommit(voteCh, height, round)
+	signAddVotes(cs1, tmproto.PrecommitType, propBlockHash, propPartSetHeader, vss[1:3]...)
+	ensurePrecommit(voteCh, height, round)
+	ensurePrecommit(voteCh, height, round)
+	ensureNewBlock(newBlockCh, height)
+
+	// the last precommit arrives during the commit timeout
+	signAddVotes(cs1, tmproto.PrecommitType, propBlockHash, propPartSetHeader, vss[3])
+	ensurePrecommit(voteCh, height, round)
+	ensureNewRound(newRoundCh, height+1, 0)
+
+	summaries := cs1.GetHeightSummaries(0)
+	require.Len(t, summaries, 1)
+	require.Equal(t, height, summaries[0].Height)
+	require.Len(t, summaries[0].Rounds, 1)
+	lateVotes := summaries[0].Rounds[0].LateVotes
+	require.Len(t, lateVotes, 1)
+	pubKey, err := vss[3].GetPubKey()
+	require.NoError(t, err)
+	assert.Equal(t, pubKey.Address(), types.Address(lateVotes[0].ValidatorAddress))
+	assert.Equal(t, tmproto.PrecommitType, lateVotes[0].Type)
+}
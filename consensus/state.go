@@ -68,6 +68,8 @@ type txNotifier interface {
 type evidencePool interface {
 	// reports conflicting votes to the evidence pool to be processed into evidence
 	ReportConflictingVotes(voteA, voteB *types.Vote)
+	// verifies and adds evidence formed from conflicting light blocks
+	AddEvidence(ev types.Evidence) error
 }
 
 // State handles execution of the consensus algorithm.
@@ -101,6 +103,11 @@ type State struct {
 	// privValidator pubkey, memoized for the duration of one block
 	// to avoid extra requests to HSM
 	privValidatorPubKey crypto.PubKey
+	// height from which we sign again, unless a vote of our validator key is
+	// received from a peer before; zero once the double sign guard is lifted
+	doubleSignGuardHeight int64
+	// whether a peer answered our request for the votes of our validator key
+	doubleSignGuardAnswered bool
 
 	// state changes may be triggered by: msgs from peers,
 	// msgs from ourself, or by timeouts
@@ -139,6 +146,9 @@ type State struct {
 
 	// for reporting metrics
 	metrics *Metrics
+
+	// timings of the current height and summaries of the last ones
+	roundTimings *roundTimings
 }
 
 // StateOption sets an optional parameter on the State.
@@ -169,6 +179,7 @@ func NewState(
 		evpool:           evpool,
 		evsw:             tmevents.NewEventSwitch(),
 		metrics:          NopMetrics(),
+		roundTimings:     newRoundTimings(maxHeightSummaries),
 	}
 
 	// set function defaults (may be overwritten before calling Start)
@@ -373,6 +384,14 @@ func (cs *State) OnStart() error {
 	if err := cs.checkDoubleSigningRisk(cs.Height); err != nil {
 		return err
 	}
+	// The current height may have started before us, so the guard holds until
+	// the next height, which is observed from its start, is committed.
+	if cs.config.DoubleSignGuard && cs.privValidator != nil {
+		cs.doubleSignGuardHeight = cs.Height + 1
+		cs.doubleSignGuardAnswered = false
+		cs.Logger.Info("double sign guard: not signing until peers were asked for votes of our key "+
+			"and a full height passes without any", "height", cs.doubleSignGuardHeight)
+	}
 
 	// now start the receiveRoutine
 	go cs.receiveRoutine(0)
@@ -538,6 +557,56 @@ func (cs *State) scheduleTimeout(duration time.Duration, height int64, round int
 	cs.timeoutTicker.ScheduleTimeout(timeoutInfo{duration, height, round, step})
 }
 
+// proposeTimeout returns how long to wait for a proposal in the given round.
+// Timeouts set in the consensus params take precedence over the local config.
+func (cs *State) proposeTimeout(round int32) time.Duration {
+	tp := cs.state.ConsensusParams.Timeout
+	return roundTimeout(tp.Propose, tp.ProposeDelta, cs.config.TimeoutPropose, cs.config.TimeoutProposeDelta, round)
+}
+
+// prevoteTimeout returns how long to wait after receiving +2/3 prevotes for
+// "anything" in the given round.
+func (cs *State) prevoteTimeout(round int32) time.Duration {
+	tp := cs.state.ConsensusParams.Timeout
+	return roundTimeout(tp.Prevote, tp.PrevoteDelta, cs.config.TimeoutPrevote, cs.config.TimeoutPrevoteDelta, round)
+}
+
+// precommitTimeout returns how long to wait after receiving +2/3 precommits
+// for "anything" in the given round.
+func (cs *State) precommitTimeout(round int32) time.Duration {
+	tp := cs.state.ConsensusParams.Timeout
+	return roundTimeout(tp.Precommit, tp.PrecommitDelta, cs.config.TimeoutPrecommit, cs.config.TimeoutPrecommitDelta,
+		round)
+}
+
+// commitTime returns when to start the next height after committing a block
+// at time t.
+func (cs *State) commitTime(t time.Time) time.Time {
+	if timeout := cs.state.ConsensusParams.Timeout.Commit; timeout != nil {
+		return t.Add(*timeout)
+	}
+	return cs.config.Commit(t)
+}
+
+// bypassCommitTimeout returns true if we should move on to the next height as
+// soon as we have all the precommits.
+func (cs *State) bypassCommitTimeout() bool {
+	if bypass := cs.state.ConsensusParams.Timeout.BypassCommitTimeout; bypass != nil {
+		return *bypass
+	}
+	return cs.config.SkipTimeoutCommit
+}
+
+func roundTimeout(timeout, delta *time.Duration, defaultTimeout, defaultDelta time.Duration, round int32) time.Duration {
+	if timeout != nil {
+		defaultTimeout = *timeout
+	}
+	if delta != nil {
+		defaultDelta = *delta
+	}
+	return defaultTimeout + defaultDelta*time.Duration(round)
+}
+
 // send a msg into the receiveRoutine regarding our own proposal, block part, or vote
 func (cs *State) sendInternalMessage(mi msgInfo) {
 	select {
@@ -568,6 +637,15 @@ func (cs *State) reconstructLastCommit(state sm.State) {
 		panic("failed to reconstruct last commit; does not have +2/3 maj")
 	}
 
+	// restore the vote extensions, which aren't part of the seen commit
+	for _, vote := range cs.blockStore.LoadExtendedVotes(state.LastBlockHeight) {
+		v := lastPrecommits.GetByIndex(vote.ValidatorIndex)
+		if v != nil && bytes.Equal(v.Signature, vote.Signature) {
+			v.Extension = vote.Extension
+			v.ExtensionSignature = vote.ExtensionSignature
+		}
+	}
+
 	cs.LastCommit = lastPrecommits
 }
 
@@ -648,19 +726,9 @@ func (cs *State) updateToState(state sm.State) {
 	cs.updateHeight(height)
 	cs.updateRoundStep(0, cstypes.RoundStepNewHeight)
 
-	if cs.CommitTime.IsZero() {
-		// "Now" makes it easier to sync up dev nodes.
-		// We add timeoutCommit to allow transactions
-		// to be gathered for the first block.
-		// And alternative solution that relies on clocks:
-		// cs.StartTime = state.LastBlockTime.Add(timeoutCommit)
-		cs.StartTime = cs.config.Commit(tmtime.Now())
-	} else {
-		cs.StartTime = cs.config.Commit(cs.CommitTime)
-	}
-
 	cs.Validators = validators
 	cs.Proposal = nil
+	cs.ProposalReceiveTime = time.Time{}
 	cs.ProposalBlock = nil
 	cs.ProposalBlockParts = nil
 	cs.LockedRound = -1
@@ -676,6 +744,18 @@ func (cs *State) updateToState(state sm.State) {
 
 	cs.state = state
 
+	// The commit timeout is taken from the params of the new state.
+	if cs.CommitTime.IsZero() {
+		// "Now" makes it easier to sync up dev nodes.
+		// We add timeoutCommit to allow transactions
+		// to be gathered for the first block.
+		// And alternative solution that relies on clocks:
+		// cs.StartTime = state.LastBlockTime.Add(timeoutCommit)
+		cs.StartTime = cs.commitTime(tmtime.Now())
+	} else {
+		cs.StartTime = cs.commitTime(cs.CommitTime)
+	}
+
 	// Finally, broadcast RoundState
 	cs.newStep()
 }
@@ -687,6 +767,7 @@ func (cs *State) newStep() {
 	}
 
 	cs.nSteps++
+	cs.recordStep()
 
 	// newStep is called by updateToState in NewState before the eventBus is set!
 	if cs.eventBus != nil {
@@ -855,6 +936,16 @@ func (cs *State) handleMsg(mi msgInfo) {
 		// the peer is sending us CatchupCommit precommits.
 		// We could make note of this and help filter in broadcastHasVoteMessage().
 
+	case *VotesResponseMessage:
+		// the peer answered the request of the double sign guard for the votes
+		// of our validator key
+		for _, vote := range msg.Votes {
+			if _, err := cs.tryAddVote(vote, peerID); err != nil {
+				cs.Logger.Debug("failed to add vote from votes response", "peer", peerID, "vote", vote, "err", err)
+			}
+		}
+		cs.answerDoubleSignGuard(peerID)
+
 	default:
 		cs.Logger.Error("unknown msg type", "type", fmt.Sprintf("%T", msg))
 		return
@@ -993,12 +1084,14 @@ func (cs *State) enterNewRound(height int64, round int32) {
 	} else {
 		logger.Debug("resetting proposal info")
 		cs.Proposal = nil
+		cs.ProposalReceiveTime = time.Time{}
 		cs.ProposalBlock = nil
 		cs.ProposalBlockParts = nil
 	}
 
 	cs.Votes.SetRound(tmmath.SafeAddInt32(round, 1)) // also track next round (round+1) to allow round-skipping
 	cs.TriggeredTimeoutPrecommit = false
+	cs.recordStep()
 
 	if err := cs.eventBus.PublishEventNewRound(cs.NewRoundEvent()); err != nil {
 		cs.Logger.Error("failed publishing new round", "err", err)
@@ -1066,7 +1159,7 @@ func (cs *State) enterPropose(height int64, round int32) {
 	}()
 
 	// If we don't get the proposal and all block parts quick enough, enterPrevote
-	cs.scheduleTimeout(cs.config.Propose(round), height, round, cstypes.RoundStepPropose)
+	cs.scheduleTimeout(cs.proposeTimeout(round), height, round, cstypes.RoundStepPropose)
 
 	// Nothing more to do if we're not a validator
 	if cs.privValidator == nil {
@@ -1099,6 +1192,28 @@ func (cs *State) enterPropose(height int64, round int32) {
 	}
 }
 
+// checkProposalTime checks, with proposer-based timestamps, that the proposal
+// carries the time of its block and, unless it re-proposes a block with a POL,
+// was received within the synchrony bounds of that time.
+func (cs *State) checkProposalTime() error {
+	if !types.IsPBTSEnabled(cs.state.ConsensusParams, cs.Height) {
+		return nil
+	}
+	if cs.Proposal == nil {
+		return errors.New("missing proposal for the proposal block")
+	}
+	if !cs.Proposal.Timestamp.Equal(cs.ProposalBlock.Time) {
+		return fmt.Errorf("proposal time %v differs from block time %v",
+			cs.Proposal.Timestamp, cs.ProposalBlock.Time)
+	}
+	if cs.Proposal.POLRound == -1 &&
+		!cs.Proposal.IsTimely(cs.ProposalReceiveTime, cs.state.ConsensusParams.Synchrony) {
+		return fmt.Errorf("proposal with time %v received at %v is not timely",
+			cs.Proposal.Timestamp, cs.ProposalReceiveTime)
+	}
+	return nil
+}
+
 func (cs *State) isProposer(address []byte) bool {
 	return bytes.Equal(cs.Validators.GetProposer().Address, address)
 }
@@ -1107,6 +1222,11 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 	var block *types.Block
 	var blockParts *types.PartSet
 
+	if cs.doubleSignGuardHeight > 0 {
+		cs.Logger.Info("not proposing, double sign guard is active", "guard_height", cs.doubleSignGuardHeight)
+		return
+	}
+
 	// Decide on block
 	if cs.ValidBlock != nil {
 		// If there is valid block, choose that.
@@ -1128,6 +1248,9 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 	// Make proposal
 	propBlockID := types.BlockID{Hash: block.Hash(), PartSetHeader: blockParts.Header()}
 	proposal := types.NewProposal(height, round, cs.ValidRound, propBlockID)
+	if types.IsPBTSEnabled(cs.state.ConsensusParams, height) {
+		proposal.Timestamp = block.Time
+	}
 	p := proposal.ToProto()
 	if err := cs.privValidator.SignProposal(cs.state.ChainID, p); err == nil {
 		proposal.Signature = p.Signature
@@ -1200,7 +1323,17 @@ func (cs *State) createProposalBlock() (block *types.Block, blockParts *types.Pa
 
 	proposerAddr := cs.privValidatorPubKey.Address()
 
-	return cs.blockExec.CreateProposalBlock(cs.Height, cs.state, commit, proposerAddr)
+	var lastVotes *types.VoteSet
+	if cs.Height > cs.state.InitialHeight {
+		lastVotes = cs.LastCommit
+	}
+
+	block, blockParts, err := cs.blockExec.CreateProposalBlock(cs.Height, cs.state, commit, lastVotes, proposerAddr)
+	if err != nil {
+		cs.Logger.Error("propose step; failed to create proposal block", "err", err)
+		return nil, nil
+	}
+	return block, blockParts
 }
 
 // Enter: `timeoutPropose` after entering Propose.
@@ -1259,6 +1392,12 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 		return
 	}
 
+	if err := cs.checkProposalTime(); err != nil {
+		logger.Info("prevote step: proposal time is invalid", "err", err)
+		cs.signAddVote(tmproto.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+
 	// Prevote cs.ProposalBlock
 	// NOTE: the proposal signature is validated when it is received,
 	// and the proposal block parts are validated as they are received (against the merkle hash in the proposal)
@@ -1294,7 +1433,7 @@ func (cs *State) enterPrevoteWait(height int64, round int32) {
 	}()
 
 	// Wait for some more prevotes; enterPrecommit
-	cs.scheduleTimeout(cs.config.Prevote(round), height, round, cstypes.RoundStepPrevoteWait)
+	cs.scheduleTimeout(cs.prevoteTimeout(round), height, round, cstypes.RoundStepPrevoteWait)
 }
 
 // Enter: `timeoutPrevote` after any +2/3 prevotes.
@@ -1453,7 +1592,7 @@ func (cs *State) enterPrecommitWait(height int64, round int32) {
 	}()
 
 	// wait for some more precommits; enterNewRound
-	cs.scheduleTimeout(cs.config.Precommit(round), height, round, cstypes.RoundStepPrecommitWait)
+	cs.scheduleTimeout(cs.precommitTimeout(round), height, round, cstypes.RoundStepPrecommitWait)
 }
 
 // Enter: +2/3 precommits for block
@@ -1593,6 +1732,7 @@ func (cs *State) finalizeCommit(height int64) {
 		precommits := cs.Votes.Precommits(cs.CommitRound)
 		seenCommit := precommits.MakeCommit()
 		cs.blockStore.SaveBlock(block, blockParts, seenCommit)
+		cs.blockStore.SaveExtendedVotes(block.Height, extendedVotes(precommits))
 	} else {
 		// Happens during replay if we already saved the block but didn't commit
 		logger.Debug("calling finalizeCommit on already stored block", "height", block.Height)
@@ -1664,6 +1804,8 @@ func (cs *State) finalizeCommit(height int64) {
 	// NewHeightStep!
 	cs.updateToState(stateCopy)
 
+	cs.liftDoubleSignGuard(height)
+
 	fail.Fail() // XXX
 
 	// Private validator might have changed it's key pair => refetch pubkey.
@@ -1815,6 +1957,7 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
 
 	proposal.Signature = p.Signature
 	cs.Proposal = proposal
+	cs.ProposalReceiveTime = tmtime.Now()
 	// We don't update cs.ProposalBlockParts if it is already set.
 	// This happens if we're already in cstypes.RoundStepCommit or if there is a valid block in the current round.
 	// TODO: We can check if Proposal is for a different block as this is a sign of misbehavior!
@@ -1822,6 +1965,7 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
 		cs.ProposalBlockParts = types.NewPartSetFromHeader(proposal.BlockID.PartSetHeader)
 	}
 
+	cs.recordProposal()
 	cs.Logger.Info("received proposal", "proposal", proposal)
 	return nil
 }
@@ -1862,6 +2006,8 @@ func (cs *State) addProposalBlockPart(msg *BlockPartMessage, peerID p2p.ID) (add
 		)
 	}
 	if added && cs.ProposalBlockParts.IsComplete() {
+		cs.recordProposalBlock()
+
 		bz, err := ioutil.ReadAll(cs.ProposalBlockParts.GetReader())
 		if err != nil {
 			return added, err
@@ -1946,6 +2092,8 @@ func (cs *State) tryAddVote(vote *types.Vote, peerID p2p.ID) (bool, error) {
 					"round", vote.Round,
 					"type", vote.Type,
 				)
+				// the signature of the conflicting vote was verified
+				cs.checkDoubleSignGuard(vote, peerID)
 
 				return added, err
 			}
@@ -1993,10 +2141,15 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 			return
 		}
 
+		if err := cs.verifyVoteExtension(vote, cs.state.LastValidators); err != nil {
+			return false, err
+		}
+
 		added, err = cs.LastCommit.AddVote(vote)
 		if !added {
 			return
 		}
+		cs.checkDoubleSignGuard(vote, peerID)
 
 		cs.Logger.Debug("added vote to last precommits", "last_commit", cs.LastCommit.StringShort())
 		if err := cs.eventBus.PublishEventVote(types.EventDataVote{Vote: vote}); err != nil {
@@ -2004,9 +2157,10 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 		}
 
 		cs.evsw.FireEvent(types.EventVote, vote)
+		cs.recordVote(vote)
 
 		// if we can skip timeoutCommit and have all the votes now,
-		if cs.config.SkipTimeoutCommit && cs.LastCommit.HasAll() {
+		if cs.bypassCommitTimeout() && cs.LastCommit.HasAll() {
 			// go straight to new round (skip timeout commit)
 			// cs.scheduleTimeout(time.Duration(0), cs.Height, 0, cstypes.RoundStepNewHeight)
 			cs.enterNewRound(cs.Height, 0)
@@ -2022,17 +2176,23 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 		return
 	}
 
+	if err := cs.verifyVoteExtension(vote, cs.Validators); err != nil {
+		return false, err
+	}
+
 	height := cs.Height
 	added, err = cs.Votes.AddVote(vote, peerID)
 	if !added {
 		// Either duplicate, or error upon cs.Votes.AddByIndex()
 		return
 	}
+	cs.checkDoubleSignGuard(vote, peerID)
 
 	if err := cs.eventBus.PublishEventVote(types.EventDataVote{Vote: vote}); err != nil {
 		return added, err
 	}
 	cs.evsw.FireEvent(types.EventVote, vote)
+	cs.recordVote(vote)
 
 	switch vote.Type {
 	case tmproto.PrevoteType:
@@ -2126,7 +2286,7 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 
 			if len(blockID.Hash) != 0 {
 				cs.enterCommit(height, vote.Round)
-				if cs.config.SkipTimeoutCommit && precommits.HasAll() {
+				if cs.bypassCommitTimeout() && precommits.HasAll() {
 					cs.enterNewRound(cs.Height, 0)
 				}
 			} else {
@@ -2144,6 +2304,39 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 	return added, err
 }
 
+// verifyVoteExtension checks that a precommit for a block carries an extension
+// signed by its validator in valSet and, unless it's our own vote, accepted by
+// the application. Votes with an unknown validator are left to the vote set.
+func (cs *State) verifyVoteExtension(vote *types.Vote, valSet *types.ValidatorSet) error {
+	if !types.IsExtendableVote(vote.ToProto()) {
+		return nil
+	}
+	_, val := valSet.GetByIndex(vote.ValidatorIndex)
+	if val == nil {
+		return nil
+	}
+	if err := vote.VerifyExtension(cs.state.ChainID, val.PubKey); err != nil {
+		return err
+	}
+	if cs.privValidatorPubKey != nil && bytes.Equal(vote.ValidatorAddress, cs.privValidatorPubKey.Address()) {
+		return nil
+	}
+	return cs.blockExec.VerifyVoteExtension(vote)
+}
+
+// extendedVotes returns the precommits for a block which carry a vote
+// extension.
+func extendedVotes(precommits *types.VoteSet) []*types.Vote {
+	var votes []*types.Vote
+	for i := int32(0); i < int32(precommits.Size()); i++ {
+		vote := precommits.GetByIndex(i)
+		if vote != nil && len(vote.ExtensionSignature) > 0 && !vote.BlockID.IsZero() {
+			votes = append(votes, vote)
+		}
+	}
+	return votes
+}
+
 // CONTRACT: cs.privValidator is not nil.
 func (cs *State) signVote(
 	msgType tmproto.SignedMsgType,
@@ -2174,8 +2367,17 @@ func (cs *State) signVote(
 	}
 
 	v := vote.ToProto()
+	if types.IsExtendableVote(v) {
+		ext, err := cs.blockExec.ExtendVote(vote)
+		if err != nil {
+			return nil, err
+		}
+		v.Extension = ext
+	}
 	err := cs.privValidator.SignVote(cs.state.ChainID, v)
 	vote.Signature = v.Signature
+	vote.Extension = v.Extension
+	vote.ExtensionSignature = v.ExtensionSignature
 
 	return vote, err
 }
@@ -2216,6 +2418,11 @@ func (cs *State) signAddVote(msgType tmproto.SignedMsgType, hash []byte, header
 		return nil
 	}
 
+	if cs.doubleSignGuardHeight > 0 {
+		cs.Logger.Debug("signAddVote: double sign guard is active", "guard_height", cs.doubleSignGuardHeight)
+		return nil
+	}
+
 	// TODO: pass pubKey to signVote
 	vote, err := cs.signVote(msgType, hash, header)
 	if err == nil {
@@ -2269,6 +2476,94 @@ func (cs *State) checkDoubleSigningRisk(height int64) error {
 	return nil
 }
 
+// checkDoubleSignGuard delays lifting the double sign guard if a peer sent us
+// a vote of our validator key, which means that the key signed for this height
+// before we started, or that another node is running with it. The signature of
+// the vote must have been verified, so that peers can't forge votes of our key
+// to keep the guard active.
+func (cs *State) checkDoubleSignGuard(vote *types.Vote, peerID p2p.ID) {
+	if cs.doubleSignGuardHeight == 0 || peerID == "" || cs.privValidatorPubKey == nil {
+		return
+	}
+	if !bytes.Equal(vote.ValidatorAddress, cs.privValidatorPubKey.Address()) {
+		return
+	}
+	if vote.Height >= cs.doubleSignGuardHeight {
+		cs.doubleSignGuardHeight = vote.Height + 1
+	}
+	cs.Logger.Error("double sign guard: received a vote of our validator key from a peer; "+
+		"make sure no other node is running with it, or restart with --consensus.double_sign_guard=false "+
+		"to override", "vote", vote, "peer", peerID, "guard_height", cs.doubleSignGuardHeight)
+}
+
+// answerDoubleSignGuard records that a peer answered our request for the votes
+// of our validator key. The votes it sent were added before.
+func (cs *State) answerDoubleSignGuard(peerID p2p.ID) {
+	if cs.doubleSignGuardHeight == 0 || cs.doubleSignGuardAnswered {
+		return
+	}
+	cs.Logger.Info("double sign guard: a peer answered our request for votes of our key", "peer", peerID)
+	cs.doubleSignGuardAnswered = true
+}
+
+// doubleSignGuardAddress returns the address of our validator key while the
+// double sign guard is active and no peer answered our request for its votes,
+// nil otherwise.
+func (cs *State) doubleSignGuardAddress() crypto.Address {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+	if cs.doubleSignGuardHeight == 0 || cs.doubleSignGuardAnswered || cs.privValidatorPubKey == nil {
+		return nil
+	}
+	return cs.privValidatorPubKey.Address()
+}
+
+// votesOf returns the votes of the given validator we have for the last commit
+// and, from the latest round down, for the current height. It answers the
+// requests of the double sign guards of our peers.
+func (cs *State) votesOf(address crypto.Address) []*types.Vote {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+
+	var votes []*types.Vote
+	if cs.LastCommit != nil && cs.LastValidators != nil {
+		if idx, _ := cs.LastValidators.GetByAddress(address); idx >= 0 {
+			if vote := cs.LastCommit.GetByIndex(idx); vote != nil {
+				votes = append(votes, vote)
+			}
+		}
+	}
+	idx, _ := cs.Validators.GetByAddress(address)
+	if idx < 0 {
+		return votes
+	}
+	for round := cs.Votes.Round(); round >= 0 && len(votes) < maxVotesResponseSize-1; round-- {
+		if vote := cs.Votes.Prevotes(round).GetByIndex(idx); vote != nil {
+			votes = append(votes, vote)
+		}
+		if vote := cs.Votes.Precommits(round).GetByIndex(idx); vote != nil {
+			votes = append(votes, vote)
+		}
+	}
+	return votes
+}
+
+// liftDoubleSignGuard lifts the double sign guard once a peer answered our
+// request for the votes of our validator key and the given height, which was
+// observed in full, was committed without any vote of our key being received.
+func (cs *State) liftDoubleSignGuard(height int64) {
+	if cs.doubleSignGuardHeight == 0 || height < cs.doubleSignGuardHeight {
+		return
+	}
+	if !cs.doubleSignGuardAnswered {
+		cs.Logger.Info("double sign guard: waiting for a peer to answer our request for votes of our key",
+			"height", height)
+		return
+	}
+	cs.Logger.Info("double sign guard: lifted, no vote of our key was received", "height", height)
+	cs.doubleSignGuardHeight = 0
+}
+
 //---------------------------------------------------------
 
 func CompareHRS(h1 int64, r1 int32, s1 cstypes.RoundStepType, h2 int64, r2 int32, s2 cstypes.RoundStepType) int {
@@ -19,6 +19,7 @@ import (
 	p2pmock "github.com/creatachain/augusteum/p2p/mock"
 	tmproto "github.com/creatachain/augusteum/proto/augusteum/types"
 	"github.com/creatachain/augusteum/types"
+	tmtime "github.com/creatachain/augusteum/types/time"
 )
 
 /*
@@ -30,6 +31,7 @@ x * TestEnterProposeNoValidator - timeout into prevote round
 x * TestEnterPropose - finish propose without timing out (we have the proposal)
 x * TestBadProposal - 2 vals, bad proposal (bad block state hash), should prevote and precommit nil
 x * TestOversizedBlock - block with too many txs should be rejected
+x * TestUntimelyProposal - 2 vals, proposal timestamp out of synchrony bounds, should prevote nil
 FullRoundSuite
 x * TestFullRound1 - 1 val, full successful round
 x * TestFullRoundNil - 1 val, full round of nil
@@ -297,6 +299,129 @@ func TestStateOversizedBlock(t *testing.T) {
 	signAddVotes(cs1, tmproto.PrecommitType, propBlock.Hash(), propBlock.MakePartSet(partSize).Header(), vs2)
 }
 
+func TestStateUntimelyProposal(t *testing.T) {
+	cs1, vss := randState(2)
+	cs1.state.ConsensusParams.Synchrony.PBTSEnableHeight = 1
+	height, round := cs1.Height, cs1.Round
+	vs2 := vss[1]
+
+	partSize := types.BlockPartSizeBytes
+
+	voteCh := subscribe(cs1.eventBus, types.EventQueryVote)
+
+	propBlock, _ := cs1.createProposalBlock()
+	// timestamp the block further in the future than the precision allows
+	propBlock.Time = tmtime.Now().Add(cs1.state.ConsensusParams.Synchrony.Precision + 5*time.Second)
+
+	// make the second validator the proposer by incrementing round
+	round++
+	incrementRound(vss[1:]...)
+
+	propBlockParts := propBlock.MakePartSet(partSize)
+	blockID := types.BlockID{Hash: propBlock.Hash(), PartSetHeader: propBlockParts.Header()}
+	proposal := types.NewProposal(height, round, -1, blockID)
+	proposal.Timestamp = propBlock.Time
+	p := proposal.ToProto()
+	if err := vs2.SignProposal(config.ChainID(), p); err != nil {
+		t.Fatal("failed to sign untimely proposal", err)
+	}
+	proposal.Signature = p.Signature
+
+	if err := cs1.SetProposalAndBlock(proposal, propBlock, propBlockParts, "some peer"); err != nil {
+		t.Fatal(err)
+	}
+
+	// start the machine
+	startTestRound(cs1, height, round)
+
+	// the block is valid, but its proposal was not timely, so we prevote nil
+	ensurePrevote(voteCh, height, round)
+	validatePrevote(t, cs1, round, vss[0], nil)
+}
+
+func TestStateTimeoutsFromParams(t *testing.T) {
+	cs1, _ := randState(1)
+	cfg := cs1.config
+
+	// without timeouts in the params, the local config is used
+	assert.Equal(t, cfg.Propose(2), cs1.proposeTimeout(2))
+	assert.Equal(t, cfg.Prevote(2), cs1.prevoteTimeout(2))
+	assert.Equal(t, cfg.Precommit(2), cs1.precommitTimeout(2))
+	now := tmtime.Now()
+	assert.Equal(t, cfg.Commit(now), cs1.commitTime(now))
+	assert.Equal(t, cfg.SkipTimeoutCommit, cs1.bypassCommitTimeout())
+
+	// timeouts set in the params take precedence, field by field
+	propose, prevoteDelta, commit := 5*time.Second, 2*time.Second, 7*time.Second
+	bypass := !cfg.SkipTimeoutCommit
+	cs1.state.ConsensusParams.Timeout = tmproto.TimeoutParams{
+		Propose:             &propose,
+		PrevoteDelta:        &prevoteDelta,
+		Commit:              &commit,
+		BypassCommitTimeout: &bypass,
+	}
+	assert.Equal(t, propose+2*cfg.TimeoutProposeDelta, cs1.proposeTimeout(2))
+	assert.Equal(t, cfg.TimeoutPrevote+2*prevoteDelta, cs1.prevoteTimeout(2))
+	assert.Equal(t, cfg.Precommit(2), cs1.precommitTimeout(2))
+	assert.Equal(t, now.Add(commit), cs1.commitTime(now))
+	assert.Equal(t, bypass, cs1.bypassCommitTimeout())
+
+	// including when they don't bypass the commit timeout, but the local config
+	// does
+	defer func(skip bool) { cfg.SkipTimeoutCommit = skip }(cfg.SkipTimeoutCommit)
+	cfg.SkipTimeoutCommit, bypass = true, false
+	assert.False(t, cs1.bypassCommitTimeout())
+}
+
+func TestStateDoubleSignGuard(t *testing.T) {
+	cs1, vss := randState(2)
+	height := cs1.Height
+	cs1.doubleSignGuardHeight = height
+
+	// we don't sign while the guard is active
+	assert.Nil(t, cs1.signAddVote(tmproto.PrevoteType, nil, types.PartSetHeader{}))
+
+	// votes of other validators don't matter
+	otherVote := signVote(vss[1], tmproto.PrevoteType, nil, types.PartSetHeader{})
+	added, err := cs1.addVote(otherVote, "some peer")
+	require.NoError(t, err)
+	require.True(t, added)
+	assert.Equal(t, height, cs1.doubleSignGuardHeight)
+
+	// a forged vote of our key doesn't count
+	incrementHeight(vss[0])
+	forged := signVote(vss[1], tmproto.PrecommitType, nil, types.PartSetHeader{})
+	forged.ValidatorAddress, forged.ValidatorIndex = cs1.privValidatorPubKey.Address(), vss[0].Index
+	_, err = cs1.addVote(forged, "some peer")
+	require.Error(t, err)
+	assert.Equal(t, height, cs1.doubleSignGuardHeight)
+
+	// a valid vote of our key from a peer does
+	ownVote := signVote(vss[0], tmproto.PrevoteType, nil, types.PartSetHeader{})
+	added, err = cs1.addVote(ownVote, "some peer")
+	require.NoError(t, err)
+	require.True(t, added)
+	assert.Equal(t, height+1, cs1.doubleSignGuardHeight)
+
+	// peers are asked for the votes of our key, and answer with the ones they have
+	assert.Equal(t, cs1.privValidatorPubKey.Address(), cs1.doubleSignGuardAddress())
+	assert.Equal(t, []*types.Vote{ownVote}, cs1.votesOf(cs1.privValidatorPubKey.Address()))
+
+	// the guard isn't lifted before a peer answered
+	cs1.liftDoubleSignGuard(height + 1)
+	assert.Equal(t, height+1, cs1.doubleSignGuardHeight)
+	cs1.handleMsg(msgInfo{&VotesResponseMessage{Votes: []*types.Vote{ownVote}}, "some peer"})
+	assert.True(t, cs1.doubleSignGuardAnswered)
+	assert.Nil(t, cs1.doubleSignGuardAddress())
+
+	// the guard is lifted once a full height passed without votes of our key
+	cs1.liftDoubleSignGuard(height)
+	assert.Equal(t, height+1, cs1.doubleSignGuardHeight)
+	cs1.liftDoubleSignGuard(height + 1)
+	assert.Zero(t, cs1.doubleSignGuardHeight)
+	assert.NotNil(t, cs1.signAddVote(tmproto.PrecommitType, nil, types.PartSetHeader{}))
+}
+
 //----------------------------------------------------------------------------------------------------
 // FullRoundSuite
 
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"time"
+
+	"github.com/creatachain/augusteum/libs/bytes"
+	tmproto "github.com/creatachain/augusteum/proto/augusteum/types"
+)
+
+// HeightSummary sums up the rounds it took this node to decide a height.
+type HeightSummary struct {
+	Height int64 `json:"height"`
+	// Subjective time when +2/3 precommits for the block were found, zero if
+	// the height isn't committed yet
+	CommitTime  time.Time      `json:"commit_time"`
+	CommitRound int32          `json:"commit_round"`
+	Rounds      []RoundSummary `json:"rounds"`
+}
+
+// RoundSummary holds the timings of a single round. All latencies are
+// measured from the start of the round and are zero if the event didn't
+// happen within the round.
+type RoundSummary struct {
+	Round     int32          `json:"round"`
+	StartTime time.Time      `json:"start_time"`
+	Proposer  bytes.HexBytes `json:"proposer"`
+
+	// Time spent in each step of the round, in the order they were entered
+	Steps []StepDuration `json:"steps"`
+
+	// When the proposal and the last part of the proposal block were received
+	ProposalLatency      time.Duration `json:"proposal_latency"`
+	ProposalBlockLatency time.Duration `json:"proposal_block_latency"`
+
+	// When +2/3 of any prevotes and precommits were received
+	PrevoteQuorumLatency   time.Duration `json:"prevote_quorum_latency"`
+	PrecommitQuorumLatency time.Duration `json:"precommit_quorum_latency"`
+
+	// Votes which arrived after their quorum was reached
+	LateVotes []LateVote `json:"late_votes"`
+}
+
+// StepDuration is the time spent in a step of a round.
+type StepDuration struct {
+	Step     string        `json:"step"`
+	Duration time.Duration `json:"duration"`
+}
+
+// LateVote is a vote which arrived after +2/3 of the votes of its type.
+type LateVote struct {
+	ValidatorAddress bytes.HexBytes        `json:"validator_address"`
+	Type             tmproto.SignedMsgType `json:"type"`
+	// Time between reaching the quorum and receiving the vote
+	Delay time.Duration `json:"delay"`
+}
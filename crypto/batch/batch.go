@@ -0,0 +1,32 @@
+package batch
+
+import (
+	"github.com/creatachain/augusteum/crypto"
+	"github.com/creatachain/augusteum/crypto/ed25519"
+	"github.com/creatachain/augusteum/crypto/sr25519"
+)
+
+// CreateBatchVerifier returns a new batch verifier for the type of the given
+// key, and false if the type doesn't support batch verification.
+func CreateBatchVerifier(pk crypto.PubKey) (crypto.BatchVerifier, bool) {
+	switch pk.Type() {
+	case ed25519.KeyType:
+		return ed25519.NewBatchVerifier(), true
+	case sr25519.KeyType:
+		return sr25519.NewBatchVerifier(), true
+	}
+
+	// case where the key does not support batch verification
+	return nil, false
+}
+
+// SupportsBatchVerifier returns true if the type of the given key supports
+// batch verification.
+func SupportsBatchVerifier(pk crypto.PubKey) bool {
+	switch pk.Type() {
+	case ed25519.KeyType, sr25519.KeyType:
+		return true
+	}
+
+	return false
+}
@@ -40,3 +40,15 @@ type Symmetric interface {
 	Encrypt(plaintext []byte, secret []byte) (ciphertext []byte)
 	Decrypt(ciphertext []byte, secret []byte) (plaintext []byte, err error)
 }
+
+// BatchVerifier verifies several signatures at once, which is faster than
+// verifying them one by one when they are all valid.
+type BatchVerifier interface {
+	// Add appends an entry to the batch. It returns an error if the key isn't
+	// of the type of the verifier or if the signature is malformed.
+	Add(key PubKey, message, signature []byte) error
+	// Verify verifies all the entries of the batch. It returns true if every
+	// signature is valid, and the validity of each signature, in the order
+	// they were added.
+	Verify() (bool, []bool)
+}
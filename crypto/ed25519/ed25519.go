@@ -3,9 +3,14 @@ package ed25519
 import (
 	"bytes"
 	"crypto/subtle"
+	"errors"
 	"fmt"
 	"io"
+	"sync"
 
+	"github.com/oasisprotocol/curve25519-voi/curve"
+	"github.com/oasisprotocol/curve25519-voi/curve/scalar"
+	voied25519 "github.com/oasisprotocol/curve25519-voi/primitives/ed25519"
 	"golang.org/x/crypto/ed25519"
 
 	"github.com/creatachain/augusteum/crypto"
@@ -169,3 +174,133 @@ func (pubKey PubKey) Equals(other crypto.PubKey) bool {
 
 	return false
 }
+
+//-------------------------------------
+
+var _ crypto.BatchVerifier = &BatchVerifier{}
+
+// batchOptions are the rules of the Go crypto/ed25519 package used by
+// PubKey.VerifySignature, except for the cofactored equation, which batch
+// verification requires. With a torsion-free public key and R, both equations
+// agree on every signature.
+var batchOptions = &voied25519.Options{
+	Verify: &voied25519.VerifyOptions{
+		AllowSmallOrderA:   true,
+		AllowSmallOrderR:   true,
+		AllowNonCanonicalA: true,
+	},
+}
+
+// BatchVerifier implements crypto.BatchVerifier for ed25519 signatures. It
+// accepts exactly the signatures accepted by PubKey.VerifySignature: the
+// signatures whose public key or R has a torsion component, which no honest
+// signer produces, are verified individually instead of in the batch.
+type BatchVerifier struct {
+	verifier *voied25519.BatchVerifier
+	entries  []batchEntry
+	batched  int
+}
+
+type batchEntry struct {
+	key      PubKey
+	msg, sig []byte
+	batched  bool
+}
+
+// NewBatchVerifier returns an empty BatchVerifier.
+func NewBatchVerifier() crypto.BatchVerifier {
+	return &BatchVerifier{verifier: voied25519.NewBatchVerifier()}
+}
+
+// Add implements crypto.BatchVerifier.
+func (b *BatchVerifier) Add(key crypto.PubKey, msg, sig []byte) error {
+	pkEd, ok := key.(PubKey)
+	if !ok {
+		return fmt.Errorf("pubkey is not ed25519: %T", key)
+	}
+	if l := len(pkEd); l != PubKeySize {
+		return fmt.Errorf("pubkey size is incorrect; expected: %d, got %d", PubKeySize, l)
+	}
+	// check that the signature is the correct length
+	if len(sig) != SignatureSize {
+		return errors.New("invalid signature")
+	}
+
+	entry := batchEntry{key: pkEd, msg: msg, sig: sig}
+	if isBatchableKey(pkEd) && isCanonicalTorsionFree(sig[:32]) {
+		entry.batched = true
+		b.verifier.AddWithOptions(voied25519.PublicKey(pkEd), msg, sig, batchOptions)
+		b.batched++
+	}
+	b.entries = append(b.entries, entry)
+	return nil
+}
+
+// Verify implements crypto.BatchVerifier. If the batch fails, its entries are
+// verified individually to find out which signatures are invalid.
+func (b *BatchVerifier) Verify() (bool, []bool) {
+	if len(b.entries) == 0 {
+		return false, nil
+	}
+
+	batchValid := b.batched > 0 && b.verifier.VerifyBatchOnly(crypto.CReader())
+	allValid := true
+	valid := make([]bool, len(b.entries))
+	for i, entry := range b.entries {
+		valid[i] = (entry.batched && batchValid) || entry.key.VerifySignature(entry.msg, entry.sig)
+		allValid = allValid && valid[i]
+	}
+	return allValid, valid
+}
+
+// maxTorsionFreeKeys is the maximum number of cached public keys.
+const maxTorsionFreeKeys = 10000
+
+// torsionFreeKeys caches the public keys which can be batch verified, since
+// the keys of the validators are verified again and again.
+var torsionFreeKeys = struct {
+	sync.RWMutex
+	keys map[string]struct{}
+}{keys: make(map[string]struct{})}
+
+// isBatchableKey returns true if the public key is canonical and torsion-free.
+func isBatchableKey(pubKey PubKey) bool {
+	torsionFreeKeys.RLock()
+	_, ok := torsionFreeKeys.keys[string(pubKey)]
+	torsionFreeKeys.RUnlock()
+	if ok {
+		return true
+	}
+	if !isCanonicalTorsionFree(pubKey) {
+		return false
+	}
+
+	torsionFreeKeys.Lock()
+	if len(torsionFreeKeys.keys) >= maxTorsionFreeKeys {
+		torsionFreeKeys.keys = make(map[string]struct{})
+	}
+	torsionFreeKeys.keys[string(pubKey)] = struct{}{}
+	torsionFreeKeys.Unlock()
+	return true
+}
+
+// isCanonicalTorsionFree returns true if the bytes are the canonical encoding
+// of a point of the prime-order subgroup.
+func isCanonicalTorsionFree(encoded []byte) bool {
+	compressed, err := curve.NewCompressedEdwardsYFromBytes(encoded)
+	if err != nil {
+		return false
+	}
+	var point curve.EdwardsPoint
+	if _, err := point.SetCompressedY(compressed); err != nil {
+		return false
+	}
+	var recompressed curve.CompressedEdwardsY
+	recompressed.SetEdwardsPoint(&point)
+	if !bytes.Equal(recompressed[:], encoded) {
+		return false
+	}
+	// the points are public, so the check can be variable time
+	var check curve.EdwardsPoint
+	return check.DoubleScalarMulBasepointVartime(scalar.BASEPOINT_ORDER, &point, scalar.New()).IsIdentity()
+}
@@ -1,13 +1,18 @@
 package ed25519_test
 
 import (
+	"crypto/sha512"
 	"testing"
 
+	"github.com/oasisprotocol/curve25519-voi/curve"
+	"github.com/oasisprotocol/curve25519-voi/curve/scalar"
+	voied25519 "github.com/oasisprotocol/curve25519-voi/primitives/ed25519"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/creatachain/augusteum/crypto"
 	"github.com/creatachain/augusteum/crypto/ed25519"
+	"github.com/creatachain/augusteum/crypto/sr25519"
 )
 
 func TestSignAndValidateEd25519(t *testing.T) {
@@ -28,3 +33,125 @@ func TestSignAndValidateEd25519(t *testing.T) {
 
 	assert.False(t, pubKey.VerifySignature(msg, sig))
 }
+
+func TestBatchSafe(t *testing.T) {
+	v := ed25519.NewBatchVerifier()
+
+	for i := 0; i <= 38; i++ {
+		priv := ed25519.GenPrivKey()
+		pub := priv.PubKey()
+
+		var msg []byte
+		if i%2 == 0 {
+			msg = []byte("easter")
+		} else {
+			msg = []byte("egg")
+		}
+
+		sig, err := priv.Sign(msg)
+		require.NoError(t, err)
+
+		err = v.Add(pub, msg, sig)
+		require.NoError(t, err)
+	}
+
+	ok, valid := v.Verify()
+	require.True(t, ok)
+	require.Len(t, valid, 39)
+}
+
+func TestBatchInvalidSignature(t *testing.T) {
+	v := ed25519.NewBatchVerifier()
+
+	msg := []byte("message")
+	for i := 0; i < 4; i++ {
+		priv := ed25519.GenPrivKey()
+		sig, err := priv.Sign(msg)
+		require.NoError(t, err)
+		if i == 2 {
+			// Mutate the signature, just one bit.
+			sig[7] ^= byte(0x01)
+		}
+		require.NoError(t, v.Add(priv.PubKey(), msg, sig))
+	}
+
+	ok, valid := v.Verify()
+	assert.False(t, ok)
+	assert.Equal(t, []bool{true, true, false, true}, valid)
+}
+
+// signWithTorsion signs msg with the key aB + T, where T is a point of order
+// 8. The signature is valid with the cofactored equation, but not always with
+// the cofactorless one of the Go crypto/ed25519 package.
+func signWithTorsion(t *testing.T, msg []byte) (ed25519.PubKey, []byte) {
+	randScalar := func() *scalar.Scalar {
+		s, err := scalar.New().SetBytesModOrderWide(crypto.CRandBytes(64))
+		require.NoError(t, err)
+		return s
+	}
+	encode := func(p *curve.EdwardsPoint) []byte {
+		var compressed curve.CompressedEdwardsY
+		compressed.SetEdwardsPoint(p)
+		return compressed[:]
+	}
+
+	a, r := randScalar(), randScalar()
+	var A, R curve.EdwardsPoint
+	A.MulBasepoint(curve.ED25519_BASEPOINT_TABLE, a)
+	A.Add(&A, curve.EIGHT_TORSION[1])
+	R.MulBasepoint(curve.ED25519_BASEPOINT_TABLE, r)
+	pubKey, encodedR := encode(&A), encode(&R)
+
+	h := sha512.New()
+	h.Write(encodedR)
+	h.Write(pubKey)
+	h.Write(msg)
+	k, err := scalar.New().SetBytesModOrderWide(h.Sum(nil))
+	require.NoError(t, err)
+
+	S := scalar.New().Mul(k, a)
+	S.Add(S, r)
+	sig := make([]byte, 64)
+	copy(sig, encodedR)
+	require.NoError(t, S.ToBytes(sig[32:]))
+	return pubKey, sig
+}
+
+func TestBatchAgreesWithVerifySignature(t *testing.T) {
+	// find a signature which only the cofactored equation accepts
+	var (
+		pubKey ed25519.PubKey
+		sig    []byte
+		msg    = []byte("message")
+	)
+	for pubKey == nil || pubKey.VerifySignature(msg, sig) {
+		pubKey, sig = signWithTorsion(t, msg)
+	}
+	require.True(t, voied25519.VerifyWithOptions(voied25519.PublicKey(pubKey), msg, sig,
+		&voied25519.Options{Verify: voied25519.VerifyOptionsZIP_215}))
+
+	v := ed25519.NewBatchVerifier()
+	for i := 0; i < 4; i++ {
+		if i == 1 {
+			require.NoError(t, v.Add(pubKey, msg, sig))
+			continue
+		}
+		priv := ed25519.GenPrivKey()
+		valid, err := priv.Sign(msg)
+		require.NoError(t, err)
+		require.NoError(t, v.Add(priv.PubKey(), msg, valid))
+	}
+
+	ok, valid := v.Verify()
+	assert.False(t, ok)
+	assert.Equal(t, []bool{true, false, true, true}, valid)
+}
+
+func TestBatchVerifierRejectsOtherKeys(t *testing.T) {
+	v := ed25519.NewBatchVerifier()
+	priv := sr25519.GenPrivKey()
+	sig, err := priv.Sign([]byte("message"))
+	require.NoError(t, err)
+	assert.Error(t, v.Add(priv.PubKey(), []byte("message"), sig))
+	assert.Error(t, v.Add(ed25519.GenPrivKey().PubKey(), []byte("message"), sig[:10]))
+}
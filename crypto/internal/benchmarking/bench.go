@@ -1,6 +1,7 @@
 package benchmarking
 
 import (
+	"fmt"
 	"io"
 	"testing"
 
@@ -61,6 +62,53 @@ func BenchmarkVerification(b *testing.B, priv crypto.PrivKey) {
 	}
 }
 
+// BenchmarkVerifyBatch benchmarks the batch verification of signatures of
+// the given numbers of signers on a constant message, against verifying them
+// one by one.
+func BenchmarkVerifyBatch(b *testing.B, genPrivKey func() crypto.PrivKey,
+	newBatchVerifier func() crypto.BatchVerifier) {
+
+	// use a short message, so this time doesn't get dominated by hashing.
+	message := []byte("Hello, world!")
+	for _, n := range []int{1, 8, 64, 1024} {
+		pubs := make([]crypto.PubKey, n)
+		sigs := make([][]byte, n)
+		for i := 0; i < n; i++ {
+			priv := genPrivKey()
+			sig, err := priv.Sign(message)
+			if err != nil {
+				b.Fatal(err)
+			}
+			pubs[i], sigs[i] = priv.PubKey(), sig
+		}
+
+		b.Run(fmt.Sprintf("sig-count-%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				v := newBatchVerifier()
+				for j := 0; j < n; j++ {
+					if err := v.Add(pubs[j], message, sigs[j]); err != nil {
+						b.Fatal(err)
+					}
+				}
+				if ok, _ := v.Verify(); !ok {
+					b.Fatal("signature set failed batch verification")
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("sig-count-%d-individually", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < n; j++ {
+					if !pubs[j].VerifySignature(message, sigs[j]) {
+						b.Fatal("signature failed verification")
+					}
+				}
+			}
+		})
+	}
+}
+
 // Below is the aforementioned license.
 
 // Copyright (c) 2012 The Go Authors. All rights reserved.
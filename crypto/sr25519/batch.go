@@ -0,0 +1,51 @@
+package sr25519
+
+import (
+	"fmt"
+
+	"github.com/oasisprotocol/curve25519-voi/primitives/sr25519"
+
+	"github.com/creatachain/augusteum/crypto"
+)
+
+var _ crypto.BatchVerifier = &BatchVerifier{}
+
+// signingContext is the empty context the messages are signed with.
+var signingContext = sr25519.NewSigningContext([]byte{})
+
+// BatchVerifier implements crypto.BatchVerifier for sr25519 signatures.
+type BatchVerifier struct {
+	verifier *sr25519.BatchVerifier
+}
+
+// NewBatchVerifier returns an empty BatchVerifier.
+func NewBatchVerifier() crypto.BatchVerifier {
+	return &BatchVerifier{verifier: sr25519.NewBatchVerifier()}
+}
+
+// Add implements crypto.BatchVerifier.
+func (b *BatchVerifier) Add(key crypto.PubKey, msg, sig []byte) error {
+	pkSr, ok := key.(PubKey)
+	if !ok {
+		return fmt.Errorf("pubkey is not sr25519: %T", key)
+	}
+
+	pk, err := sr25519.NewPublicKeyFromBytes(pkSr)
+	if err != nil {
+		return err
+	}
+	signature, err := sr25519.NewSignatureFromBytes(sig)
+	if err != nil {
+		return err
+	}
+
+	b.verifier.Add(pk, signingContext.NewTranscriptBytes(msg), signature)
+	return nil
+}
+
+// Verify implements crypto.BatchVerifier. If the batch fails, the
+// curve25519-voi batch verifier verifies its entries one by one to find out
+// which signatures are invalid.
+func (b *BatchVerifier) Verify() (bool, []bool) {
+	return b.verifier.Verify(crypto.CReader())
+}
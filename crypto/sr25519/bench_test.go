@@ -24,3 +24,10 @@ func BenchmarkVerification(b *testing.B) {
 	priv := GenPrivKey()
 	benchmarking.BenchmarkVerification(b, priv)
 }
+
+func BenchmarkVerifyBatch(b *testing.B) {
+	genPrivKey := func() crypto.PrivKey {
+		return GenPrivKey()
+	}
+	benchmarking.BenchmarkVerifyBatch(b, genPrivKey, NewBatchVerifier)
+}
@@ -15,7 +15,7 @@ var _ crypto.PubKey = PubKey{}
 // PubKeySize is the number of bytes in an Sr25519 public key.
 const (
 	PubKeySize = 32
-	keyType    = "sr25519"
+	KeyType    = "sr25519"
 )
 
 // PubKeySr25519 implements crypto.PubKey for the Sr25519 signature scheme.
@@ -72,6 +72,6 @@ func (pubKey PubKey) Equals(other crypto.PubKey) bool {
 }
 
 func (pubKey PubKey) Type() string {
-	return keyType
+	return KeyType
 
 }
@@ -25,6 +25,10 @@ const (
 	baseKeyPending   = byte(0x01)
 )
 
+// ErrEvidenceNotFound is returned by Pool.Evidence when the evidence is
+// neither pending nor committed.
+var ErrEvidenceNotFound = errors.New("evidence not found")
+
 // Pool maintains a pool of valid evidence to be broadcasted and committed
 type Pool struct {
 	logger log.Logger
@@ -96,6 +100,42 @@ func (evpool *Pool) PendingEvidence(maxBytes int64) ([]types.Evidence, int64) {
 	return evidence, size
 }
 
+// Evidence looks up the evidence of the given height with the given hash. If
+// the evidence is pending, it is returned with a zero block height. If it is
+// committed, only the height of the block which includes it is returned, as the
+// evidence itself is kept in the block store. Evidence committed before the
+// height of its block was recorded has the height of the evidence instead,
+// which is lower than that of any block including it. ErrEvidenceNotFound is
+// returned when the evidence is neither pending nor committed.
+func (evpool *Pool) Evidence(height int64, hash []byte) (types.Evidence, int64, error) {
+	suffix := keySuffixByHash(height, hash)
+
+	evBytes, err := evpool.evidenceStore.Get(append([]byte{baseKeyPending}, suffix...))
+	if err != nil {
+		return nil, 0, fmt.Errorf("database error: %v", err)
+	}
+	if evBytes != nil {
+		ev, err := bytesToEv(evBytes)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to decode pending evidence: %w", err)
+		}
+		return ev, 0, nil
+	}
+
+	hBytes, err := evpool.evidenceStore.Get(append([]byte{baseKeyCommitted}, suffix...))
+	if err != nil {
+		return nil, 0, fmt.Errorf("database error: %v", err)
+	}
+	if hBytes == nil {
+		return nil, 0, ErrEvidenceNotFound
+	}
+	var h gogotypes.Int64Value
+	if err := proto.Unmarshal(hBytes, &h); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode committed evidence height: %w", err)
+	}
+	return nil, h.Value, nil
+}
+
 // Update takes both the new state and the evidence committed at that height and performs
 // the following operations:
 // 1. Take any conflicting votes from consensus and use the state's LastBlockTime to form
@@ -122,7 +162,7 @@ func (evpool *Pool) Update(state sm.State, ev types.EvidenceList) {
 	evpool.updateState(state)
 
 	// move committed evidence out from the pending pool and into the committed pool
-	evpool.markEvidenceAsCommitted(ev)
+	evpool.markEvidenceAsCommitted(ev, state.LastBlockHeight)
 
 	// prune pending evidence when it has expired. This also updates when the next evidence will expire
 	if evpool.Size() > 0 && state.LastBlockHeight > evpool.pruningHeight &&
@@ -380,9 +420,9 @@ func (evpool *Pool) removePendingEvidence(evidence types.Evidence) {
 	}
 }
 
-// markEvidenceAsCommitted processes all the evidence in the block, marking it as
-// committed and removing it from the pending database.
-func (evpool *Pool) markEvidenceAsCommitted(evidence types.EvidenceList) {
+// markEvidenceAsCommitted processes all the evidence in the block at the given
+// height, marking it as committed and removing it from the pending database.
+func (evpool *Pool) markEvidenceAsCommitted(evidence types.EvidenceList, blockHeight int64) {
 	blockEvidenceMap := make(map[string]struct{}, len(evidence))
 	for _, ev := range evidence {
 		if evpool.isPending(ev) {
@@ -391,10 +431,10 @@ func (evpool *Pool) markEvidenceAsCommitted(evidence types.EvidenceList) {
 		}
 
 		// Add evidence to the committed list. As the evidence is stored in the block store
-		// we only need to record the height that it was saved at.
+		// we only need to record the height of the block that it was saved in.
 		key := keyCommitted(ev)
 
-		h := gogotypes.Int64Value{Value: ev.Height()}
+		h := gogotypes.Int64Value{Value: blockHeight}
 		evBytes, err := proto.Marshal(&h)
 		if err != nil {
 			evpool.logger.Error("failed to marshal committed evidence", "err", err, "key(height/hash)", key)
@@ -618,5 +658,9 @@ func keyPending(evidence types.Evidence) []byte {
 }
 
 func keySuffix(evidence types.Evidence) []byte {
-	return []byte(fmt.Sprintf("%s/%X", bE(evidence.Height()), evidence.Hash()))
+	return keySuffixByHash(evidence.Height(), evidence.Hash())
+}
+
+func keySuffixByHash(height int64, hash []byte) []byte {
+	return []byte(fmt.Sprintf("%s/%X", bE(height), hash))
 }
@@ -92,6 +92,15 @@ func TestEvidencePoolBasic(t *testing.T) {
 	evs, _ = pool.PendingEvidence(defaultEvidenceMaxBytes)
 	assert.Equal(t, 1, len(evs))
 
+	// pending evidence can be looked up by its hash
+	pending, blockHeight, err := pool.Evidence(height, ev.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, ev, pending)
+	assert.Zero(t, blockHeight)
+
+	_, _, err = pool.Evidence(height+1, ev.Hash())
+	assert.Equal(t, evidence.ErrEvidenceNotFound, err)
+
 }
 
 // Tests inbound evidence for the right time and height
@@ -207,6 +216,12 @@ func TestEvidencePoolUpdate(t *testing.T) {
 	if assert.Error(t, err) {
 		assert.Equal(t, "evidence was already committed", err.(*types.ErrInvalidEvidence).Reason.Error())
 	}
+
+	// c) Committed evidence is looked up with the height of its block
+	committed, blockHeight, err := pool.Evidence(ev.Height(), ev.Hash())
+	require.NoError(t, err)
+	assert.Nil(t, committed)
+	assert.Equal(t, height+1, blockHeight)
 }
 
 func TestVerifyPendingEvidencePasses(t *testing.T) {
@@ -0,0 +1,246 @@
+// Package eventlog keeps a log of the events published on an event bus for a
+// window of time, so that subscribers can resume reading it from a cursor
+// after a disconnection without missing events.
+package eventlog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	tmpubsub "github.com/creatachain/augusteum/libs/pubsub"
+	tmsync "github.com/creatachain/augusteum/libs/sync"
+)
+
+// ErrPruned is returned when reading after a cursor followed by items which
+// have been pruned from the log, so that reading from it would skip them.
+var ErrPruned = errors.New("items after the cursor have been pruned")
+
+// Cursor identifies an item of the log. Cursors are based on the time an item
+// was added, and strictly increase, also across restarts as long as the clock
+// does not go backwards. The zero Cursor refers to the start of the log.
+type Cursor int64
+
+// String returns the cursor as 16 hexadecimal digits, which sort like the
+// cursors do.
+func (c Cursor) String() string {
+	if c == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%016x", int64(c))
+}
+
+// ParseCursor parses a cursor returned by Cursor.String.
+func ParseCursor(s string) (Cursor, error) {
+	if s == "" {
+		return 0, nil
+	}
+	c, err := strconv.ParseInt(s, 16, 64)
+	if err != nil || c < 0 {
+		return 0, fmt.Errorf("invalid cursor %q", s)
+	}
+	return Cursor(c), nil
+}
+
+// Item is an event of the log.
+type Item struct {
+	Cursor Cursor
+	Data   interface{}
+	Events map[string][]string
+}
+
+// Info describes the content of the log.
+type Info struct {
+	Oldest Cursor
+	Newest Cursor
+	Size   int
+}
+
+// Page is a page of items read from the log.
+type Page struct {
+	Items []*Item
+	// More is true if more matching items follow the page.
+	More bool
+	// Next is the cursor to read the following page after. Items not matching
+	// the read are skipped by it, so that they can be pruned without the next
+	// read failing.
+	Next Cursor
+}
+
+// Source is a subscription the log is fed from.
+type Source interface {
+	Out() <-chan tmpubsub.Message
+	Cancelled() <-chan struct{}
+}
+
+// Log is a goroutine-safe log of events, retaining the events added within a
+// window of time, up to a maximum number of items.
+type Log struct {
+	windowSize time.Duration
+	maxItems   int
+
+	mtx    tmsync.RWMutex
+	items  []*Item // oldest first
+	newest Cursor
+	pruned Cursor        // the newest pruned cursor
+	ready  chan struct{} // closed and replaced when an item is added
+}
+
+// New returns a log retaining the items of the last windowSize. If maxItems
+// is positive, older items are pruned beyond that number of items.
+func New(windowSize time.Duration, maxItems int) (*Log, error) {
+	if windowSize <= 0 {
+		return nil, errors.New("window size must be positive")
+	}
+	if maxItems < 0 {
+		return nil, errors.New("max items can't be negative")
+	}
+	// the events before the log was created are not available, so reading
+	// after a cursor of a previous log fails instead of silently skipping them
+	now := Cursor(time.Now().UnixNano())
+	return &Log{
+		windowSize: windowSize,
+		maxItems:   maxItems,
+		newest:     now,
+		pruned:     now,
+		ready:      make(chan struct{}),
+	}, nil
+}
+
+// Add adds an event to the log, prunes the items which fall out of the
+// window, and returns the cursor of the new item.
+func (lg *Log) Add(data interface{}, events map[string][]string) Cursor {
+	lg.mtx.Lock()
+	defer lg.mtx.Unlock()
+
+	now := time.Now()
+	cursor := Cursor(now.UnixNano())
+	if cursor <= lg.newest {
+		cursor = lg.newest + 1
+	}
+	lg.newest = cursor
+	lg.items = append(lg.items, &Item{Cursor: cursor, Data: data, Events: events})
+
+	minCursor := Cursor(now.Add(-lg.windowSize).UnixNano())
+	n := 0
+	for n < len(lg.items) && lg.items[n].Cursor < minCursor {
+		n++
+	}
+	if lg.maxItems > 0 && len(lg.items)-n > lg.maxItems {
+		n = len(lg.items) - lg.maxItems
+	}
+	if n > 0 {
+		lg.pruned = lg.items[n-1].Cursor
+		for i := 0; i < n; i++ {
+			lg.items[i] = nil
+		}
+		lg.items = lg.items[n:]
+	}
+
+	close(lg.ready)
+	lg.ready = make(chan struct{})
+	return cursor
+}
+
+// Info returns the oldest and newest cursors of the log and its size.
+func (lg *Log) Info() Info {
+	lg.mtx.RLock()
+	defer lg.mtx.RUnlock()
+
+	info := Info{Size: len(lg.items)}
+	if len(lg.items) > 0 {
+		info.Oldest = lg.items[0].Cursor
+		info.Newest = lg.items[len(lg.items)-1].Cursor
+	}
+	return info
+}
+
+// Scan returns a page of up to max items added after the given cursor for
+// which match returns true, oldest first. If after is zero, it starts from the
+// oldest item retained. It returns ErrPruned if items after the cursor have
+// been pruned.
+func (lg *Log) Scan(after Cursor, max int, match func(*Item) (bool, error)) (Page, error) {
+	lg.mtx.RLock()
+	defer lg.mtx.RUnlock()
+
+	return lg.scan(after, max, match)
+}
+
+func (lg *Log) scan(after Cursor, max int, match func(*Item) (bool, error)) (Page, error) {
+	if after != 0 && after < lg.pruned {
+		return Page{}, fmt.Errorf("%w: cursor %v, newest pruned cursor %v", ErrPruned, after, lg.pruned)
+	}
+
+	page := Page{Next: after}
+	for i := lg.firstAfter(after); i < len(lg.items); i++ {
+		ok, err := match(lg.items[i])
+		if err != nil {
+			return Page{}, err
+		}
+		if !ok {
+			continue
+		}
+		if len(page.Items) == max {
+			page.More = true
+			page.Next = page.Items[len(page.Items)-1].Cursor
+			return page, nil
+		}
+		page.Items = append(page.Items, lg.items[i])
+	}
+	if lg.newest > page.Next {
+		page.Next = lg.newest
+	}
+	return page, nil
+}
+
+// firstAfter returns the index of the first item after the cursor.
+func (lg *Log) firstAfter(after Cursor) int {
+	lo, hi := 0, len(lg.items)
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if lg.items[mid].Cursor <= after {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
+
+// WaitScan is like Scan, but waits for a matching item to be added if there is
+// none, until the context is done, in which case it returns the empty page
+// along with the context's error.
+func (lg *Log) WaitScan(ctx context.Context, after Cursor, max int,
+	match func(*Item) (bool, error)) (Page, error) {
+	for {
+		lg.mtx.RLock()
+		page, err := lg.scan(after, max, match)
+		ready := lg.ready
+		lg.mtx.RUnlock()
+		if err != nil || len(page.Items) > 0 {
+			return page, err
+		}
+
+		select {
+		case <-ready:
+		case <-ctx.Done():
+			return page, ctx.Err()
+		}
+	}
+}
+
+// Consume adds the messages of the subscription to the log until it is
+// cancelled. It's meant to consume an unbuffered subscription, so that no
+// event is missed.
+func (lg *Log) Consume(src Source) {
+	for {
+		select {
+		case msg := <-src.Out():
+			lg.Add(msg.Data(), msg.Events())
+		case <-src.Cancelled():
+			return
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package eventlog_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/creatachain/augusteum/libs/eventlog"
+	tmpubsub "github.com/creatachain/augusteum/libs/pubsub"
+	"github.com/creatachain/augusteum/libs/pubsub/query"
+)
+
+func matchAll(*eventlog.Item) (bool, error) { return true, nil }
+
+func TestCursor(t *testing.T) {
+	for _, c := range []eventlog.Cursor{0, 1, 1 << 40, eventlog.Cursor(time.Now().UnixNano())} {
+		parsed, err := eventlog.ParseCursor(c.String())
+		require.NoError(t, err)
+		assert.Equal(t, c, parsed)
+	}
+	assert.True(t, eventlog.Cursor(15).String() < eventlog.Cursor(16).String())
+
+	_, err := eventlog.ParseCursor("not a cursor")
+	assert.Error(t, err)
+	_, err = eventlog.ParseCursor("-1")
+	assert.Error(t, err)
+}
+
+func TestNew(t *testing.T) {
+	_, err := eventlog.New(0, 0)
+	assert.Error(t, err)
+	_, err = eventlog.New(time.Minute, -1)
+	assert.Error(t, err)
+}
+
+func TestLogScan(t *testing.T) {
+	lg, err := eventlog.New(time.Minute, 0)
+	require.NoError(t, err)
+	page, err := lg.Scan(0, 10, matchAll)
+	require.NoError(t, err)
+	assert.Empty(t, page.Items)
+
+	var cursors []eventlog.Cursor
+	for i := 0; i < 5; i++ {
+		c := lg.Add(i, map[string][]string{"tm.event": {"Test"}, "test.even": {evenOdd(i)}})
+		if len(cursors) > 0 {
+			assert.Greater(t, int64(c), int64(cursors[len(cursors)-1]))
+		}
+		cursors = append(cursors, c)
+	}
+	info := lg.Info()
+	assert.Equal(t, eventlog.Info{Oldest: cursors[0], Newest: cursors[4], Size: 5}, info)
+
+	// pages of all items
+	page, err = lg.Scan(0, 2, matchAll)
+	require.NoError(t, err)
+	require.Len(t, page.Items, 2)
+	assert.Equal(t, 0, page.Items[0].Data)
+	assert.True(t, page.More)
+	assert.Equal(t, cursors[1], page.Next)
+
+	page, err = lg.Scan(page.Next, 10, matchAll)
+	require.NoError(t, err)
+	require.Len(t, page.Items, 3)
+	assert.Equal(t, 2, page.Items[0].Data)
+	assert.False(t, page.More)
+	assert.Equal(t, cursors[4], page.Next)
+
+	// filtered items
+	q := query.MustParse("test.even = 'odd'")
+	match := func(item *eventlog.Item) (bool, error) { return q.Matches(item.Events) }
+	page, err = lg.Scan(cursors[1], 10, match)
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	assert.Equal(t, 3, page.Items[0].Data)
+	assert.Equal(t, cursors[4], page.Next)
+}
+
+func TestLogPrune(t *testing.T) {
+	lg, err := eventlog.New(time.Minute, 3)
+	require.NoError(t, err)
+
+	var cursors []eventlog.Cursor
+	for i := 0; i < 5; i++ {
+		cursors = append(cursors, lg.Add(i, nil))
+	}
+	assert.Equal(t, eventlog.Info{Oldest: cursors[2], Newest: cursors[4], Size: 3}, lg.Info())
+
+	// no item after the cursor of the newest pruned item is missing
+	page, err := lg.Scan(cursors[1], 10, matchAll)
+	require.NoError(t, err)
+	assert.Len(t, page.Items, 3)
+
+	// the item after this cursor is gone
+	_, err = lg.Scan(cursors[0], 10, matchAll)
+	assert.True(t, errors.Is(err, eventlog.ErrPruned), err)
+
+	// as are the events before the log was created
+	_, err = lg.Scan(cursors[0]-eventlog.Cursor(time.Hour), 10, matchAll)
+	assert.True(t, errors.Is(err, eventlog.ErrPruned), err)
+
+	// the window
+	lg, err = eventlog.New(time.Millisecond, 0)
+	require.NoError(t, err)
+	lg.Add(0, nil)
+	time.Sleep(2 * time.Millisecond)
+	c := lg.Add(1, nil)
+	assert.Equal(t, eventlog.Info{Oldest: c, Newest: c, Size: 1}, lg.Info())
+}
+
+func TestLogWaitScan(t *testing.T) {
+	lg, err := eventlog.New(time.Minute, 0)
+	require.NoError(t, err)
+	c := lg.Add(0, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	page, err := lg.WaitScan(ctx, c, 10, matchAll)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Empty(t, page.Items)
+	assert.Equal(t, c, page.Next)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		lg.Add(1, nil)
+	}()
+	page, err = lg.WaitScan(context.Background(), c, 10, matchAll)
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	assert.Equal(t, 1, page.Items[0].Data)
+}
+
+func TestLogConsume(t *testing.T) {
+	s := tmpubsub.NewServer()
+	require.NoError(t, s.Start())
+	t.Cleanup(func() {
+		if err := s.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	lg, err := eventlog.New(time.Minute, 0)
+	require.NoError(t, err)
+	sub, err := s.SubscribeUnbuffered(context.Background(), "EventLog", query.Empty{})
+	require.NoError(t, err)
+	done := make(chan struct{})
+	go func() {
+		lg.Consume(sub)
+		close(done)
+	}()
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, s.PublishWithEvents(context.Background(), i, map[string][]string{"i": {"x"}}))
+	}
+	require.NoError(t, s.UnsubscribeAll(context.Background(), "EventLog"))
+	<-done
+
+	page, err := lg.Scan(0, 10, matchAll)
+	require.NoError(t, err)
+	require.Len(t, page.Items, 3)
+	for i, item := range page.Items {
+		assert.Equal(t, i, item.Data)
+	}
+}
+
+func evenOdd(i int) string {
+	if i%2 == 0 {
+		return "even"
+	}
+	return "odd"
+}
@@ -0,0 +1,197 @@
+package json
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// schemaRefPrefix is the prefix of the references to the schemas of named
+// types, which are OpenAPI components.
+const schemaRefPrefix = "#/components/schemas/"
+
+// modulePath is trimmed from the names of the schemas of the types of this
+// module.
+const modulePath = "github.com/creatachain/augusteum/"
+
+// Schema returns the OpenAPI 3.0 schema object of the JSON encoding of the
+// values of type rt by Marshal. The schemas of named struct types are added to
+// defs, by name, and referred to as "#/components/schemas/<name>".
+//
+// Types implementing json.Marshaler have a custom encoding: their schema is a
+// string if they are based on a string or bytes, and any value otherwise.
+func Schema(rt reflect.Type, defs map[string]interface{}) map[string]interface{} {
+	if name := typeRegistry.name(rt); name != "" {
+		// Marshal wraps registered types even if they're not in an interface
+		return wrappedSchema(name, rt, defs)
+	}
+	return schemaOf(rt, defs)
+}
+
+// SchemaName returns the name of the schema of a named type in the defs of
+// Schema.
+func SchemaName(rt reflect.Type) string {
+	for rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	path := strings.TrimPrefix(rt.PkgPath(), modulePath)
+	return strings.ReplaceAll(path, "/", ".") + "." + rt.Name()
+}
+
+func schemaOf(rt reflect.Type, defs map[string]interface{}) map[string]interface{} {
+	nullable := false
+	for rt.Kind() == reflect.Ptr {
+		nullable = true
+		rt = rt.Elem()
+	}
+
+	var schema map[string]interface{}
+	switch {
+	case rt == timeType:
+		schema = map[string]interface{}{"type": "string", "format": "date-time"}
+
+	case rt.Implements(jsonMarshalerType) || reflect.PtrTo(rt).Implements(jsonMarshalerType):
+		schema = marshalerSchema(rt)
+
+	default:
+		schema = kindSchema(rt, defs)
+	}
+
+	if nullable || (rt.Kind() == reflect.Slice && rt.Elem().Kind() != reflect.Uint8) {
+		if ref, ok := schema["$ref"]; ok {
+			// siblings of $ref are ignored
+			return map[string]interface{}{"allOf": []interface{}{map[string]interface{}{"$ref": ref}},
+				"nullable": true}
+		}
+		schema["nullable"] = true
+	}
+	return schema
+}
+
+func kindSchema(rt reflect.Type, defs map[string]interface{}) map[string]interface{} {
+	switch rt.Kind() {
+	case reflect.Interface:
+		return interfaceSchema(rt, defs)
+
+	case reflect.Array, reflect.Slice:
+		if rt.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": "string", "format": "byte"}
+		}
+		return map[string]interface{}{"type": "array", "items": schemaOf(rt.Elem(), defs)}
+
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": schemaOf(rt.Elem(), defs)}
+
+	case reflect.Struct:
+		return structSchema(rt, defs)
+
+	case reflect.Int64, reflect.Int:
+		return map[string]interface{}{"type": "string", "format": "int64"}
+
+	case reflect.Uint64, reflect.Uint:
+		return map[string]interface{}{"type": "string", "format": "uint64"}
+
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return map[string]interface{}{"type": "integer", "format": "int32"}
+
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+
+	default:
+		// not encodable, e.g. channels
+		return map[string]interface{}{}
+	}
+}
+
+func marshalerSchema(rt reflect.Type) map[string]interface{} {
+	switch {
+	case rt.Kind() == reflect.String,
+		(rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array) && rt.Elem().Kind() == reflect.Uint8:
+		return map[string]interface{}{"type": "string"}
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+func structSchema(rt reflect.Type, defs map[string]interface{}) map[string]interface{} {
+	if rt.Name() != "" {
+		name := SchemaName(rt)
+		if _, ok := defs[name]; !ok {
+			// added before the fields, for recursive types
+			defs[name] = map[string]interface{}{}
+			defs[name] = structFieldsSchema(rt, defs)
+		}
+		return map[string]interface{}{"$ref": schemaRefPrefix + name}
+	}
+	return structFieldsSchema(rt, defs)
+}
+
+func structFieldsSchema(rt reflect.Type, defs map[string]interface{}) map[string]interface{} {
+	properties := make(map[string]interface{})
+	required := []string{}
+	sInfo := makeStructInfo(rt)
+	for i, fInfo := range sInfo.fields {
+		if fInfo.hidden {
+			continue
+		}
+		properties[fInfo.jsonName] = schemaOf(rt.Field(i).Type, defs)
+		if !fInfo.omitEmpty {
+			required = append(required, fInfo.jsonName)
+		}
+	}
+
+	schema := map[string]interface{}{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// interfaceSchema returns the schema of the registered types implementing the
+// interface, wrapped as encoded. An empty interface, e.g. interface{} or
+// TMEventData, can hold any value, so any value matches its schema.
+func interfaceSchema(rt reflect.Type, defs map[string]interface{}) map[string]interface{} {
+	if rt.NumMethod() == 0 {
+		return map[string]interface{}{}
+	}
+
+	typeRegistry.RLock()
+	names := make([]string, 0)
+	for name, tInfo := range typeRegistry.byName {
+		if tInfo.rt.Implements(rt) || reflect.PtrTo(tInfo.rt).Implements(rt) {
+			names = append(names, name)
+		}
+	}
+	typeRegistry.RUnlock()
+
+	if len(names) == 0 {
+		return map[string]interface{}{}
+	}
+	sort.Strings(names)
+	oneOf := make([]interface{}, len(names))
+	for i, name := range names {
+		concrete, _ := typeRegistry.lookup(name)
+		oneOf[i] = wrappedSchema(name, concrete, defs)
+	}
+	return map[string]interface{}{"oneOf": oneOf}
+}
+
+func wrappedSchema(name string, rt reflect.Type, defs map[string]interface{}) map[string]interface{} {
+	for rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"type":  map[string]interface{}{"type": "string", "enum": []string{name}},
+			"value": schemaOf(rt, defs),
+		},
+		"required": []string{"type", "value"},
+	}
+}
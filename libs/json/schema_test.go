@@ -0,0 +1,76 @@
+package json_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/creatachain/augusteum/libs/json"
+)
+
+func TestSchema(t *testing.T) {
+	defs := make(map[string]interface{})
+	schema := json.Schema(reflect.TypeOf(Struct{}), defs)
+
+	name := json.SchemaName(reflect.TypeOf(Struct{}))
+	assert.Equal(t, "libs.json_test.Struct", name)
+	assert.Equal(t, map[string]interface{}{"$ref": "#/components/schemas/" + name}, schema)
+	require.Contains(t, defs, name)
+
+	def := defs[name].(map[string]interface{})
+	properties := def["properties"].(map[string]interface{})
+	assert.NotContains(t, properties, "private")
+	assert.Equal(t, map[string]interface{}{"type": "boolean"}, properties["Bool"])
+	assert.Equal(t, map[string]interface{}{"type": "integer", "format": "int32"}, properties["Int32"])
+	// 64-bit integers are encoded as strings
+	assert.Equal(t, map[string]interface{}{"type": "string", "format": "int64"}, properties["Int64"])
+	assert.Equal(t, map[string]interface{}{"type": "string", "format": "int64", "nullable": true},
+		properties["Int64Ptr"])
+	assert.Equal(t, map[string]interface{}{"type": "string", "format": "byte"}, properties["Bytes"])
+	assert.Equal(t, map[string]interface{}{"type": "string", "format": "date-time"}, properties["Time"])
+	// recursive types refer to themselves
+	assert.Equal(t, map[string]interface{}{
+		"allOf":    []interface{}{map[string]interface{}{"$ref": "#/components/schemas/" + name}},
+		"nullable": true,
+	}, properties["Child"])
+
+	// interfaces are any of the registered types implementing them, wrapped
+	vehicles := properties["Vehicles"].(map[string]interface{})
+	oneOf := vehicles["items"].(map[string]interface{})["oneOf"].([]interface{})
+	require.Len(t, oneOf, 2)
+	boat := oneOf[0].(map[string]interface{})["properties"].(map[string]interface{})
+	assert.Equal(t, []string{"vehicle/boat"}, boat["type"].(map[string]interface{})["enum"])
+	car := oneOf[1].(map[string]interface{})["properties"].(map[string]interface{})
+	assert.Equal(t, []string{"vehicle/car"}, car["type"].(map[string]interface{})["enum"])
+	assert.Contains(t, defs, json.SchemaName(reflect.TypeOf(Car{})))
+
+	// any value matches an empty interface, even though the registered types
+	// implement it
+	assert.Equal(t, map[string]interface{}{}, json.Schema(reflect.TypeOf((*interface{})(nil)).Elem(), defs))
+}
+
+func TestSchemaTags(t *testing.T) {
+	defs := make(map[string]interface{})
+	json.Schema(reflect.TypeOf(Tags{}), defs)
+
+	def := defs[json.SchemaName(reflect.TypeOf(Tags{}))].(map[string]interface{})
+	properties := def["properties"].(map[string]interface{})
+	assert.Len(t, properties, 3)
+	assert.Contains(t, properties, "name")
+	assert.Contains(t, properties, "OmitEmpty")
+	assert.Contains(t, properties, "tags")
+	assert.Equal(t, []string{"name"}, def["required"])
+}
+
+func TestSchemaCustom(t *testing.T) {
+	defs := make(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{}, json.Schema(reflect.TypeOf(CustomValue{}), defs))
+	assert.Equal(t, map[string]interface{}{"nullable": true}, json.Schema(reflect.TypeOf(&CustomPtr{}), defs))
+
+	// registered types are wrapped even outside of interfaces
+	schema := json.Schema(reflect.TypeOf(Boat{}), defs)
+	assert.Equal(t, []string{"type", "value"}, schema["required"])
+	assert.Empty(t, defs[json.SchemaName(reflect.TypeOf(CustomValue{}))])
+}
@@ -1,6 +1,8 @@
 package proxy
 
 import (
+	"time"
+
 	"github.com/creatachain/augusteum/libs/bytes"
 	lrpc "github.com/creatachain/augusteum/light/rpc"
 	rpcclient "github.com/creatachain/augusteum/rpc/client"
@@ -16,6 +18,7 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 		"subscribe":       rpcserver.NewWSRPCFunc(c.SubscribeWS, "query"),
 		"unsubscribe":     rpcserver.NewWSRPCFunc(c.UnsubscribeWS, "query"),
 		"unsubscribe_all": rpcserver.NewWSRPCFunc(c.UnsubscribeAllWS, ""),
+		"events":          rpcserver.NewRPCFunc(makeEventsFunc(c), "query,after,max_items,wait_time"),
 
 		// info API
 		"health":               rpcserver.NewRPCFunc(makeHealthFunc(c), ""),
@@ -26,6 +29,9 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 		"block":                rpcserver.NewRPCFunc(makeBlockFunc(c), "height"),
 		"block_by_hash":        rpcserver.NewRPCFunc(makeBlockByHashFunc(c), "hash"),
 		"block_results":        rpcserver.NewRPCFunc(makeBlockResultsFunc(c), "height"),
+		"blocks_range":         rpcserver.NewRPCFunc(makeBlocksRangeFunc(c), "minHeight,maxHeight"),
+		"header":               rpcserver.NewRPCFunc(makeHeaderFunc(c), "height"),
+		"header_by_hash":       rpcserver.NewRPCFunc(makeHeaderByHashFunc(c), "hash"),
 		"commit":               rpcserver.NewRPCFunc(makeCommitFunc(c), "height"),
 		"tx":                   rpcserver.NewRPCFunc(makeTxFunc(c), "hash,prove"),
 		"tx_search":            rpcserver.NewRPCFunc(makeTxSearchFunc(c), "query,prove,page,per_page,order_by"),
@@ -47,6 +53,8 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 
 		// evidence API
 		"broadcast_evidence": rpcserver.NewRPCFunc(makeBroadcastEvidenceFunc(c), "evidence"),
+		"pending_evidence":   rpcserver.NewRPCFunc(makePendingEvidenceFunc(c), ""),
+		"evidence":           rpcserver.NewRPCFunc(makeEvidenceFunc(c), "hash,height"),
 	}
 }
 
@@ -115,6 +123,30 @@ func makeBlockResultsFunc(c *lrpc.Client) rpcBlockResultsFunc {
 	}
 }
 
+type rpcBlocksRangeFunc func(ctx *rpctypes.Context, minHeight, maxHeight int64) (*ctypes.ResultBlocksRange, error)
+
+func makeBlocksRangeFunc(c *lrpc.Client) rpcBlocksRangeFunc {
+	return func(ctx *rpctypes.Context, minHeight, maxHeight int64) (*ctypes.ResultBlocksRange, error) {
+		return c.BlocksRange(ctx.Context(), minHeight, maxHeight)
+	}
+}
+
+type rpcHeaderFunc func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultHeader, error)
+
+func makeHeaderFunc(c *lrpc.Client) rpcHeaderFunc {
+	return func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultHeader, error) {
+		return c.Header(ctx.Context(), height)
+	}
+}
+
+type rpcHeaderByHashFunc func(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultHeader, error)
+
+func makeHeaderByHashFunc(c *lrpc.Client) rpcHeaderByHashFunc {
+	return func(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultHeader, error) {
+		return c.HeaderByHash(ctx.Context(), hash)
+	}
+}
+
 type rpcCommitFunc func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultCommit, error)
 
 func makeCommitFunc(c *lrpc.Client) rpcCommitFunc {
@@ -141,6 +173,16 @@ func makeTxSearchFunc(c *lrpc.Client) rpcTxSearchFunc {
 	}
 }
 
+type rpcEventsFunc func(ctx *rpctypes.Context, query, after string, maxItems *int,
+	waitTime time.Duration) (*ctypes.ResultEvents, error)
+
+func makeEventsFunc(c *lrpc.Client) rpcEventsFunc {
+	return func(ctx *rpctypes.Context, query, after string, maxItems *int,
+		waitTime time.Duration) (*ctypes.ResultEvents, error) {
+		return c.Events(ctx.Context(), query, after, maxItems, waitTime)
+	}
+}
+
 type rpcValidatorsFunc func(ctx *rpctypes.Context, height *int64,
 	page, perPage *int) (*ctypes.ResultValidators, error)
 
@@ -244,3 +286,19 @@ func makeBroadcastEvidenceFunc(c *lrpc.Client) rpcBroadcastEvidenceFunc {
 		return c.BroadcastEvidence(ctx.Context(), ev)
 	}
 }
+
+type rpcPendingEvidenceFunc func(ctx *rpctypes.Context) (*ctypes.ResultPendingEvidence, error)
+
+func makePendingEvidenceFunc(c *lrpc.Client) rpcPendingEvidenceFunc {
+	return func(ctx *rpctypes.Context) (*ctypes.ResultPendingEvidence, error) {
+		return c.PendingEvidence(ctx.Context())
+	}
+}
+
+type rpcEvidenceFunc func(ctx *rpctypes.Context, hash []byte, height int64) (*ctypes.ResultEvidence, error)
+
+func makeEvidenceFunc(c *lrpc.Client) rpcEvidenceFunc {
+	return func(ctx *rpctypes.Context, hash []byte, height int64) (*ctypes.ResultEvidence, error) {
+		return c.Evidence(ctx.Context(), hash, height)
+	}
+}
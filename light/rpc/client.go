@@ -380,12 +380,23 @@ func (c *Client) BlockResults(ctx context.Context, height *int64) (*ctypes.Resul
 		return nil, err
 	}
 
+	// Verify block results.
+	if err := verifyBlockResults(res, trustedBlock); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// verifyBlockResults verifies the results of a block against the last results
+// hash of the trusted next block.
+func verifyBlockResults(res *ctypes.ResultBlockResults, trustedNextBlock *types.LightBlock) error {
 	// proto-encode BeginBlock events
 	bbeBytes, err := proto.Marshal(&msm.ResponseBeginBlock{
 		Events: res.BeginBlockEvents,
 	})
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Build a Merkle tree of proto-encoded DeliverTx results and get a hash.
@@ -396,21 +407,134 @@ func (c *Client) BlockResults(ctx context.Context, height *int64) (*ctypes.Resul
 		Events: res.EndBlockEvents,
 	})
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Build a Merkle tree out of the above 3 binary slices.
 	rH := merkle.HashFromByteSlices([][]byte{bbeBytes, results.Hash(), ebeBytes})
 
-	// Verify block results.
-	if !bytes.Equal(rH, trustedBlock.LastResultsHash) {
-		return nil, fmt.Errorf("last results %X does not match with trusted last results %X",
-			rH, trustedBlock.LastResultsHash)
+	if !bytes.Equal(rH, trustedNextBlock.LastResultsHash) {
+		return fmt.Errorf("last results %X does not match with trusted last results %X",
+			rH, trustedNextBlock.LastResultsHash)
+	}
+	return nil
+}
+
+// BlocksRange calls rpcclient#BlocksRange and then verifies every block and
+// its results. The results of a block are proven by the next block, so the
+// last block is dropped if the next one is not committed yet.
+func (c *Client) BlocksRange(ctx context.Context, minHeight, maxHeight int64) (*ctypes.ResultBlocksRange, error) {
+	res, err := c.next.BlocksRange(ctx, minHeight, maxHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate res.
+	for i, b := range res.Blocks {
+		if b == nil || b.Block == nil || b.Results == nil {
+			return nil, fmt.Errorf("nil block %d", i)
+		}
+		if err := b.BlockID.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("invalid block id %d: %w", i, err)
+		}
+		if err := b.Block.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("invalid block %d: %w", i, err)
+		}
+		if bmH, bH := b.BlockID.Hash, b.Block.Hash(); !bytes.Equal(bmH, bH) {
+			return nil, fmt.Errorf("blockID %X does not match with block %X",
+				bmH, bH)
+		}
+		if b.Results.Height != b.Block.Height {
+			return nil, fmt.Errorf("results of height %d for block %d", b.Results.Height, b.Block.Height)
+		}
+		if i > 0 && b.Block.Height != res.Blocks[i-1].Block.Height+1 {
+			return nil, fmt.Errorf("block %d follows block %d", b.Block.Height, res.Blocks[i-1].Block.Height)
+		}
+	}
+	if len(res.Blocks) > 0 && res.Blocks[len(res.Blocks)-1].Block.Height >= res.LastHeight {
+		res.Blocks = res.Blocks[:len(res.Blocks)-1]
+	}
+	if len(res.Blocks) == 0 {
+		return res, nil
+	}
+
+	// Verify each block and its results, updating the light client if we're
+	// behind.
+	l, err := c.updateLightClientIfNeededTo(ctx, &res.Blocks[0].Block.Height)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range res.Blocks {
+		if bH, tH := b.Block.Hash(), l.Hash(); !bytes.Equal(bH, tH) {
+			return nil, fmt.Errorf("block header %X does not match with trusted header %X",
+				bH, tH)
+		}
+
+		nextHeight := b.Block.Height + 1
+		if l, err = c.updateLightClientIfNeededTo(ctx, &nextHeight); err != nil {
+			return nil, err
+		}
+		if err := verifyBlockResults(b.Results, l); err != nil {
+			return nil, err
+		}
 	}
 
 	return res, nil
 }
 
+// Header calls rpcclient#Header and then verifies the result.
+func (c *Client) Header(ctx context.Context, height *int64) (*ctypes.ResultHeader, error) {
+	res, err := c.next.Header(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.verifyHeader(ctx, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// HeaderByHash calls rpcclient#HeaderByHash and then verifies the result.
+func (c *Client) HeaderByHash(ctx context.Context, hash []byte) (*ctypes.ResultHeader, error) {
+	res, err := c.next.HeaderByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.verifyHeader(ctx, res); err != nil {
+		return nil, err
+	}
+	if hH := res.Header.Hash(); !bytes.Equal(hH, hash) {
+		return nil, fmt.Errorf("header %X does not match with requested hash %X", hH, hash)
+	}
+	return res, nil
+}
+
+// verifyHeader verifies a header against the trusted header at its height.
+func (c *Client) verifyHeader(ctx context.Context, res *ctypes.ResultHeader) error {
+	// Validate res.
+	if res.Header == nil {
+		return errors.New("empty header")
+	}
+	if err := res.Header.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// Update the light client if we're behind.
+	l, err := c.updateLightClientIfNeededTo(ctx, &res.Header.Height)
+	if err != nil {
+		return err
+	}
+
+	// Verify header.
+	if hH, tH := res.Header.Hash(), l.Hash(); !bytes.Equal(hH, tH) {
+		return fmt.Errorf("header %X does not match with trusted header %X",
+			hH, tH)
+	}
+	return nil
+}
+
 func (c *Client) Commit(ctx context.Context, height *int64) (*ctypes.ResultCommit, error) {
 	// Update the light client if we're behind and retrieve the light block at the requested height
 	// or at the latest height if no height is provided.
@@ -485,6 +609,19 @@ func (c *Client) BroadcastEvidence(ctx context.Context, ev types.Evidence) (*cty
 	return c.next.BroadcastEvidence(ctx, ev)
 }
 
+func (c *Client) PendingEvidence(ctx context.Context) (*ctypes.ResultPendingEvidence, error) {
+	return c.next.PendingEvidence(ctx)
+}
+
+func (c *Client) Evidence(ctx context.Context, hash []byte, height int64) (*ctypes.ResultEvidence, error) {
+	return c.next.Evidence(ctx, hash, height)
+}
+
+func (c *Client) Events(ctx context.Context, query, after string, maxItems *int,
+	waitTime time.Duration) (*ctypes.ResultEvents, error) {
+	return c.next.Events(ctx, query, after, maxItems, waitTime)
+}
+
 func (c *Client) Subscribe(ctx context.Context, subscriber, query string,
 	outCapacity ...int) (out <-chan ctypes.ResultEvent, err error) {
 	return c.next.Subscribe(ctx, subscriber, query, outCapacity...)
@@ -8,25 +8,31 @@ import (
 	"net"
 	"net/http"
 	_ "net/http/pprof" // nolint: gosec // securely exposed on separate, optional port
+	"runtime"
 	"strings"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/cors"
+	"google.golang.org/grpc"
 
 	dbm "github.com/creatachain/tm-db"
 
 	bcv0 "github.com/creatachain/augusteum/blockchain/v0"
 	bcv1 "github.com/creatachain/augusteum/blockchain/v1"
 	bcv2 "github.com/creatachain/augusteum/blockchain/v2"
+	bcv3 "github.com/creatachain/augusteum/blockchain/v3"
 	cfg "github.com/creatachain/augusteum/config"
 	cs "github.com/creatachain/augusteum/consensus"
 	"github.com/creatachain/augusteum/crypto"
 	"github.com/creatachain/augusteum/evidence"
+	"github.com/creatachain/augusteum/libs/autofile"
+	"github.com/creatachain/augusteum/libs/eventlog"
 	tmjson "github.com/creatachain/augusteum/libs/json"
 	"github.com/creatachain/augusteum/libs/log"
 	tmpubsub "github.com/creatachain/augusteum/libs/pubsub"
+	tmquery "github.com/creatachain/augusteum/libs/pubsub/query"
 	"github.com/creatachain/augusteum/libs/service"
 	"github.com/creatachain/augusteum/light"
 	mempl "github.com/creatachain/augusteum/mempool"
@@ -34,6 +40,7 @@ import (
 	"github.com/creatachain/augusteum/p2p"
 	"github.com/creatachain/augusteum/p2p/pex"
 	"github.com/creatachain/augusteum/privval"
+	tmgrpc "github.com/creatachain/augusteum/privval/grpc"
 	"github.com/creatachain/augusteum/proxy"
 	rpccore "github.com/creatachain/augusteum/rpc/core"
 	grpccore "github.com/creatachain/augusteum/rpc/grpc"
@@ -51,6 +58,10 @@ import (
 
 //------------------------------------------------------------------------------
 
+// mutexProfileFraction is the rate of the mutex contention events sampled
+// when the pprof server is enabled, on average 1 out of 100.
+const mutexProfileFraction = 100
+
 // DBContext specifies config information for loading a new DB.
 type DBContext struct {
 	ID     string
@@ -185,6 +196,7 @@ type Node struct {
 
 	// services
 	eventBus          *types.EventBus // pub/sub for services
+	eventLog          *eventlog.Log   // log of the events read by /events, nil if disabled
 	stateStore        sm.Store
 	blockStore        *store.BlockStore // store the blockchain to disk
 	bcReactor         p2p.Reactor       // for fast-syncing
@@ -239,6 +251,26 @@ func createAndStartEventBus(logger log.Logger) (*types.EventBus, error) {
 	return eventBus, nil
 }
 
+// createEventLog returns a log fed with all the events of the event bus, or
+// nil if it is disabled. The subscription is unbuffered, so that the log
+// misses no event.
+func createEventLog(config *cfg.Config, eventBus *types.EventBus) (*eventlog.Log, error) {
+	if config.RPC.EventLogWindowSize == 0 {
+		return nil, nil
+	}
+
+	eventLog, err := eventlog.New(config.RPC.EventLogWindowSize, config.RPC.EventLogMaxItems)
+	if err != nil {
+		return nil, err
+	}
+	sub, err := eventBus.SubscribeUnbuffered(context.Background(), "EventLog", tmquery.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	go eventLog.Consume(sub)
+	return eventLog, nil
+}
+
 func createAndStartIndexerService(config *cfg.Config, dbProvider DBProvider,
 	eventBus *types.EventBus, logger log.Logger) (*txindex.IndexerService, txindex.TxIndexer, error) {
 
@@ -353,6 +385,7 @@ func createEvidenceReactor(config *cfg.Config, dbProvider DBProvider,
 
 func createBlockchainReactor(config *cfg.Config,
 	state sm.State,
+	stateStore sm.Store,
 	blockExec *sm.BlockExecutor,
 	blockStore *store.BlockStore,
 	fastSync bool,
@@ -365,6 +398,16 @@ func createBlockchainReactor(config *cfg.Config,
 		bcReactor = bcv1.NewBlockchainReactor(state.Copy(), blockExec, blockStore, fastSync)
 	case "v2":
 		bcReactor = bcv2.NewBlockchainReactor(state.Copy(), blockExec, blockStore, fastSync)
+	case "v3":
+		bcMetrics := bcv3.NopMetrics()
+		if config.Instrumentation.Prometheus {
+			bcMetrics = bcv3.PrometheusMetrics(config.Instrumentation.Namespace, "chain_id", state.ChainID)
+		}
+		options := []bcv3.ReactorOption{bcv3.ReactorMetrics(bcMetrics), bcv3.ReactorStateStore(stateStore)}
+		if config.FastSync.VerifyHeadersFirst {
+			options = append(options, bcv3.ReactorVerifyHeadersFirst(config.FastSync.TrustPeriod))
+		}
+		bcReactor = bcv3.NewBlockchainReactor(state.Copy(), blockExec, blockStore, fastSync, options...)
 	default:
 		return nil, fmt.Errorf("unknown fastsync version %s", config.FastSync.Version)
 	}
@@ -466,10 +509,15 @@ func createTransport(
 		)
 	}
 
+	// In validator mode, only the sentries may connect.
+	if config.P2P.Mode == cfg.P2PModeValidator {
+		peerFilters = append(peerFilters, sentryPeerFilter(config.P2P))
+	}
+
 	p2p.MultiplexTransportConnFilters(connFilters...)(transport)
 
 	// Limit the number of incoming connections.
-	max := config.P2P.MaxNumInboundPeers + len(splitAndTrimEmpty(config.P2P.UnconditionalPeerIDs, ",", " "))
+	max := config.P2P.MaxNumInboundPeers + len(unconditionalPeerIDs(config.P2P))
 	p2p.MultiplexTransportMaxIncomingConnections(max)(transport)
 
 	return transport, peerFilters
@@ -486,14 +534,23 @@ func createSwitch(config *cfg.Config,
 	evidenceReactor *evidence.Reactor,
 	nodeInfo p2p.NodeInfo,
 	nodeKey *p2p.NodeKey,
-	p2pLogger log.Logger) *p2p.Switch {
+	p2pLogger log.Logger) (*p2p.Switch, error) {
 
-	sw := p2p.NewSwitch(
-		config.P2P,
-		transport,
+	options := []p2p.SwitchOption{
 		p2p.WithMetrics(p2pMetrics),
 		p2p.SwitchPeerFilters(peerFilters...),
-	)
+	}
+	if captureFile := config.P2P.CaptureFilePath(); captureFile != "" {
+		capture, err := p2p.NewCapture(captureFile, autofile.GroupTotalSizeLimit(config.P2P.CaptureMaxSize))
+		if err != nil {
+			return nil, fmt.Errorf("could not create p2p capture: %w", err)
+		}
+		capture.SetLogger(p2pLogger.With("capture", captureFile))
+		options = append(options, p2p.SwitchCapture(capture))
+		p2pLogger.Info("Capturing p2p messages", "file", captureFile)
+	}
+
+	sw := p2p.NewSwitch(config.P2P, transport, options...)
 	sw.SetLogger(p2pLogger)
 	sw.AddReactor("MEMPOOL", mempoolReactor)
 	sw.AddReactor("BLOCKCHAIN", bcReactor)
@@ -505,7 +562,7 @@ func createSwitch(config *cfg.Config,
 	sw.SetNodeKey(nodeKey)
 
 	p2pLogger.Info("P2P Node ID", "ID", nodeKey.ID(), "file", config.NodeKeyFile())
-	return sw
+	return sw, nil
 }
 
 func createAddrBookAndSetOnSwitch(config *cfg.Config, sw *p2p.Switch,
@@ -550,6 +607,7 @@ func createPEXReactorAndAddToSwitch(addrBook pex.AddrBook, config *cfg.Config,
 			// https://github.com/creatachain/augusteum/issues/3523
 			SeedDisconnectWaitPeriod:     28 * time.Hour,
 			PersistentPeersMaxDialPeriod: config.P2P.PersistentPeersMaxDialPeriod,
+			DNSSeedRefreshPeriod:         config.P2P.DNSSeedRefreshPeriod,
 		})
 	pexReactor.SetLogger(logger.With("module", "pex"))
 	sw.AddReactor("PEX", pexReactor)
@@ -650,6 +708,11 @@ func NewNode(config *cfg.Config,
 		return nil, err
 	}
 
+	eventLog, err := createEventLog(config, eventBus)
+	if err != nil {
+		return nil, fmt.Errorf("error creating event log: %w", err)
+	}
+
 	// Transaction indexing
 	indexerService, txIndexer, err := createAndStartIndexerService(config, dbProvider, eventBus, logger)
 	if err != nil {
@@ -657,8 +720,20 @@ func NewNode(config *cfg.Config,
 	}
 
 	// If an address is provided, listen on the socket for a connection from an
-	// external signing process.
-	if config.PrivValidatorListenAddr != "" {
+	// external signing process, or connect to it if it serves gRPC. Several
+	// comma-separated addresses make a quorum of signers.
+	switch {
+	case strings.Contains(config.PrivValidatorListenAddr, ","):
+		privValidator, err = createAndStartPrivValidatorMultiSignerClient(config, genDoc.ChainID, logger)
+		if err != nil {
+			return nil, fmt.Errorf("error with private validator multi signer client: %w", err)
+		}
+	case tmgrpc.IsGRPCAddr(config.PrivValidatorListenAddr):
+		privValidator, err = createPrivValidatorGRPCClient(config, genDoc.ChainID, logger)
+		if err != nil {
+			return nil, fmt.Errorf("error with private validator grpc client: %w", err)
+		}
+	case config.PrivValidatorListenAddr != "":
 		// FIXME: we should start services inside OnStart
 		privValidator, err = createAndStartPrivValidatorSocketClient(config.PrivValidatorListenAddr, genDoc.ChainID, logger)
 		if err != nil {
@@ -723,7 +798,8 @@ func NewNode(config *cfg.Config,
 	)
 
 	// Make BlockchainReactor. Don't start fast sync if we're doing a state sync first.
-	bcReactor, err := createBlockchainReactor(config, state, blockExec, blockStore, fastSync && !stateSync, logger)
+	bcReactor, err := createBlockchainReactor(config, state, stateStore, blockExec, blockStore,
+		fastSync && !stateSync, logger)
 	if err != nil {
 		return nil, fmt.Errorf("could not create blockchain reactor: %w", err)
 	}
@@ -748,7 +824,8 @@ func NewNode(config *cfg.Config,
 		config.StateSync.TempDir)
 	stateSyncReactor.SetLogger(logger.With("module", "statesync"))
 
-	nodeInfo, err := makeNodeInfo(config, nodeKey, txIndexer, genDoc, state)
+	nodeInfo, err := makeNodeInfo(config, nodeKey, txIndexer, genDoc, state,
+		mempoolReactor, bcReactor, stateSyncReactor, consensusReactor, evidenceReactor)
 	if err != nil {
 		return nil, err
 	}
@@ -758,17 +835,20 @@ func NewNode(config *cfg.Config,
 
 	// Setup Switch.
 	p2pLogger := logger.With("module", "p2p")
-	sw := createSwitch(
+	sw, err := createSwitch(
 		config, transport, p2pMetrics, peerFilters, mempoolReactor, bcReactor,
 		stateSyncReactor, consensusReactor, evidenceReactor, nodeInfo, nodeKey, p2pLogger,
 	)
+	if err != nil {
+		return nil, err
+	}
 
 	err = sw.AddPersistentPeers(splitAndTrimEmpty(config.P2P.PersistentPeers, ",", " "))
 	if err != nil {
 		return nil, fmt.Errorf("could not add peers from persistent_peers field: %w", err)
 	}
 
-	err = sw.AddUnconditionalPeerIDs(splitAndTrimEmpty(config.P2P.UnconditionalPeerIDs, ",", " "))
+	err = sw.AddUnconditionalPeerIDs(unconditionalPeerIDs(config.P2P))
 	if err != nil {
 		return nil, fmt.Errorf("could not add peer ids from unconditional_peer_ids field: %w", err)
 	}
@@ -791,11 +871,13 @@ func NewNode(config *cfg.Config,
 	// If PEX is on, it should handle dialing the seeds. Otherwise the switch does it.
 	// Note we currently use the addrBook regardless at least for AddOurAddress
 	var pexReactor *pex.Reactor
-	if config.P2P.PexReactor {
+	if config.P2P.PexEnabled() {
 		pexReactor = createPEXReactorAndAddToSwitch(addrBook, config, sw, logger)
 	}
 
 	if config.RPC.PprofListenAddress != "" {
+		// sample the mutex contention events, so that the mutex profile isn't empty
+		runtime.SetMutexProfileFraction(mutexProfileFraction)
 		go func() {
 			logger.Info("Starting pprof server", "laddr", config.RPC.PprofListenAddress)
 			logger.Error("pprof server error", "err", http.ListenAndServe(config.RPC.PprofListenAddress, nil))
@@ -828,6 +910,7 @@ func NewNode(config *cfg.Config,
 		proxyApp:         proxyApp,
 		txIndexer:        txIndexer,
 		indexerService:   indexerService,
+		eventLog:         eventLog,
 		eventBus:         eventBus,
 	}
 	node.BaseService = *service.NewBaseService(logger, "Node", node)
@@ -849,7 +932,7 @@ func (n *Node) OnStart() error {
 	}
 
 	// Add private IDs to addrbook to block those peers being added
-	n.addrBook.AddPrivateIDs(splitAndTrimEmpty(n.config.P2P.PrivatePeerIDs, ",", " "))
+	n.addrBook.AddPrivateIDs(privatePeerIDs(n.config.P2P))
 
 	// Start the RPC server before the P2P server
 	// so we can eg. receive txs for the first block
@@ -955,6 +1038,11 @@ func (n *Node) OnStop() {
 			n.Logger.Error("Error closing private validator", "err", err)
 		}
 	}
+	if pvsc, ok := n.privValidator.(interface{ Close() error }); ok {
+		if err := pvsc.Close(); err != nil {
+			n.Logger.Error("Error closing private validator", "err", err)
+		}
+	}
 
 	if n.prometheusSrv != nil {
 		if err := n.prometheusSrv.Shutdown(context.Background()); err != nil {
@@ -986,6 +1074,7 @@ func (n *Node) ConfigureRPC() error {
 		TxIndexer:        n.txIndexer,
 		ConsensusReactor: n.consensusReactor,
 		EventBus:         n.eventBus,
+		EventLog:         n.eventLog,
 		Mempool:          n.mempool,
 
 		Logger: n.Logger.With("module", "rpc"),
@@ -995,6 +1084,35 @@ func (n *Node) ConfigureRPC() error {
 	return nil
 }
 
+// createRPCAccessControl returns the access control of the RPC server, or nil
+// if the calls are neither rate limited nor restricted.
+func createRPCAccessControl(config *cfg.RPCConfig) (*rpcserver.AccessControl, error) {
+	if !config.IsAccessControlEnabled() {
+		return nil, nil
+	}
+
+	methodCosts, err := config.MethodCostMap()
+	if err != nil {
+		return nil, err
+	}
+	quotas, err := config.APIKeyQuotas()
+	if err != nil {
+		return nil, err
+	}
+	apiKeys := make(map[string]rpcserver.Quota, len(quotas))
+	for key, quota := range quotas {
+		apiKeys[key] = rpcserver.Quota{Rate: quota.Rate, Burst: quota.Burst}
+	}
+
+	return rpcserver.NewAccessControl(rpcserver.AccessConfig{
+		PerIP:          rpcserver.Quota{Rate: config.RateLimit, Burst: config.RateLimitBurst},
+		APIKeys:        apiKeys,
+		MethodCosts:    methodCosts,
+		AllowedMethods: config.AllowedMethods,
+		DeniedMethods:  config.DeniedMethods,
+	})
+}
+
 func (n *Node) startRPC() ([]net.Listener, error) {
 	err := n.ConfigureRPC()
 	if err != nil {
@@ -1018,6 +1136,11 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		config.WriteTimeout = n.config.RPC.TimeoutBroadcastTxCommit + 1*time.Second
 	}
 
+	accessControl, err := createRPCAccessControl(n.config.RPC)
+	if err != nil {
+		return nil, err
+	}
+
 	// we may expose the rpc over both a unix and tcp socket
 	listeners := make([]net.Listener, len(listenAddrs))
 	for i, listenAddr := range listenAddrs {
@@ -1034,8 +1157,9 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 			rpcserver.ReadLimit(config.MaxBodyBytes),
 		)
 		wm.SetLogger(wmLogger)
+		wm.SetAccessControl(accessControl)
 		mux.HandleFunc("/websocket", wm.WebsocketHandler)
-		rpcserver.RegisterRPCFuncs(mux, rpccore.Routes, rpcLogger)
+		rpcserver.RegisterRPCFuncsWithAccessControl(mux, rpccore.Routes, accessControl, rpcLogger)
 		listener, err := rpcserver.Listen(
 			listenAddr,
 			config,
@@ -1082,7 +1206,7 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		listeners[i] = listener
 	}
 
-	// we expose a simplified api over grpc for convenience to app devs
+	// we expose the broadcast and query apis over grpc for convenience to app devs
 	grpcListenAddr := n.config.RPC.GRPCListenAddress
 	if grpcListenAddr != "" {
 		config := rpcserver.DefaultConfig()
@@ -1223,6 +1347,7 @@ func makeNodeInfo(
 	txIndexer txindex.TxIndexer,
 	genDoc *types.GenesisDoc,
 	state sm.State,
+	reactors ...p2p.Reactor,
 ) (p2p.NodeInfo, error) {
 	txIndexerStatus := "on"
 	if _, ok := txIndexer.(*null.TxIndex); ok {
@@ -1237,6 +1362,8 @@ func makeNodeInfo(
 		bcChannel = bcv1.BlockchainChannel
 	case "v2":
 		bcChannel = bcv2.BlockchainChannel
+	case "v3":
+		bcChannel = bcv3.BlockchainChannel
 	default:
 		return nil, fmt.Errorf("unknown fastsync version %s", config.FastSync.Version)
 	}
@@ -1262,10 +1389,15 @@ func makeNodeInfo(
 			TxIndex:    txIndexerStatus,
 			RPCAddress: config.RPC.ListenAddress,
 		},
+		ChannelVersions: p2p.ChannelVersionsOf(reactors...),
 	}
 
-	if config.P2P.PexReactor {
+	if config.P2P.PexEnabled() {
 		nodeInfo.Channels = append(nodeInfo.Channels, pex.PexChannel)
+		// The PEX reactor is only created once the switch exists, but its
+		// channels don't depend on its state.
+		nodeInfo.ChannelVersions = append(nodeInfo.ChannelVersions,
+			p2p.ChannelVersionsOf(&pex.Reactor{})...)
 	}
 
 	lAddr := config.P2P.ExternalAddress
@@ -1374,6 +1506,109 @@ func createAndStartPrivValidatorSocketClient(
 	return pvscWithRetries, nil
 }
 
+func createAndStartPrivValidatorMultiSignerClient(
+	config *cfg.Config,
+	chainID string,
+	logger log.Logger,
+) (types.PrivValidator, error) {
+	addrs := strings.Split(config.PrivValidatorListenAddr, ",")
+	signers := make([]privval.StatefulSigner, 0, len(addrs))
+	closeSigners := func() {
+		for _, signer := range signers {
+			_ = signer.(*privval.RetrySignerClient).Close()
+		}
+	}
+	for _, addr := range addrs {
+		addr = strings.TrimSpace(addr)
+		pv, err := createAndStartPrivValidatorSocketClient(addr, chainID, logger.With("laddr", addr))
+		if err != nil {
+			closeSigners()
+			return nil, err
+		}
+		signers = append(signers, pv.(*privval.RetrySignerClient))
+	}
+
+	pvmsc, err := privval.NewMultiSignerClient(signers, config.PrivValidatorQuorum)
+	if err != nil {
+		closeSigners()
+		return nil, err
+	}
+
+	// the signers must agree on the pubkey
+	if _, err := pvmsc.GetPubKey(); err != nil {
+		_ = pvmsc.Close()
+		return nil, fmt.Errorf("can't get pubkey: %w", err)
+	}
+
+	return pvmsc, nil
+}
+
+func createPrivValidatorGRPCClient(
+	config *cfg.Config,
+	chainID string,
+	logger log.Logger,
+) (types.PrivValidator, error) {
+	tlsConfig, err := tmgrpc.ClientTLSConfig(
+		config.PrivValidatorClientCertificateFile(),
+		config.PrivValidatorClientKeyFile(),
+		config.PrivValidatorRootCAFile(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pvsc, err := tmgrpc.DialRemoteSigner(config.PrivValidatorListenAddr, tlsConfig, chainID,
+		logger.With("module", "privval"), grpc.WithBlock())
+	if err != nil {
+		return nil, err
+	}
+
+	// try to get a pubkey from private validate first time
+	if _, err = pvsc.GetPubKey(); err != nil {
+		_ = pvsc.Close()
+		return nil, fmt.Errorf("can't get pubkey: %w", err)
+	}
+
+	return pvsc, nil
+}
+
+// privatePeerIDs returns the IDs of the peers whose addresses are never
+// gossiped. In sentry mode, these include the validators behind the sentry.
+func privatePeerIDs(config *cfg.P2PConfig) []string {
+	ids := splitAndTrimEmpty(config.PrivatePeerIDs, ",", " ")
+	if config.Mode == cfg.P2PModeSentry {
+		ids = append(ids, splitAndTrimEmpty(config.ValidatorPeerIDs, ",", " ")...)
+	}
+	return ids
+}
+
+// unconditionalPeerIDs returns the IDs of the peers which are accepted
+// regardless of the peer limits. In sentry mode, these include the validators
+// behind the sentry.
+func unconditionalPeerIDs(config *cfg.P2PConfig) []string {
+	ids := splitAndTrimEmpty(config.UnconditionalPeerIDs, ",", " ")
+	if config.Mode == cfg.P2PModeSentry {
+		ids = append(ids, splitAndTrimEmpty(config.ValidatorPeerIDs, ",", " ")...)
+	}
+	return ids
+}
+
+// sentryPeerFilter rejects any peer but the sentries, which are the
+// persistent peers of a validator.
+func sentryPeerFilter(config *cfg.P2PConfig) p2p.PeerFilterFunc {
+	sentries := make(map[p2p.ID]struct{})
+	for _, addr := range splitAndTrimEmpty(config.PersistentPeers, ",", " ") {
+		sentries[p2p.ID(strings.SplitN(addr, "@", 2)[0])] = struct{}{}
+	}
+
+	return func(_ p2p.IPeerSet, p p2p.Peer) error {
+		if _, ok := sentries[p.ID()]; !ok {
+			return fmt.Errorf("peer %v is not a sentry of this validator", p.ID())
+		}
+		return nil
+	}
+}
+
 // splitAndTrimEmpty slices s into all subslices separated by sep and returns a
 // slice of the string s with all leading and trailing Unicode code points
 // contained in cutset removed. If sep is empty, SplitAndTrim splits after each
@@ -24,6 +24,8 @@ import (
 	"github.com/creatachain/augusteum/p2p"
 	p2pmock "github.com/creatachain/augusteum/p2p/mock"
 	"github.com/creatachain/augusteum/privval"
+	tmgrpc "github.com/creatachain/augusteum/privval/grpc"
+	grpctest "github.com/creatachain/augusteum/privval/grpc/test"
 	"github.com/creatachain/augusteum/proxy"
 	sm "github.com/creatachain/augusteum/state"
 	"github.com/creatachain/augusteum/store"
@@ -93,6 +95,32 @@ func TestSplitAndTrimEmpty(t *testing.T) {
 	}
 }
 
+func TestSentryTopology(t *testing.T) {
+	sentry, other := p2pmock.NewPeer(nil), p2pmock.NewPeer(nil)
+
+	config := cfg.TestP2PConfig()
+	config.PrivatePeerIDs = "private"
+	config.UnconditionalPeerIDs = "unconditional"
+	config.ValidatorPeerIDs = "val1, val2"
+	config.PersistentPeers = sentry.SocketAddr().String()
+
+	// validator IDs are only used in sentry mode
+	assert.Equal(t, []string{"private"}, privatePeerIDs(config))
+	assert.Equal(t, []string{"unconditional"}, unconditionalPeerIDs(config))
+
+	config.Mode = cfg.P2PModeSentry
+	assert.True(t, config.PexEnabled())
+	assert.Equal(t, []string{"private", "val1", "val2"}, privatePeerIDs(config))
+	assert.Equal(t, []string{"unconditional", "val1", "val2"}, unconditionalPeerIDs(config))
+
+	// validators only accept their sentries and never run pex
+	config.Mode = cfg.P2PModeValidator
+	assert.False(t, config.PexEnabled())
+	filter := sentryPeerFilter(config)
+	assert.NoError(t, filter(nil, sentry))
+	assert.Error(t, filter(nil, other))
+}
+
 func TestNodeDelayedStart(t *testing.T) {
 	config := cfg.ResetTestRoot("node_delayed_start_test")
 	defer os.RemoveAll(config.RootDir)
@@ -131,6 +159,26 @@ func TestNodeSetAppVersion(t *testing.T) {
 	assert.Equal(t, n.nodeInfo.(p2p.DefaultNodeInfo).ProtocolVersion.App, appVersion)
 }
 
+func TestNodeChannelVersions(t *testing.T) {
+	config := cfg.ResetTestRoot("node_channel_versions_test")
+	defer os.RemoveAll(config.RootDir)
+
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+
+	// every channel registered on the switch must have its version advertised
+	nodeInfo := n.nodeInfo.(p2p.DefaultNodeInfo)
+	for name, reactor := range n.Switch().Reactors() {
+		for _, chDesc := range reactor.GetChannels() {
+			assert.Contains(t, nodeInfo.ChannelVersions, p2p.ChannelVersion{
+				Channel:    chDesc.ID,
+				Version:    chDesc.Version,
+				MinVersion: chDesc.MinVersion,
+			}, "channel %X of reactor %s", chDesc.ID, name)
+		}
+	}
+}
+
 func TestNodeSetPrivValTCP(t *testing.T) {
 	addr := "tcp://" + testFreeAddr(t)
 
@@ -208,6 +256,36 @@ func TestNodeSetPrivValIPC(t *testing.T) {
 	assert.IsType(t, &privval.RetrySignerClient{}, n.PrivValidator())
 }
 
+func TestNodeSetPrivValGRPC(t *testing.T) {
+	config := cfg.ResetTestRoot("node_priv_val_grpc_test")
+	defer os.RemoveAll(config.RootDir)
+
+	ca, err := grpctest.NewCA(config.RootDir, "ca")
+	require.NoError(t, err)
+	signerFiles, err := ca.Issue("signer")
+	require.NoError(t, err)
+	nodeFiles, err := ca.Issue("node")
+	require.NoError(t, err)
+
+	tlsConfig, err := tmgrpc.ServerTLSConfig(signerFiles.CertFile, signerFiles.KeyFile, signerFiles.RootCAFile)
+	require.NoError(t, err)
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := tmgrpc.NewServer(tlsConfig,
+		tmgrpc.NewSignerServer(config.ChainID(), types.NewMockPV(), log.TestingLogger()))
+	go server.Serve(ln) //nolint:errcheck // ignore for tests
+	defer server.Stop()
+
+	config.BaseConfig.PrivValidatorListenAddr = "grpc://" + ln.Addr().String()
+	config.BaseConfig.PrivValidatorClientCertificate = nodeFiles.CertFile
+	config.BaseConfig.PrivValidatorClientKey = nodeFiles.KeyFile
+	config.BaseConfig.PrivValidatorRootCA = nodeFiles.RootCAFile
+
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	assert.IsType(t, &tmgrpc.SignerClient{}, n.PrivValidator())
+}
+
 // testFreeAddr claims a free port so we don't block on listener being ready.
 func testFreeAddr(t *testing.T) string {
 	ln, err := net.Listen("tcp", "127.0.0.1:0")
@@ -291,11 +369,12 @@ func TestCreateProposalBlock(t *testing.T) {
 	)
 
 	commit := types.NewCommit(height-1, 0, types.BlockID{}, nil)
-	block, _ := blockExec.CreateProposalBlock(
+	block, _, err := blockExec.CreateProposalBlock(
 		height,
-		state, commit,
+		state, commit, nil,
 		proposerAddr,
 	)
+	require.NoError(t, err)
 
 	// check that the part set does not exceed the maximum block size
 	partSet := block.MakePartSet(partSize)
@@ -359,11 +438,12 @@ func TestMaxProposalBlockSize(t *testing.T) {
 	)
 
 	commit := types.NewCommit(height-1, 0, types.BlockID{}, nil)
-	block, _ := blockExec.CreateProposalBlock(
+	block, _, err := blockExec.CreateProposalBlock(
 		height,
-		state, commit,
+		state, commit, nil,
 		proposerAddr,
 	)
+	require.NoError(t, err)
 
 	pb, err := block.ToProto()
 	require.NoError(t, err)
@@ -0,0 +1,162 @@
+package p2p
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"time"
+
+	auto "github.com/creatachain/augusteum/libs/autofile"
+	"github.com/creatachain/augusteum/libs/log"
+	tmos "github.com/creatachain/augusteum/libs/os"
+	"github.com/creatachain/augusteum/libs/service"
+	tmtime "github.com/creatachain/augusteum/types/time"
+)
+
+const (
+	// how often the capture is flushed to disk
+	captureFlushInterval = 2 * time.Second
+
+	// CaptureSent and CaptureReceived are the directions of captured envelopes.
+	CaptureSent     = "sent"
+	CaptureReceived = "recv"
+)
+
+// CaptureEnvelope is a single message sent to or received from a peer.
+type CaptureEnvelope struct {
+	Time      time.Time `json:"time"`
+	Peer      ID        `json:"peer"`
+	Direction string    `json:"direction"`
+	ChannelID byte      `json:"channel_id"`
+	Size      int       `json:"size"`
+	Msg       []byte    `json:"msg"`
+}
+
+// Capture records the envelopes exchanged with peers into a rotating group of
+// files, one JSON encoded envelope per line. Messages are recorded undecoded;
+// use `augusteum debug p2p-dump` to decode them.
+type Capture struct {
+	service.BaseService
+
+	group       *auto.Group
+	flushTicker *time.Ticker
+}
+
+// NewCapture creates a capture writing to the group with the given head path.
+func NewCapture(headPath string, groupOptions ...func(*auto.Group)) (*Capture, error) {
+	err := tmos.EnsureDir(filepath.Dir(headPath), 0700)
+	if err != nil {
+		return nil, fmt.Errorf("failed to ensure p2p capture directory is in place: %w", err)
+	}
+
+	group, err := auto.OpenGroup(headPath, groupOptions...)
+	if err != nil {
+		return nil, err
+	}
+	c := &Capture{group: group}
+	c.BaseService = *service.NewBaseService(nil, "P2PCapture", c)
+	return c, nil
+}
+
+// SetLogger implements service.Service.
+func (c *Capture) SetLogger(l log.Logger) {
+	c.BaseService.Logger = l
+	c.group.SetLogger(l)
+}
+
+// OnStart implements service.Service.
+func (c *Capture) OnStart() error {
+	if err := c.group.Start(); err != nil {
+		return err
+	}
+	c.flushTicker = time.NewTicker(captureFlushInterval)
+	go c.processFlushTicks()
+	return nil
+}
+
+func (c *Capture) processFlushTicks() {
+	for {
+		select {
+		case <-c.flushTicker.C:
+			if err := c.group.FlushAndSync(); err != nil {
+				c.Logger.Error("Periodic p2p capture flush failed", "err", err)
+			}
+		case <-c.Quit():
+			return
+		}
+	}
+}
+
+// OnStop implements service.Service.
+func (c *Capture) OnStop() {
+	c.flushTicker.Stop()
+	if err := c.group.FlushAndSync(); err != nil {
+		c.Logger.Error("Error flushing p2p capture", "err", err)
+	}
+	if err := c.group.Stop(); err != nil {
+		c.Logger.Error("Error stopping p2p capture", "err", err)
+	}
+	c.group.Close()
+}
+
+// Record writes an envelope for msgBytes exchanged with peer on chID. It's a
+// no-op on a nil or stopped Capture.
+// NOTE: does not call fsync()
+func (c *Capture) Record(peer ID, direction string, chID byte, msgBytes []byte) {
+	if c == nil || !c.IsRunning() {
+		return
+	}
+
+	bz, err := json.Marshal(CaptureEnvelope{
+		Time:      tmtime.Now(),
+		Peer:      peer,
+		Direction: direction,
+		ChannelID: chID,
+		Size:      len(msgBytes),
+		Msg:       msgBytes,
+	})
+	if err != nil {
+		c.Logger.Error("Error encoding p2p capture envelope", "err", err)
+		return
+	}
+	if err := c.group.WriteLine(string(bz)); err != nil {
+		c.Logger.Error("Error writing p2p capture envelope", "err", err)
+	}
+}
+
+// ReadCapture calls fn for every envelope of the capture with the given head
+// path, oldest first.
+func ReadCapture(headPath string, fn func(CaptureEnvelope) error) error {
+	group, err := auto.OpenGroup(headPath)
+	if err != nil {
+		return err
+	}
+	defer group.Close()
+
+	r, err := group.NewReader(group.MinIndex())
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadBytes('\n')
+		if len(line) > 0 {
+			var env CaptureEnvelope
+			if err := json.Unmarshal(line, &env); err != nil {
+				return fmt.Errorf("failed to decode p2p capture envelope: %w", err)
+			}
+			if err := fn(env); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
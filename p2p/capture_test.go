@@ -0,0 +1,84 @@
+package p2p
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCaptureRecordAndRead(t *testing.T) {
+	headPath := filepath.Join(t.TempDir(), "capture")
+
+	capture, err := NewCapture(headPath)
+	require.NoError(t, err)
+
+	// not started yet, nothing is recorded
+	capture.Record("peer0", CaptureSent, 0x01, []byte("dropped"))
+
+	require.NoError(t, capture.Start())
+	capture.Record("peer1", CaptureSent, 0x01, []byte("foo"))
+	capture.Record("peer2", CaptureReceived, 0x02, []byte("barbaz"))
+	require.NoError(t, capture.Stop())
+
+	var envs []CaptureEnvelope
+	err = ReadCapture(headPath, func(env CaptureEnvelope) error {
+		envs = append(envs, env)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, envs, 2)
+
+	assert.Equal(t, ID("peer1"), envs[0].Peer)
+	assert.Equal(t, CaptureSent, envs[0].Direction)
+	assert.Equal(t, byte(0x01), envs[0].ChannelID)
+	assert.Equal(t, 3, envs[0].Size)
+	assert.Equal(t, []byte("foo"), envs[0].Msg)
+
+	assert.Equal(t, ID("peer2"), envs[1].Peer)
+	assert.Equal(t, CaptureReceived, envs[1].Direction)
+	assert.Equal(t, []byte("barbaz"), envs[1].Msg)
+	assert.False(t, envs[1].Time.Before(envs[0].Time))
+
+	// a nil capture is a no-op
+	var nilCapture *Capture
+	nilCapture.Record("peer1", CaptureSent, 0x01, []byte("foo"))
+}
+
+func TestSwitchCapture(t *testing.T) {
+	headPath := filepath.Join(t.TempDir(), "capture")
+	capture, err := NewCapture(headPath)
+	require.NoError(t, err)
+
+	s1, s2 := MakeSwitchPair(t, func(i int, sw *Switch) *Switch {
+		if i == 0 {
+			SwitchCapture(capture)(sw)
+		}
+		return initSwitchFunc(i, sw)
+	})
+	t.Cleanup(func() {
+		if err := s2.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	msg := []byte("captured")
+	s1.Broadcast(byte(0x01), msg)
+	assertMsgReceivedWithTimeout(t, msg, byte(0x01),
+		s2.Reactor("foo").(*TestReactor), 10*time.Millisecond, 5*time.Second)
+	require.NoError(t, s1.Stop())
+
+	var sent []CaptureEnvelope
+	err = ReadCapture(headPath, func(env CaptureEnvelope) error {
+		if env.Direction == CaptureSent {
+			sent = append(sent, env)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, sent, 1)
+	assert.Equal(t, s2.NodeInfo().ID(), sent[0].Peer)
+	assert.Equal(t, msg, sent[0].Msg)
+}
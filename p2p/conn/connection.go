@@ -722,6 +722,11 @@ type ChannelDescriptor struct {
 	SendQueueCapacity   int
 	RecvBufferCapacity  int
 	RecvMessageCapacity int
+
+	// Protocol version spoken on the channel and the oldest version of it
+	// peers may speak. Messages are only exchanged with compatible peers.
+	Version    uint32
+	MinVersion uint32
 }
 
 func (chDesc ChannelDescriptor) FillDefaults() (filled ChannelDescriptor) {
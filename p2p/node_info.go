@@ -3,6 +3,7 @@ package p2p
 import (
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 
 	"github.com/creatachain/augusteum/libs/bytes"
@@ -70,6 +71,39 @@ func NewProtocolVersion(p2p, block, app uint64) ProtocolVersion {
 
 //-------------------------------------------------------------
 
+// ChannelVersion is the protocol version a node speaks on a channel, along
+// with the oldest version it is still able to talk to. Channels without an
+// advertised version are at version 0.
+type ChannelVersion struct {
+	Channel    byte   `json:"channel"`
+	Version    uint32 `json:"version"`
+	MinVersion uint32 `json:"min_version"`
+}
+
+// CompatibleWith returns true if both sides speak a version the other side
+// still accepts.
+func (cv ChannelVersion) CompatibleWith(other ChannelVersion) bool {
+	return cv.Version >= other.MinVersion && other.Version >= cv.MinVersion
+}
+
+// ChannelVersionsOf collects the versions declared in the channel descriptors
+// of the given reactors. Unversioned channels are reported with version 0.
+func ChannelVersionsOf(reactors ...Reactor) []ChannelVersion {
+	var versions []ChannelVersion
+	for _, reactor := range reactors {
+		for _, chDesc := range reactor.GetChannels() {
+			versions = append(versions, ChannelVersion{
+				Channel:    chDesc.ID,
+				Version:    chDesc.Version,
+				MinVersion: chDesc.MinVersion,
+			})
+		}
+	}
+	return versions
+}
+
+//-------------------------------------------------------------
+
 // Assert DefaultNodeInfo satisfies NodeInfo
 var _ NodeInfo = DefaultNodeInfo{}
 
@@ -92,6 +126,9 @@ type DefaultNodeInfo struct {
 	// ASCIIText fields
 	Moniker string               `json:"moniker"` // arbitrary moniker
 	Other   DefaultNodeInfoOther `json:"other"`   // other application specific data
+
+	// Protocol versions of the versioned channels
+	ChannelVersions []ChannelVersion `json:"channel_versions"`
 }
 
 // DefaultNodeInfoOther is the misc. applcation specific data
@@ -150,6 +187,22 @@ func (info DefaultNodeInfo) Validate() error {
 		channels[ch] = struct{}{}
 	}
 
+	// Validate ChannelVersions - ensure they refer to known channels.
+	versioned := make(map[byte]struct{})
+	for _, cv := range info.ChannelVersions {
+		if _, ok := channels[cv.Channel]; !ok {
+			return fmt.Errorf("info.ChannelVersions contains unknown channel id %v", cv.Channel)
+		}
+		if _, ok := versioned[cv.Channel]; ok {
+			return fmt.Errorf("info.ChannelVersions contains duplicate channel id %v", cv.Channel)
+		}
+		if cv.MinVersion > cv.Version {
+			return fmt.Errorf("info.ChannelVersions min version %v of channel %v is above version %v",
+				cv.MinVersion, cv.Channel, cv.Version)
+		}
+		versioned[cv.Channel] = struct{}{}
+	}
+
 	// Validate Moniker.
 	if !tmstrings.IsASCIIText(info.Moniker) || tmstrings.ASCIITrim(info.Moniker) == "" {
 		return fmt.Errorf("info.Moniker must be valid non-empty ASCII text without tabs, but got %v", info.Moniker)
@@ -174,7 +227,7 @@ func (info DefaultNodeInfo) Validate() error {
 
 // CompatibleWith checks if two DefaultNodeInfo are compatible with eachother.
 // CONTRACT: two nodes are compatible if the Block version and network match
-// and they have at least one channel in common.
+// and they have at least one channel in common whose versions are compatible.
 func (info DefaultNodeInfo) CompatibleWith(otherInfo NodeInfo) error {
 	other, ok := otherInfo.(DefaultNodeInfo)
 	if !ok {
@@ -201,18 +254,29 @@ func (info DefaultNodeInfo) CompatibleWith(otherInfo NodeInfo) error {
 OUTER_LOOP:
 	for _, ch1 := range info.Channels {
 		for _, ch2 := range other.Channels {
-			if ch1 == ch2 {
+			if ch1 == ch2 && info.ChannelVersion(ch1).CompatibleWith(other.ChannelVersion(ch2)) {
 				found = true
 				break OUTER_LOOP // only need one
 			}
 		}
 	}
 	if !found {
-		return fmt.Errorf("peer has no common channels. Our channels: %v ; Peer channels: %v", info.Channels, other.Channels)
+		return fmt.Errorf("peer has no common compatible channels. Our channels: %v %v; Peer channels: %v %v",
+			info.Channels, info.ChannelVersions, other.Channels, other.ChannelVersions)
 	}
 	return nil
 }
 
+// ChannelVersion returns the advertised version of the given channel.
+func (info DefaultNodeInfo) ChannelVersion(chID byte) ChannelVersion {
+	for _, cv := range info.ChannelVersions {
+		if cv.Channel == chID {
+			return cv
+		}
+	}
+	return ChannelVersion{Channel: chID}
+}
+
 // NetAddress returns a NetAddress derived from the DefaultNodeInfo -
 // it includes the authenticated peer ID and the self-reported
 // ListenAddr. Note that the ListenAddr is not authenticated and
@@ -241,6 +305,13 @@ func (info DefaultNodeInfo) ToProto() *tmp2p.DefaultNodeInfo {
 		TxIndex:    info.Other.TxIndex,
 		RPCAddress: info.Other.RPCAddress,
 	}
+	for _, cv := range info.ChannelVersions {
+		dni.ChannelVersions = append(dni.ChannelVersions, tmp2p.ChannelVersion{
+			ChannelID:  uint32(cv.Channel),
+			Version:    cv.Version,
+			MinVersion: cv.MinVersion,
+		})
+	}
 
 	return dni
 }
@@ -266,6 +337,16 @@ func DefaultNodeInfoFromToProto(pb *tmp2p.DefaultNodeInfo) (DefaultNodeInfo, err
 			RPCAddress: pb.Other.RPCAddress,
 		},
 	}
+	for _, cv := range pb.ChannelVersions {
+		if cv.ChannelID > math.MaxUint8 {
+			return DefaultNodeInfo{}, fmt.Errorf("invalid channel id %v in channel versions", cv.ChannelID)
+		}
+		dni.ChannelVersions = append(dni.ChannelVersions, ChannelVersion{
+			Channel:    byte(cv.ChannelID),
+			Version:    cv.Version,
+			MinVersion: cv.MinVersion,
+		})
+	}
 
 	return dni, nil
 }
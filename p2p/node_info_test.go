@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/creatachain/augusteum/crypto/ed25519"
 )
@@ -39,6 +40,20 @@ func TestNodeInfoValidate(t *testing.T) {
 		{"Duplicate Channel", func(ni *DefaultNodeInfo) { ni.Channels = dupChannels }, true},
 		{"Good Channels", func(ni *DefaultNodeInfo) { ni.Channels = ni.Channels[:5] }, false},
 
+		{"Unknown ChannelVersion", func(ni *DefaultNodeInfo) {
+			ni.Channels = ni.Channels[:5]
+			ni.ChannelVersions = []ChannelVersion{{Channel: 6, Version: 1}}
+		}, true},
+		{"Duplicate ChannelVersion", func(ni *DefaultNodeInfo) {
+			ni.ChannelVersions = []ChannelVersion{{Channel: 1, Version: 1}, {Channel: 1, Version: 2}}
+		}, true},
+		{"MinVersion above Version", func(ni *DefaultNodeInfo) {
+			ni.ChannelVersions = []ChannelVersion{{Channel: 1, Version: 1, MinVersion: 2}}
+		}, true},
+		{"Good ChannelVersions", func(ni *DefaultNodeInfo) {
+			ni.ChannelVersions = []ChannelVersion{{Channel: 1, Version: 2, MinVersion: 1}}
+		}, false},
+
 		{"Invalid NetAddress", func(ni *DefaultNodeInfo) { ni.ListenAddr = "not-an-address" }, true},
 		{"Good NetAddress", func(ni *DefaultNodeInfo) { ni.ListenAddr = "0.0.0.0:26656" }, false},
 
@@ -117,6 +132,9 @@ func TestNodeInfoCompatible(t *testing.T) {
 		{"Wrong block version", func(ni *DefaultNodeInfo) { ni.ProtocolVersion.Block++ }},
 		{"Wrong network", func(ni *DefaultNodeInfo) { ni.Network += "-wrong" }},
 		{"No common channels", func(ni *DefaultNodeInfo) { ni.Channels = []byte{newTestChannel} }},
+		{"Channel version too new", func(ni *DefaultNodeInfo) {
+			ni.ChannelVersions = []ChannelVersion{{Channel: testCh, Version: 2, MinVersion: 2}}
+		}},
 	}
 
 	for _, tc := range testCases {
@@ -125,3 +143,33 @@ func TestNodeInfoCompatible(t *testing.T) {
 		assert.Error(t, ni1.CompatibleWith(ni))
 	}
 }
+
+func TestChannelVersionCompatibleWith(t *testing.T) {
+	testCases := []struct {
+		ours, theirs ChannelVersion
+		compatible   bool
+	}{
+		{ChannelVersion{}, ChannelVersion{}, true},
+		{ChannelVersion{Version: 2, MinVersion: 1}, ChannelVersion{Version: 1}, true},
+		{ChannelVersion{Version: 2, MinVersion: 2}, ChannelVersion{Version: 1}, false},
+		{ChannelVersion{Version: 1}, ChannelVersion{Version: 3, MinVersion: 2}, false},
+		{ChannelVersion{Version: 3, MinVersion: 1}, ChannelVersion{Version: 2, MinVersion: 2}, true},
+	}
+
+	for i, tc := range testCases {
+		assert.Equal(t, tc.compatible, tc.ours.CompatibleWith(tc.theirs), "#%d", i)
+		assert.Equal(t, tc.compatible, tc.theirs.CompatibleWith(tc.ours), "#%d", i)
+	}
+}
+
+func TestNodeInfoChannelVersionsProto(t *testing.T) {
+	nodeKey := NodeKey{PrivKey: ed25519.GenPrivKey()}
+	ni := testNodeInfo(nodeKey.ID(), "testing").(DefaultNodeInfo)
+	ni.ChannelVersions = []ChannelVersion{{Channel: testCh, Version: 2, MinVersion: 1}}
+
+	ni2, err := DefaultNodeInfoFromToProto(ni.ToProto())
+	require.NoError(t, err)
+	assert.Equal(t, ni, ni2)
+	assert.Equal(t, ChannelVersion{Channel: testCh, Version: 2, MinVersion: 1}, ni2.ChannelVersion(testCh))
+	assert.Equal(t, ChannelVersion{Channel: 0x2}, ni2.ChannelVersion(0x2))
+}
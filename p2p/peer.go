@@ -107,7 +107,8 @@ type peer struct {
 	mconn *tmconn.MConnection
 
 	// peer's node info and the channel it knows about
-	// channels = nodeInfo.Channels
+	// channels = nodeInfo.Channels, less the ones whose version is
+	// incompatible with ours
 	// cached to avoid copying nodeInfo in hasChannel
 	nodeInfo NodeInfo
 	channels []byte
@@ -117,6 +118,9 @@ type peer struct {
 
 	metrics       *Metrics
 	metricsTicker *time.Ticker
+
+	// records exchanged messages, may be nil
+	capture *Capture
 }
 
 type PeerOption func(*peer)
@@ -133,7 +137,7 @@ func newPeer(
 	p := &peer{
 		peerConn:      pc,
 		nodeInfo:      nodeInfo,
-		channels:      nodeInfo.(DefaultNodeInfo).Channels, // TODO
+		channels:      compatibleChannels(nodeInfo.(DefaultNodeInfo), chDescs), // TODO
 		Data:          cmap.NewCMap(),
 		metricsTicker: time.NewTicker(metricsTickerDuration),
 		metrics:       NopMetrics(),
@@ -258,6 +262,7 @@ func (p *peer) Send(chID byte, msgBytes []byte) bool {
 			"chID", fmt.Sprintf("%#x", chID),
 		}
 		p.metrics.PeerSendBytesTotal.With(labels...).Add(float64(len(msgBytes)))
+		p.capture.Record(p.ID(), CaptureSent, chID, msgBytes)
 	}
 	return res
 }
@@ -277,6 +282,7 @@ func (p *peer) TrySend(chID byte, msgBytes []byte) bool {
 			"chID", fmt.Sprintf("%#x", chID),
 		}
 		p.metrics.PeerSendBytesTotal.With(labels...).Add(float64(len(msgBytes)))
+		p.capture.Record(p.ID(), CaptureSent, chID, msgBytes)
 	}
 	return res
 }
@@ -311,6 +317,28 @@ func (p *peer) hasChannel(chID byte) bool {
 	return false
 }
 
+// compatibleChannels returns the channels of the peer which either we don't
+// know about or on which the peer speaks a version compatible with ours.
+func compatibleChannels(nodeInfo DefaultNodeInfo, chDescs []*tmconn.ChannelDescriptor) []byte {
+	versions := make(map[byte]ChannelVersion, len(chDescs))
+	for _, chDesc := range chDescs {
+		versions[chDesc.ID] = ChannelVersion{
+			Channel:    chDesc.ID,
+			Version:    chDesc.Version,
+			MinVersion: chDesc.MinVersion,
+		}
+	}
+
+	channels := make([]byte, 0, len(nodeInfo.Channels))
+	for _, ch := range nodeInfo.Channels {
+		if ours, ok := versions[ch]; ok && !ours.CompatibleWith(nodeInfo.ChannelVersion(ch)) {
+			continue
+		}
+		channels = append(channels, ch)
+	}
+	return channels
+}
+
 // CloseConn closes original connection. Used for cleaning up in cases where the peer had not been started at all.
 func (p *peer) CloseConn() error {
 	return p.peerConn.conn.Close()
@@ -346,6 +374,13 @@ func PeerMetrics(metrics *Metrics) PeerOption {
 	}
 }
 
+// PeerCapture sets the capture recording the messages exchanged with the peer.
+func PeerCapture(capture *Capture) PeerOption {
+	return func(p *peer) {
+		p.capture = capture
+	}
+}
+
 func (p *peer) metricsReporter() {
 	for {
 		select {
@@ -382,11 +417,17 @@ func createMConnection(
 			// which does onPeerError.
 			panic(fmt.Sprintf("Unknown channel %X", chID))
 		}
+		if !p.hasChannel(chID) {
+			// the peer speaks an incompatible version on this channel
+			p.Logger.Debug("Dropping message on incompatible channel", "channel", chID)
+			return
+		}
 		labels := []string{
 			"peer_id", string(p.ID()),
 			"chID", fmt.Sprintf("%#x", chID),
 		}
 		p.metrics.PeerReceiveBytesTotal.With(labels...).Add(float64(len(msgBytes)))
+		p.capture.Record(p.ID(), CaptureReceived, chID, msgBytes)
 		reactor.Receive(chID, p, msgBytes)
 	}
 
@@ -73,6 +73,21 @@ func TestPeerSend(t *testing.T) {
 	assert.True(p.Send(testCh, []byte("Asylum")))
 }
 
+func TestPeerCompatibleChannels(t *testing.T) {
+	nodeInfo := DefaultNodeInfo{
+		Channels:        []byte{0x01, 0x02, 0x03, 0x04},
+		ChannelVersions: []ChannelVersion{{Channel: 0x02, Version: 2, MinVersion: 1}, {Channel: 0x03, Version: 1}},
+	}
+	chDescs := []*tmconn.ChannelDescriptor{
+		{ID: 0x01},
+		{ID: 0x02, Version: 1},
+		{ID: 0x03, Version: 3, MinVersion: 2},
+	}
+
+	// 0x03 is too old for us, 0x04 is unknown to us and left to the switch
+	assert.Equal(t, []byte{0x01, 0x02, 0x04}, compatibleChannels(nodeInfo, chDescs))
+}
+
 func createOutboundPeerAndPerformHandshake(
 	addr *NetAddress,
 	config *config.P2PConfig,
@@ -74,6 +74,15 @@ type AddrBook interface {
 	// Send a selection of addresses with bias
 	GetSelectionWithBias(biasTowardsNewAddrs int) []*p2p.NetAddress
 
+	// Record a successful probe of the address by the seed crawler
+	MarkVerified(id p2p.ID, latency time.Duration, version string)
+	// Send a selection of addresses verified after the given time
+	GetVerifiedSelection(since time.Time) []*p2p.NetAddress
+
+	// Inspect and clean up the book
+	KnownAddresses() []KnownAddressInfo
+	Prune(olderThan time.Duration) int
+
 	Size() int
 
 	// Persist to disk
@@ -469,6 +478,84 @@ func (a *addrBook) GetSelectionWithBias(biasTowardsNewAddrs int) []*p2p.NetAddre
 	return selection
 }
 
+// MarkVerified implements AddrBook - it records that the seed crawler has
+// connected to the peer, along with the observed dial latency and the version
+// the peer reported in its NodeInfo.
+func (a *addrBook) MarkVerified(id p2p.ID, latency time.Duration, version string) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	ka := a.addrLookup[id]
+	if ka == nil {
+		return
+	}
+	ka.markVerified(latency, version)
+}
+
+// GetVerifiedSelection implements AddrBook.
+// It randomly selects some of the addresses which were verified by the seed
+// crawler after since. Must never return a nil address.
+func (a *addrBook) GetVerifiedSelection(since time.Time) []*p2p.NetAddress {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	verified := make([]*p2p.NetAddress, 0)
+	for _, ka := range a.addrLookup {
+		if ka.isVerifiedSince(since) {
+			verified = append(verified, ka.Addr)
+		}
+	}
+	if len(verified) == 0 {
+		return nil
+	}
+
+	numAddresses := tmmath.MaxInt(
+		tmmath.MinInt(minGetSelection, len(verified)),
+		len(verified)*getSelectionPercent/100)
+	numAddresses = tmmath.MinInt(maxGetSelection, numAddresses)
+
+	rand.Shuffle(len(verified), func(i, j int) {
+		verified[i], verified[j] = verified[j], verified[i]
+	})
+	return verified[:numAddresses]
+}
+
+// KnownAddresses implements AddrBook - it returns a snapshot of every address
+// in the book.
+func (a *addrBook) KnownAddresses() []KnownAddressInfo {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	infos := make([]KnownAddressInfo, 0, len(a.addrLookup))
+	for _, ka := range a.addrLookup {
+		infos = append(infos, ka.info())
+	}
+	return infos
+}
+
+// Prune implements AddrBook - it removes addresses which are considered bad.
+// If olderThan is positive, addresses which were not successfully dialed or
+// verified within that period are removed as well. It returns the number of
+// removed addresses.
+func (a *addrBook) Prune(olderThan time.Duration) int {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	cutoff := time.Now().Add(-olderThan)
+	pruned := 0
+	for _, ka := range a.addrLookup {
+		stale := olderThan > 0 &&
+			ka.LastSuccess.Before(cutoff) &&
+			!ka.isVerifiedSince(cutoff)
+		if ka.isBad() || stale {
+			a.Logger.Info("Pruning address from book", "addr", ka.Addr)
+			a.removeFromAllBuckets(ka)
+			pruned++
+		}
+	}
+	return pruned
+}
+
 //------------------------------------------------
 
 // Size returns the number of addresses in the book.
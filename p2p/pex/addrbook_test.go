@@ -711,6 +711,60 @@ func TestAddrBookGroupKey(t *testing.T) {
 	}
 }
 
+func TestAddrBookGetVerifiedSelection(t *testing.T) {
+	book, fname := createAddrBookWithMOldAndNNewAddrs(t, 5, 5)
+	defer deleteTempFile(fname)
+
+	since := time.Now().Add(-time.Hour)
+	assert.Nil(t, book.GetVerifiedSelection(since))
+
+	infos := book.KnownAddresses()
+	require.Len(t, infos, 10)
+	verified := infos[0].Addr
+	book.MarkVerified(verified.ID, 10*time.Millisecond, "0.34.0")
+
+	addrs := book.GetVerifiedSelection(since)
+	require.Len(t, addrs, 1)
+	assert.Equal(t, verified, addrs[0])
+	assert.Nil(t, book.GetVerifiedSelection(time.Now().Add(time.Hour)))
+
+	for _, info := range book.KnownAddresses() {
+		if info.Addr.Equals(verified) {
+			assert.Equal(t, 10*time.Millisecond, info.Latency)
+			assert.Equal(t, "0.34.0", info.Version)
+		}
+	}
+}
+
+func TestAddrBookPrune(t *testing.T) {
+	book, fname := createAddrBookWithMOldAndNNewAddrs(t, 2, 3)
+	defer deleteTempFile(fname)
+
+	// fresh addresses are not bad
+	assert.Zero(t, book.Prune(0))
+	assert.Equal(t, 5, book.Size())
+
+	// new addresses never succeeded, so they are stale
+	assert.Equal(t, 3, book.Prune(time.Hour))
+	assert.Equal(t, 2, book.Size())
+}
+
+func TestLoadAddrBook(t *testing.T) {
+	book, fname := createAddrBookWithMOldAndNNewAddrs(t, 3, 7)
+	defer deleteTempFile(fname)
+	book.Save()
+
+	loaded, err := LoadAddrBook(fname, true)
+	require.NoError(t, err)
+	assert.Equal(t, 10, loaded.Size())
+	assert.False(t, loaded.IsRunning())
+
+	err = ioutil.WriteFile(fname, []byte("not json"), 0644)
+	require.NoError(t, err)
+	_, err = LoadAddrBook(fname, true)
+	assert.Error(t, err)
+}
+
 func assertMOldAndNNewAddrsInSelection(t *testing.T, m, n int, addrs []*p2p.NetAddress, book *addrBook) {
 	nOld, nNew := countOldAndNewAddrsInSelection(addrs, book)
 	assert.Equal(t, m, nOld, "old addresses")
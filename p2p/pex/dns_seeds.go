@@ -0,0 +1,175 @@
+package pex
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/creatachain/augusteum/libs/log"
+	"github.com/creatachain/augusteum/p2p"
+)
+
+const (
+	// DNSSeedScheme marks a seed as a DNS name whose records list the actual
+	// seed addresses, e.g. "dns://seeds.example.com".
+	DNSSeedScheme = "dns://"
+
+	// service and protocol of the SRV records looked up for DNS seeds, i.e.
+	// _augusteum._tcp.<name>
+	dnsSeedSRVService = "augusteum"
+	dnsSeedSRVProto   = "tcp"
+
+	// how often DNS seeds are resolved again
+	defaultDNSSeedRefreshPeriod = 30 * time.Minute
+
+	// timeout for resolving a single DNS seed
+	dnsSeedLookupTimeout = 10 * time.Second
+)
+
+// DNSResolver is a behaviour subset of net.Resolver used to discover seeds.
+type DNSResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+}
+
+// ReactorDNSResolver sets the resolver used to look up DNS seeds, defaults to
+// net.DefaultResolver.
+func ReactorDNSResolver(resolver DNSResolver) ReactorOption {
+	return func(r *Reactor) { r.dnsResolver = resolver }
+}
+
+// splitDNSSeeds separates DNS seed names from id@host:port seed addresses.
+func splitDNSSeeds(seeds []string) (addrs, names []string) {
+	for _, seed := range seeds {
+		if strings.HasPrefix(seed, DNSSeedScheme) {
+			names = append(names, strings.TrimPrefix(seed, DNSSeedScheme))
+		} else {
+			addrs = append(addrs, seed)
+		}
+	}
+	return addrs, names
+}
+
+// resolveDNSSeed looks up the seed addresses published under name. They are
+// taken from
+//
+//  - TXT records of name, each holding one or more comma or space separated
+//    id@host:port addresses;
+//  - SRV records of _augusteum._tcp.<name>, whose targets are of the form
+//    <id>.<host>, with the port taken from the record.
+//
+// Malformed addresses and targets are logged and skipped. An error is only
+// returned if neither record type could be resolved.
+func resolveDNSSeed(
+	ctx context.Context,
+	resolver DNSResolver,
+	logger log.Logger,
+	name string,
+) ([]*p2p.NetAddress, error) {
+	var (
+		addrs []*p2p.NetAddress
+		errs  []string
+	)
+
+	txts, err := resolver.LookupTXT(ctx, name)
+	if err != nil {
+		errs = append(errs, err.Error())
+	}
+	for _, txt := range txts {
+		for _, s := range strings.FieldsFunc(txt, func(r rune) bool { return r == ',' || r == ' ' }) {
+			addr, err := p2p.NewNetAddressString(s)
+			if err != nil {
+				logger.Error("Invalid address in TXT record of DNS seed", "seed", name, "addr", s, "err", err)
+				continue
+			}
+			addrs = append(addrs, addr)
+		}
+	}
+
+	_, srvs, err := resolver.LookupSRV(ctx, dnsSeedSRVService, dnsSeedSRVProto, name)
+	if err != nil {
+		errs = append(errs, err.Error())
+	}
+	for _, srv := range srvs {
+		target := strings.TrimSuffix(srv.Target, ".")
+		labels := strings.SplitN(target, ".", 2)
+		if len(labels) != 2 {
+			logger.Error("Invalid target in SRV record of DNS seed, expected <id>.<host>",
+				"seed", name, "target", srv.Target)
+			continue
+		}
+		idAddr := p2p.IDAddressString(p2p.ID(labels[0]), net.JoinHostPort(labels[1], strconv.Itoa(int(srv.Port))))
+		addr, err := p2p.NewNetAddressString(idAddr)
+		if err != nil {
+			logger.Error("Invalid target in SRV record of DNS seed", "seed", name, "target", srv.Target, "err", err)
+			continue
+		}
+		addrs = append(addrs, addr)
+	}
+
+	if len(errs) == 2 {
+		return nil, fmt.Errorf("failed to resolve DNS seed %s: %s", name, strings.Join(errs, "; "))
+	}
+	return addrs, nil
+}
+
+// resolveDNSSeeds resolves all configured DNS seeds. Seeds which fail to
+// resolve are logged and skipped.
+func (r *Reactor) resolveDNSSeeds() []*p2p.NetAddress {
+	var addrs []*p2p.NetAddress
+	for _, name := range r.dnsSeeds {
+		ctx, cancel := context.WithTimeout(context.Background(), dnsSeedLookupTimeout)
+		seedAddrs, err := resolveDNSSeed(ctx, r.dnsResolver, r.Logger, name)
+		cancel()
+		if err != nil {
+			r.Logger.Error("Resolving DNS seed failed", "seed", name, "err", err)
+			continue
+		}
+		r.Logger.Debug("Resolved DNS seed", "seed", name, "addrs", seedAddrs)
+		addrs = append(addrs, seedAddrs...)
+	}
+	return addrs
+}
+
+// Periodically resolves the DNS seeds again, so that seeds can be rotated
+// without changing the configuration of every node. (continuous)
+func (r *Reactor) dnsSeedsRoutine() {
+	period := r.config.DNSSeedRefreshPeriod
+	if period == 0 {
+		period = defaultDNSSeedRefreshPeriod
+	}
+
+	ticker := time.NewTicker(period)
+	for {
+		select {
+		case <-ticker.C:
+			// keep the previous addresses if every lookup fails
+			if addrs := r.resolveDNSSeeds(); len(addrs) > 0 {
+				r.setDNSSeedAddrs(addrs)
+			}
+		case <-r.Quit():
+			ticker.Stop()
+			return
+		}
+	}
+}
+
+func (r *Reactor) setDNSSeedAddrs(addrs []*p2p.NetAddress) {
+	r.seedsMtx.Lock()
+	defer r.seedsMtx.Unlock()
+	r.dnsSeedAddrs = addrs
+}
+
+// getSeedAddrs returns the configured seed addresses together with the ones
+// last resolved from DNS seeds.
+func (r *Reactor) getSeedAddrs() []*p2p.NetAddress {
+	r.seedsMtx.RLock()
+	defer r.seedsMtx.RUnlock()
+
+	addrs := make([]*p2p.NetAddress, 0, len(r.seedAddrs)+len(r.dnsSeedAddrs))
+	addrs = append(addrs, r.seedAddrs...)
+	return append(addrs, r.dnsSeedAddrs...)
+}
@@ -0,0 +1,156 @@
+package pex
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/creatachain/augusteum/libs/log"
+	tmsync "github.com/creatachain/augusteum/libs/sync"
+	"github.com/creatachain/augusteum/p2p"
+)
+
+// mockDNSResolver is a local stand-in for net.Resolver.
+type mockDNSResolver struct {
+	mtx  tmsync.Mutex
+	txts map[string][]string
+	srvs map[string][]*net.SRV
+}
+
+func (r *mockDNSResolver) LookupTXT(_ context.Context, name string) ([]string, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	txts, ok := r.txts[name]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+	return txts, nil
+}
+
+func (r *mockDNSResolver) LookupSRV(_ context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	cname := "_" + service + "._" + proto + "." + name
+	srvs, ok := r.srvs[name]
+	if !ok {
+		return "", nil, &net.DNSError{Err: "no such host", Name: cname, IsNotFound: true}
+	}
+	return cname, srvs, nil
+}
+
+func (r *mockDNSResolver) setTXT(name string, txts ...string) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.txts[name] = txts
+}
+
+func TestResolveDNSSeed(t *testing.T) {
+	const (
+		id1 = "ed3dfd27bfc4af18f67a49862f04cc100696e84d"
+		id2 = "d824b13cb5d40fa1d8a614e089357c7eff31b670"
+		id3 = "3c7a5920811550c04bf7a0b2f1e02ab52317b5e6"
+	)
+
+	resolver := &mockDNSResolver{
+		txts: map[string][]string{
+			"txt.seeds":     {id1 + "@127.0.0.1:26656, " + id2 + "@127.0.0.2:26656"},
+			"both.seeds":    {id1 + "@127.0.0.1:26656"},
+			"badtxt.seeds":  {"127.0.0.1:26656"},
+			"mixed.seeds":   {"127.0.0.1:26656 " + id1 + "@127.0.0.1:26656"},
+			"emptytx.seeds": {},
+		},
+		srvs: map[string][]*net.SRV{
+			"srv.seeds":    {{Target: id3 + ".127.0.0.3.", Port: 26657}},
+			"both.seeds":   {{Target: id3 + ".127.0.0.3.", Port: 26657}},
+			"badsrv.seeds": {{Target: "localhost.", Port: 26657}},
+			"mixed.seeds":  {{Target: "localhost.", Port: 26657}, {Target: id3 + ".127.0.0.3.", Port: 26657}},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		expAddrs []string
+		expErr   bool
+	}{
+		{"txt.seeds", []string{id1 + "@127.0.0.1:26656", id2 + "@127.0.0.2:26656"}, false},
+		{"srv.seeds", []string{id3 + "@127.0.0.3:26657"}, false},
+		{"both.seeds", []string{id1 + "@127.0.0.1:26656", id3 + "@127.0.0.3:26657"}, false},
+		{"emptytx.seeds", []string{}, false},
+		{"badtxt.seeds", []string{}, false},
+		{"badsrv.seeds", []string{}, false},
+		{"mixed.seeds", []string{id1 + "@127.0.0.1:26656", id3 + "@127.0.0.3:26657"}, false},
+		{"missing.seeds", nil, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			addrs, err := resolveDNSSeed(context.Background(), resolver, log.TestingLogger(), tc.name)
+			if tc.expErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			strs := make([]string, 0, len(addrs))
+			for _, addr := range addrs {
+				strs = append(strs, addr.String())
+			}
+			assert.Equal(t, tc.expAddrs, strs)
+		})
+	}
+}
+
+func TestSplitDNSSeeds(t *testing.T) {
+	addrs, names := splitDNSSeeds([]string{
+		"ed3dfd27bfc4af18f67a49862f04cc100696e84d@127.0.0.1:26656",
+		"dns://seeds.example.com",
+	})
+	assert.Equal(t, []string{"ed3dfd27bfc4af18f67a49862f04cc100696e84d@127.0.0.1:26656"}, addrs)
+	assert.Equal(t, []string{"seeds.example.com"}, names)
+}
+
+func TestPEXReactorUsesDNSSeeds(t *testing.T) {
+	// directory to store address books
+	dir, err := ioutil.TempDir("", "pex_reactor")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	// 1. create seed
+	seed := testCreateSeed(dir, 0, []*p2p.NetAddress{}, []*p2p.NetAddress{})
+	require.Nil(t, seed.Start())
+	defer seed.Stop() // nolint:errcheck // ignore for tests
+
+	// 2. a DNS seed which can't be resolved fails the start with an empty book
+	resolver := &mockDNSResolver{txts: map[string][]string{}, srvs: map[string][]*net.SRV{}}
+	conf := &ReactorConfig{
+		Seeds:                []string{DNSSeedScheme + "seeds.test"},
+		DNSSeedRefreshPeriod: 10 * time.Millisecond,
+	}
+	peer := testCreatePeerWithConfig(dir, 1, conf, ReactorDNSResolver(resolver))
+	require.Error(t, peer.Start())
+	peer.Stop() // nolint:errcheck // ignore for tests
+
+	// 3. once the DNS seed lists the seed, the peer connects to it
+	resolver.setTXT("seeds.test", seed.NetAddress().String())
+	peer = testCreatePeerWithConfig(dir, 2, conf, ReactorDNSResolver(resolver))
+	require.Nil(t, peer.Start())
+	defer peer.Stop() // nolint:errcheck // ignore for tests
+	assertPeersWithTimeout(t, []*p2p.Switch{peer}, 10*time.Millisecond, 3*time.Second, 1)
+
+	// 4. rotating the DNS seed is picked up by the refresh routine
+	rotated := "d824b13cb5d40fa1d8a614e089357c7eff31b670@127.0.0.2:26656"
+	resolver.setTXT("seeds.test", rotated)
+	r := peer.Reactor("pex").(*Reactor)
+	assert.Eventually(t, func() bool {
+		addrs := r.getSeedAddrs()
+		return len(addrs) == 1 && addrs[0].String() == rotated
+	}, time.Second, 10*time.Millisecond)
+}
@@ -50,18 +50,49 @@ func (a *addrBook) loadFromFile(filePath string) bool {
 		return false
 	}
 
-	// Load addrBookJSON{}
+	aJSON, err := readAddrBookFile(filePath)
+	if err != nil {
+		panic(err)
+	}
+	a.restore(aJSON)
+	return true
+}
+
+// LoadAddrBook creates an address book populated from the file at filePath
+// without starting it. It is meant for offline tooling, which must call Save
+// to persist any changes. An error is returned if the file is corrupt.
+func LoadAddrBook(filePath string, routabilityStrict bool) (AddrBook, error) {
+	book := NewAddrBook(filePath, routabilityStrict).(*addrBook)
+	if _, err := os.Stat(filePath); os.IsNotExist(err) {
+		return book, nil
+	}
+
+	aJSON, err := readAddrBookFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	book.restore(aJSON)
+	return book, nil
+}
+
+func readAddrBookFile(filePath string) (*addrBookJSON, error) {
 	r, err := os.Open(filePath)
 	if err != nil {
-		panic(fmt.Sprintf("Error opening file %s: %v", filePath, err))
+		return nil, fmt.Errorf("error opening file %s: %w", filePath, err)
 	}
 	defer r.Close()
 	aJSON := &addrBookJSON{}
 	dec := json.NewDecoder(r)
 	err = dec.Decode(aJSON)
 	if err != nil {
-		panic(fmt.Sprintf("Error reading file %s: %v", filePath, err))
+		return nil, fmt.Errorf("error reading file %s: %w", filePath, err)
 	}
+	return aJSON, nil
+}
+
+func (a *addrBook) restore(aJSON *addrBookJSON) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
 
 	// Restore all the fields...
 	// Restore the key
@@ -79,5 +110,4 @@ func (a *addrBook) loadFromFile(filePath string) bool {
 			a.nOld++
 		}
 	}
-	return true
 }
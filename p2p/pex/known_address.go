@@ -17,6 +17,11 @@ type knownAddress struct {
 	LastAttempt time.Time       `json:"last_attempt"`
 	LastSuccess time.Time       `json:"last_success"`
 	LastBanTime time.Time       `json:"last_ban_time"`
+
+	// Populated by the seed crawler when it successfully probes the address.
+	LastVerified time.Time     `json:"last_verified"`
+	Latency      time.Duration `json:"latency"`
+	Version      string        `json:"version"`
 }
 
 func newKnownAddress(addr *p2p.NetAddress, src *p2p.NetAddress) *knownAddress {
@@ -55,6 +60,16 @@ func (ka *knownAddress) markGood() {
 	ka.LastSuccess = now
 }
 
+func (ka *knownAddress) markVerified(latency time.Duration, version string) {
+	ka.LastVerified = time.Now()
+	ka.Latency = latency
+	ka.Version = version
+}
+
+func (ka *knownAddress) isVerifiedSince(t time.Time) bool {
+	return ka.LastVerified.After(t)
+}
+
 func (ka *knownAddress) ban(banTime time.Duration) {
 	if ka.LastBanTime.Before(time.Now().Add(banTime)) {
 		ka.LastBanTime = time.Now().Add(banTime)
@@ -93,6 +108,39 @@ func (ka *knownAddress) removeBucketRef(bucketIdx int) int {
 	return len(ka.Buckets)
 }
 
+// info returns an exported snapshot of the known address.
+func (ka *knownAddress) info() KnownAddressInfo {
+	bucketType := "new"
+	if ka.isOld() {
+		bucketType = "old"
+	}
+	return KnownAddressInfo{
+		Addr:         ka.Addr,
+		Src:          ka.Src,
+		BucketType:   bucketType,
+		Attempts:     ka.Attempts,
+		LastAttempt:  ka.LastAttempt,
+		LastSuccess:  ka.LastSuccess,
+		LastVerified: ka.LastVerified,
+		Latency:      ka.Latency,
+		Version:      ka.Version,
+	}
+}
+
+// KnownAddressInfo is a read-only view of an address book entry. It is used
+// by tooling which inspects the address book.
+type KnownAddressInfo struct {
+	Addr         *p2p.NetAddress `json:"addr"`
+	Src          *p2p.NetAddress `json:"src"`
+	BucketType   string          `json:"bucket_type"`
+	Attempts     int32           `json:"attempts"`
+	LastAttempt  time.Time       `json:"last_attempt"`
+	LastSuccess  time.Time       `json:"last_success"`
+	LastVerified time.Time       `json:"last_verified"`
+	Latency      time.Duration   `json:"latency"`
+	Version      string          `json:"version"`
+}
+
 /*
    An address is bad if the address in question is a New address, has not been tried in the last
    minute, and meets one of the following criteria:
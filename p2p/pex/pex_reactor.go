@@ -3,6 +3,7 @@ package pex
 import (
 	"errors"
 	"fmt"
+	"net"
 	"sync"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	tmmath "github.com/creatachain/augusteum/libs/math"
 	tmrand "github.com/creatachain/augusteum/libs/rand"
 	"github.com/creatachain/augusteum/libs/service"
+	tmsync "github.com/creatachain/augusteum/libs/sync"
 	"github.com/creatachain/augusteum/p2p"
 	"github.com/creatachain/augusteum/p2p/conn"
 	tmp2p "github.com/creatachain/augusteum/proto/augusteum/p2p"
@@ -43,6 +45,10 @@ const (
 	// check some peers every this
 	crawlPeerPeriod = 30 * time.Second
 
+	// seeds only hand out addresses which were verified within this period
+	// (falling back to the whole book when none are verified yet)
+	seedVerifiedAddrMaxAge = 6 * time.Hour
+
 	maxAttemptsToDial = 16 // ~ 35h in total (last attempt - 18h)
 
 	// if node connects to seed, it does not have any trusted peers.
@@ -94,6 +100,12 @@ type Reactor struct {
 
 	seedAddrs []*p2p.NetAddress
 
+	// DNS seed names and the addresses they last resolved to
+	dnsResolver  DNSResolver
+	dnsSeeds     []string
+	dnsSeedAddrs []*p2p.NetAddress
+	seedsMtx     tmsync.RWMutex
+
 	attemptsToDial sync.Map // address (string) -> {number of attempts (int), last time dialed (time.Time)}
 
 	// seed/crawled mode fields
@@ -121,7 +133,11 @@ type ReactorConfig struct {
 
 	// Seeds is a list of addresses reactor may use
 	// if it can't connect to peers in the addrbook.
+	// Entries of the form dns://<name> are DNS seeds, see resolveDNSSeed.
 	Seeds []string
+
+	// How often DNS seeds are resolved again (if zero, 30 minutes)
+	DNSSeedRefreshPeriod time.Duration
 }
 
 type _attemptsToDial struct {
@@ -129,17 +145,24 @@ type _attemptsToDial struct {
 	lastDialed time.Time
 }
 
+// ReactorOption sets an optional parameter on the Reactor.
+type ReactorOption func(*Reactor)
+
 // NewReactor creates new PEX reactor.
-func NewReactor(b AddrBook, config *ReactorConfig) *Reactor {
+func NewReactor(b AddrBook, config *ReactorConfig, options ...ReactorOption) *Reactor {
 	r := &Reactor{
 		book:                 b,
 		config:               config,
 		ensurePeersPeriod:    defaultEnsurePeersPeriod,
 		requestsSent:         cmap.NewCMap(),
 		lastReceivedRequests: cmap.NewCMap(),
+		dnsResolver:          net.DefaultResolver,
 		crawlPeerInfos:       make(map[p2p.ID]crawlPeerInfo),
 	}
 	r.BaseReactor = *p2p.NewBaseReactor("PEX", r)
+	for _, option := range options {
+		option(r)
+	}
 	return r
 }
 
@@ -153,12 +176,20 @@ func (r *Reactor) OnStart() error {
 	numOnline, seedAddrs, err := r.checkSeeds()
 	if err != nil {
 		return err
-	} else if numOnline == 0 && r.book.Empty() {
-		return errors.New("address book is empty and couldn't resolve any seed nodes")
 	}
-
 	r.seedAddrs = seedAddrs
 
+	if _, r.dnsSeeds = splitDNSSeeds(r.config.Seeds); len(r.dnsSeeds) > 0 {
+		dnsSeedAddrs := r.resolveDNSSeeds()
+		r.setDNSSeedAddrs(dnsSeedAddrs)
+		numOnline = tmmath.MaxInt(numOnline, 0) + len(dnsSeedAddrs)
+		go r.dnsSeedsRoutine()
+	}
+
+	if numOnline == 0 && r.book.Empty() {
+		return errors.New("address book is empty and couldn't resolve any seed nodes")
+	}
+
 	// Check if this node should run
 	// in seed/crawler mode
 	if r.config.SeedMode {
@@ -266,7 +297,7 @@ func (r *Reactor) Receive(chID byte, src Peer, msgBytes []byte) {
 			r.lastReceivedRequests.Set(id, time.Now())
 
 			// Send addrs and disconnect
-			r.SendAddrs(src, r.book.GetSelectionWithBias(biasToSelectNewPeers))
+			r.SendAddrs(src, r.seedSelection())
 			go func() {
 				// In a go-routine so it doesn't block .Receive.
 				src.FlushStop()
@@ -367,7 +398,7 @@ func (r *Reactor) ReceiveAddrs(addrs []*p2p.NetAddress, src Peer) error {
 	}
 
 	srcIsSeed := false
-	for _, seedAddr := range r.seedAddrs {
+	for _, seedAddr := range r.getSeedAddrs() {
 		if seedAddr.Equals(srcAddr) {
 			srcIsSeed = true
 			break
@@ -405,6 +436,16 @@ func (r *Reactor) ReceiveAddrs(addrs []*p2p.NetAddress, src Peer) error {
 	return nil
 }
 
+// seedSelection returns the addresses a seed hands out to requesters: the
+// ones its crawler verified recently or, if there are none yet, a biased
+// selection of the whole book.
+func (r *Reactor) seedSelection() []*p2p.NetAddress {
+	if addrs := r.book.GetVerifiedSelection(time.Now().Add(-seedVerifiedAddrMaxAge)); len(addrs) > 0 {
+		return addrs
+	}
+	return r.book.GetSelectionWithBias(biasToSelectNewPeers)
+}
+
 // SendAddrs sends addrs to the peer.
 func (r *Reactor) SendAddrs(p Peer, netAddrs []*p2p.NetAddress) {
 	p.Send(PexChannel, mustEncode(&tmp2p.PexAddrs{Addrs: p2p.NetAddressesToProto(netAddrs)}))
@@ -600,12 +641,14 @@ func (r *Reactor) maxBackoffDurationForPeer(addr *p2p.NetAddress, planned time.D
 // return err if user provided any badly formatted seed addresses.
 // Doesn't error if the seed node can't be reached.
 // numOnline returns -1 if no seed nodes were in the initial configuration.
+// DNS seeds are resolved separately.
 func (r *Reactor) checkSeeds() (numOnline int, netAddrs []*p2p.NetAddress, err error) {
-	lSeeds := len(r.config.Seeds)
+	seeds, _ := splitDNSSeeds(r.config.Seeds)
+	lSeeds := len(seeds)
 	if lSeeds == 0 {
 		return -1, nil, nil
 	}
-	netAddrs, errs := p2p.NewNetAddressStrings(r.config.Seeds)
+	netAddrs, errs := p2p.NewNetAddressStrings(seeds)
 	numOnline = lSeeds - len(errs)
 	for _, err := range errs {
 		switch e := err.(type) {
@@ -620,11 +663,12 @@ func (r *Reactor) checkSeeds() (numOnline int, netAddrs []*p2p.NetAddress, err e
 
 // randomly dial seeds until we connect to one or exhaust them
 func (r *Reactor) dialSeeds() {
-	perm := tmrand.Perm(len(r.seedAddrs))
+	seedAddrs := r.getSeedAddrs()
+	perm := tmrand.Perm(len(seedAddrs))
 	// perm := r.Switch.rng.Perm(lSeeds)
 	for _, i := range perm {
 		// dial a random seed
-		seedAddr := r.seedAddrs[i]
+		seedAddr := seedAddrs[i]
 		err := r.Switch.DialPeerWithAddress(seedAddr)
 
 		switch err.(type) {
@@ -634,7 +678,7 @@ func (r *Reactor) dialSeeds() {
 		r.Switch.Logger.Error("Error dialing seed", "err", err, "seed", seedAddr)
 	}
 	// do not write error message if there were no seeds specified in config
-	if len(r.seedAddrs) > 0 {
+	if len(seedAddrs) > 0 {
 		r.Switch.Logger.Error("Couldn't connect to any seeds")
 	}
 }
@@ -656,7 +700,7 @@ func (r *Reactor) AttemptsToDial(addr *p2p.NetAddress) int {
 // from peers, except other seed nodes.
 func (r *Reactor) crawlPeersRoutine() {
 	// If we have any seed nodes, consult them first
-	if len(r.seedAddrs) > 0 {
+	if len(r.getSeedAddrs()) > 0 {
 		r.dialSeeds()
 	} else {
 		// Do an initial crawl
@@ -711,6 +755,7 @@ func (r *Reactor) crawlPeers(addrs []*p2p.NetAddress) {
 			LastCrawled: now,
 		}
 
+		start := time.Now()
 		err := r.dialPeer(addr)
 		if err != nil {
 			switch err.(type) {
@@ -724,6 +769,8 @@ func (r *Reactor) crawlPeers(addrs []*p2p.NetAddress) {
 
 		peer := r.Switch.Peers().Get(addr.ID)
 		if peer != nil {
+			// Record liveness, so that only verified addresses are handed out.
+			r.book.MarkVerified(addr.ID, time.Since(start), nodeInfoVersion(peer.NodeInfo()))
 			r.RequestAddrs(peer)
 		}
 	}
@@ -756,6 +803,15 @@ func (r *Reactor) attemptDisconnects() {
 	}
 }
 
+// nodeInfoVersion returns the software version the peer reported during the
+// handshake, or an empty string if it is unknown.
+func nodeInfoVersion(ni p2p.NodeInfo) string {
+	if dni, ok := ni.(p2p.DefaultNodeInfo); ok {
+		return dni.Version
+	}
+	return ""
+}
+
 func markAddrInBookBasedOnErr(addr *p2p.NetAddress, book AddrBook, err error) {
 	// TODO: detect more "bad peer" scenarios
 	switch err.(type) {
@@ -577,7 +577,7 @@ func assertPeersWithTimeout(
 }
 
 // Creates a peer with the provided config
-func testCreatePeerWithConfig(dir string, id int, config *ReactorConfig) *p2p.Switch {
+func testCreatePeerWithConfig(dir string, id int, config *ReactorConfig, options ...ReactorOption) *p2p.Switch {
 	peer := p2p.MakeSwitch(
 		cfg,
 		id,
@@ -593,6 +593,7 @@ func testCreatePeerWithConfig(dir string, id int, config *ReactorConfig) *p2p.Sw
 			r := NewReactor(
 				book,
 				config,
+				options...,
 			)
 			r.SetLogger(log.TestingLogger())
 			sw.AddReactor("pex", r)
@@ -90,6 +90,8 @@ type Switch struct {
 	rng *rand.Rand // seed for randomizing dial times and orders
 
 	metrics *Metrics
+
+	capture *Capture
 }
 
 // NetAddress returns the address the switch is listening on.
@@ -149,6 +151,12 @@ func WithMetrics(metrics *Metrics) SwitchOption {
 	return func(sw *Switch) { sw.metrics = metrics }
 }
 
+// SwitchCapture sets the capture recording the messages exchanged with peers.
+// The capture is started and stopped along with the switch.
+func SwitchCapture(capture *Capture) SwitchOption {
+	return func(sw *Switch) { sw.capture = capture }
+}
+
 //---------------------------------------------------------------------
 // Switch setup
 
@@ -221,6 +229,13 @@ func (sw *Switch) SetNodeKey(nodeKey *NodeKey) {
 
 // OnStart implements BaseService. It starts all the reactors and peers.
 func (sw *Switch) OnStart() error {
+	// Start capturing messages before any peer can connect.
+	if sw.capture != nil {
+		if err := sw.capture.Start(); err != nil {
+			return fmt.Errorf("failed to start p2p capture: %w", err)
+		}
+	}
+
 	// Start reactors
 	for _, reactor := range sw.reactors {
 		err := reactor.Start()
@@ -249,6 +264,12 @@ func (sw *Switch) OnStop() {
 			sw.Logger.Error("error while stopped reactor", "reactor", reactor, "error", err)
 		}
 	}
+
+	if sw.capture != nil {
+		if err := sw.capture.Stop(); err != nil {
+			sw.Logger.Error("error while stopping p2p capture", "error", err)
+		}
+	}
 }
 
 //---------------------------------------------------------------------
@@ -622,6 +643,7 @@ func (sw *Switch) acceptRoutine() {
 			onPeerError:  sw.StopPeerForError,
 			reactorsByCh: sw.reactorsByCh,
 			metrics:      sw.metrics,
+			capture:      sw.capture,
 			isPersistent: sw.IsPeerPersistent,
 		})
 		if err != nil {
@@ -726,6 +748,7 @@ func (sw *Switch) addOutboundPeerWithConfig(
 		isPersistent: sw.IsPeerPersistent,
 		reactorsByCh: sw.reactorsByCh,
 		metrics:      sw.metrics,
+		capture:      sw.capture,
 	})
 	if err != nil {
 		if e, ok := err.(ErrRejected); ok {
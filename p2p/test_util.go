@@ -151,6 +151,7 @@ func (sw *Switch) addPeerWithConnection(conn net.Conn) error {
 		sw.reactorsByCh,
 		sw.chDescs,
 		sw.StopPeerForError,
+		PeerCapture(sw.capture),
 	)
 
 	if err = sw.addPeer(p); err != nil {
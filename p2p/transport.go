@@ -50,6 +50,7 @@ type peerConfig struct {
 	isPersistent func(*NetAddress) bool
 	reactorsByCh map[byte]Reactor
 	metrics      *Metrics
+	capture      *Capture
 }
 
 // Transport emits and connects to Peers. The implementation of Peer is left to
@@ -509,6 +510,7 @@ func (mt *MultiplexTransport) wrapPeer(
 		cfg.chDescs,
 		cfg.onPeerError,
 		PeerMetrics(cfg.metrics),
+		PeerCapture(cfg.capture),
 	)
 
 	return p
@@ -25,5 +25,19 @@ SignerClient handles remote validator connections that provide signing services.
 In production, it's recommended to wrap it with RetrySignerClient to avoid
 termination in case of temporary errors.
 
+grpc.SignerClient
+
+grpc.SignerClient, in the grpc subpackage, connects to a remote signer serving
+the PrivValidatorAPI gRPC service, authenticating both ends with mutual TLS.
+Unlike SignerListenerEndpoint, the node dials the remote signer, whose address
+is given to priv_validator_laddr with the grpc:// scheme.
+
+MultiSignerClient
+
+MultiSignerClient coordinates several signers holding the same key, given as
+comma-separated addresses in priv_validator_laddr. It only signs once a quorum
+of them report the same last signed height/round/step and agree on the
+signature, and refuses to sign when they disagree.
+
 */
 package privval
@@ -33,3 +33,7 @@ type RemoteSignerError struct {
 func (e *RemoteSignerError) Error() string {
 	return fmt.Sprintf("signerEndpoint returned error #%d: %s", e.Code, e.Description)
 }
+
+// ErrSignersDisagree is returned by MultiSignerClient when less than a quorum
+// of signers agree on the last signed state or on a signature.
+var ErrSignersDisagree = errors.New("signers disagree")
@@ -268,6 +268,14 @@ func (pv *FilePV) SignProposal(chainID string, proposal *tmproto.Proposal) error
 	return nil
 }
 
+// GetLastSignState returns a copy of the height, round and step last signed,
+// along with the signature and sign bytes. Implements StatefulSigner.
+func (pv *FilePV) GetLastSignState() (*FilePVLastSignState, error) {
+	lss := pv.LastSignState
+	lss.filePath = ""
+	return &lss, nil
+}
+
 // Save persists the FilePV to disk.
 func (pv *FilePV) Save() {
 	pv.Key.Save()
@@ -326,9 +334,9 @@ func (pv *FilePV) signVote(chainID string, vote *tmproto.Vote) error {
 			vote.Timestamp = timestamp
 			vote.Signature = lss.Signature
 		} else {
-			err = fmt.Errorf("conflicting data")
+			return fmt.Errorf("conflicting data")
 		}
-		return err
+		return pv.signVoteExtension(chainID, vote)
 	}
 
 	// It passed the checks. Sign the vote
@@ -338,6 +346,21 @@ func (pv *FilePV) signVote(chainID string, vote *tmproto.Vote) error {
 	}
 	pv.saveSigned(height, round, step, signBytes, sig)
 	vote.Signature = sig
+	return pv.signVoteExtension(chainID, vote)
+}
+
+// signVoteExtension sets the extension signature of a precommit for a block.
+// The extension isn't part of the last sign state: signing different
+// extensions for the same vote can't be used to equivocate.
+func (pv *FilePV) signVoteExtension(chainID string, vote *tmproto.Vote) error {
+	if !types.IsExtendableVote(vote) {
+		return nil
+	}
+	sig, err := pv.Key.PrivKey.Sign(types.VoteExtensionSignBytes(chainID, vote))
+	if err != nil {
+		return err
+	}
+	vote.ExtensionSignature = sig
 	return nil
 }
 
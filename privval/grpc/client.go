@@ -0,0 +1,135 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
+
+	"github.com/creatachain/augusteum/crypto"
+	cryptoenc "github.com/creatachain/augusteum/crypto/encoding"
+	"github.com/creatachain/augusteum/libs/log"
+	"github.com/creatachain/augusteum/privval"
+	privvalproto "github.com/creatachain/augusteum/proto/augusteum/privval"
+	tmproto "github.com/creatachain/augusteum/proto/augusteum/types"
+	"github.com/creatachain/augusteum/types"
+)
+
+// SignerClient implements PrivValidator.
+// Handles remote validator connections that provide signing services
+type SignerClient struct {
+	logger log.Logger
+
+	conn    *grpc.ClientConn
+	client  privvalproto.PrivValidatorAPIClient
+	timeout time.Duration
+	chainID string
+}
+
+var _ types.PrivValidator = (*SignerClient)(nil)
+
+// NewSignerClient returns an instance of SignerClient using the given
+// connection to the remote signer. Every request times out after timeout.
+func NewSignerClient(conn *grpc.ClientConn, chainID string, timeout time.Duration, logger log.Logger) *SignerClient {
+	return &SignerClient{
+		logger:  logger,
+		conn:    conn,
+		client:  privvalproto.NewPrivValidatorAPIClient(conn),
+		timeout: timeout,
+		chainID: chainID,
+	}
+}
+
+// Close closes the underlying connection
+func (sc *SignerClient) Close() error {
+	sc.logger.Info("Stopping service")
+	return sc.conn.Close()
+}
+
+// WaitForConnection waits maxWait for the connection to the remote signer to
+// be ready. The returned error has a Timeout method, like the one of
+// privval.SignerClient.
+func (sc *SignerClient) WaitForConnection(maxWait time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), maxWait)
+	defer cancel()
+	for {
+		state := sc.conn.GetState()
+		if state == connectivity.Ready {
+			return nil
+		}
+		if !sc.conn.WaitForStateChange(ctx, state) {
+			return ctx.Err()
+		}
+	}
+}
+
+//--------------------------------------------------------
+// Implement PrivValidator
+
+// GetPubKey retrieves a public key from a remote signer
+// returns an error if client is not able to provide the key
+func (sc *SignerClient) GetPubKey() (crypto.PubKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), sc.timeout)
+	defer cancel()
+
+	resp, err := sc.client.GetPubKey(ctx, &privvalproto.PubKeyRequest{ChainId: sc.chainID})
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	if resp.Error != nil {
+		return nil, &privval.RemoteSignerError{Code: int(resp.Error.Code), Description: resp.Error.Description}
+	}
+
+	return cryptoenc.PubKeyFromProto(resp.PubKey)
+}
+
+// SignVote requests a remote signer to sign a vote
+func (sc *SignerClient) SignVote(chainID string, vote *tmproto.Vote) error {
+	ctx, cancel := context.WithTimeout(context.Background(), sc.timeout)
+	defer cancel()
+
+	resp, err := sc.client.SignVote(ctx, &privvalproto.SignVoteRequest{ChainId: chainID, Vote: vote})
+	if err != nil {
+		return fromStatus(err)
+	}
+	if resp.Error != nil {
+		return &privval.RemoteSignerError{Code: int(resp.Error.Code), Description: resp.Error.Description}
+	}
+
+	*vote = resp.Vote
+
+	return nil
+}
+
+// SignProposal requests a remote signer to sign a proposal
+func (sc *SignerClient) SignProposal(chainID string, proposal *tmproto.Proposal) error {
+	ctx, cancel := context.WithTimeout(context.Background(), sc.timeout)
+	defer cancel()
+
+	resp, err := sc.client.SignProposal(ctx, &privvalproto.SignProposalRequest{ChainId: chainID, Proposal: proposal})
+	if err != nil {
+		return fromStatus(err)
+	}
+	if resp.Error != nil {
+		return &privval.RemoteSignerError{Code: int(resp.Error.Code), Description: resp.Error.Description}
+	}
+
+	*proposal = resp.Proposal
+
+	return nil
+}
+
+// fromStatus turns the error status returned by the remote signer into a
+// privval.RemoteSignerError. Other errors, like transport ones, are returned
+// unchanged.
+func fromStatus(err error) error {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() == codes.Unavailable || st.Code() == codes.DeadlineExceeded ||
+		st.Code() == codes.Canceled {
+		return err
+	}
+	return &privval.RemoteSignerError{Code: int(st.Code()), Description: st.Message()}
+}
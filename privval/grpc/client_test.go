@@ -0,0 +1,137 @@
+package grpc_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/creatachain/augusteum/crypto/tmhash"
+	"github.com/creatachain/augusteum/libs/log"
+	tmrand "github.com/creatachain/augusteum/libs/rand"
+	"github.com/creatachain/augusteum/privval"
+	tmgrpc "github.com/creatachain/augusteum/privval/grpc"
+	grpctest "github.com/creatachain/augusteum/privval/grpc/test"
+	tmproto "github.com/creatachain/augusteum/proto/augusteum/types"
+	"github.com/creatachain/augusteum/types"
+)
+
+const chainID = "chain-id"
+
+// newTestCA returns a function issuing certificates signed by a new CA.
+func newTestCA(t *testing.T, dir, name string) func(name string) grpctest.Certificate {
+	ca, err := grpctest.NewCA(dir, name)
+	require.NoError(t, err)
+	return func(name string) grpctest.Certificate {
+		files, err := ca.Issue(name)
+		require.NoError(t, err)
+		return files
+	}
+}
+
+// startSigner serves PrivValidatorAPI with mock private validator on a random
+// local port and returns its grpc:// address.
+func startSigner(t *testing.T, files grpctest.Certificate, pv types.PrivValidator) string {
+	tlsConfig, err := tmgrpc.ServerTLSConfig(files.CertFile, files.KeyFile, files.RootCAFile)
+	require.NoError(t, err)
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := tmgrpc.NewServer(tlsConfig, tmgrpc.NewSignerServer(chainID, pv, log.TestingLogger()))
+	go func() { _ = s.Serve(ln) }()
+	t.Cleanup(s.Stop)
+	return "grpc://" + ln.Addr().String()
+}
+
+func dialSigner(t *testing.T, addr string, files grpctest.Certificate) *tmgrpc.SignerClient {
+	tlsConfig, err := tmgrpc.ClientTLSConfig(files.CertFile, files.KeyFile, files.RootCAFile)
+	require.NoError(t, err)
+	sc, err := tmgrpc.DialRemoteSigner(addr, tlsConfig, chainID, log.TestingLogger())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sc.Close() })
+	return sc
+}
+
+func TestSignerClient(t *testing.T) {
+	dir := t.TempDir()
+	issue := newTestCA(t, dir, "ca")
+	pv := types.NewMockPV()
+	addr := startSigner(t, issue("signer"), pv)
+	sc := dialSigner(t, addr, issue("node"))
+	require.NoError(t, sc.WaitForConnection(5*time.Second))
+
+	pubKey, err := sc.GetPubKey()
+	require.NoError(t, err)
+	expected, err := pv.GetPubKey()
+	require.NoError(t, err)
+	assert.Equal(t, expected, pubKey)
+
+	hash := tmrand.Bytes(tmhash.Size)
+	vote := &tmproto.Vote{
+		Type:             tmproto.PrecommitType,
+		Height:           1,
+		BlockID:          tmproto.BlockID{Hash: hash, PartSetHeader: tmproto.PartSetHeader{Hash: hash, Total: 2}},
+		Timestamp:        time.Now(),
+		ValidatorAddress: pubKey.Address(),
+	}
+	require.NoError(t, sc.SignVote(chainID, vote))
+	assert.True(t, pubKey.VerifySignature(types.VoteSignBytes(chainID, vote), vote.Signature))
+
+	proposal := &tmproto.Proposal{
+		Type:      tmproto.ProposalType,
+		Height:    1,
+		PolRound:  -1,
+		BlockID:   tmproto.BlockID{Hash: hash, PartSetHeader: tmproto.PartSetHeader{Hash: hash, Total: 2}},
+		Timestamp: time.Now(),
+	}
+	require.NoError(t, sc.SignProposal(chainID, proposal))
+	assert.True(t, pubKey.VerifySignature(types.ProposalSignBytes(chainID, proposal), proposal.Signature))
+
+	// the remote signer only signs for its chain
+	err = sc.SignVote("other-chain", vote)
+	var rsErr *privval.RemoteSignerError
+	assert.ErrorAs(t, err, &rsErr)
+}
+
+func TestSignerClientRemoteSignerError(t *testing.T) {
+	dir := t.TempDir()
+	issue := newTestCA(t, dir, "ca")
+	addr := startSigner(t, issue("signer"), types.NewErroringMockPV())
+	sc := dialSigner(t, addr, issue("node"))
+
+	err := sc.SignProposal(chainID, &tmproto.Proposal{})
+	var rsErr *privval.RemoteSignerError
+	assert.ErrorAs(t, err, &rsErr)
+}
+
+func TestSignerClientRequiresMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	issue := newTestCA(t, dir, "ca")
+	rogue := newTestCA(t, dir, "rogue")
+	addr := startSigner(t, issue("signer"), types.NewMockPV())
+
+	// the node certificate is not signed by the CA of the signer
+	files := rogue("node")
+	files.RootCAFile = issue("other").RootCAFile
+	sc := dialSigner(t, addr, files)
+	err := sc.WaitForConnection(500 * time.Millisecond)
+	if err == nil {
+		// the TLS handshake of the client may complete before the signer
+		// rejects its certificate
+		_, err = sc.GetPubKey()
+	}
+	assert.Error(t, err)
+
+	// the signer certificate is not signed by the CA trusted by the node
+	addr = startSigner(t, rogue("rogue-signer"), types.NewMockPV())
+	sc = dialSigner(t, addr, issue("node"))
+	assert.Error(t, sc.WaitForConnection(500*time.Millisecond))
+}
+
+func TestDialRemoteSignerInvalidAddr(t *testing.T) {
+	_, err := tmgrpc.DialRemoteSigner("tcp://127.0.0.1:26659", nil, chainID, log.TestingLogger())
+	assert.Error(t, err)
+	assert.True(t, tmgrpc.IsGRPCAddr("grpc://127.0.0.1:26659"))
+	assert.False(t, tmgrpc.IsGRPCAddr("unix:///tmp/signer.sock"))
+}
@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cryptoenc "github.com/creatachain/augusteum/crypto/encoding"
+	"github.com/creatachain/augusteum/libs/log"
+	privvalproto "github.com/creatachain/augusteum/proto/augusteum/privval"
+	"github.com/creatachain/augusteum/types"
+)
+
+// SignerServer implements PrivValidatorAPIServer, serving the signing requests
+// of a node with the given private validator.
+type SignerServer struct {
+	logger  log.Logger
+	chainID string
+	privVal types.PrivValidator
+}
+
+var _ privvalproto.PrivValidatorAPIServer = (*SignerServer)(nil)
+
+// NewSignerServer returns a SignerServer signing for the given chain only.
+func NewSignerServer(chainID string, privVal types.PrivValidator, logger log.Logger) *SignerServer {
+	return &SignerServer{
+		logger:  logger,
+		chainID: chainID,
+		privVal: privVal,
+	}
+}
+
+// GetPubKey receives a request for the pubkey
+// returns the pubkey on success and error on failure
+func (ss *SignerServer) GetPubKey(ctx context.Context, req *privvalproto.PubKeyRequest) (
+	*privvalproto.PubKeyResponse, error) {
+	if err := ss.checkChainID(req.ChainId); err != nil {
+		return nil, err
+	}
+
+	pubKey, err := ss.privVal.GetPubKey()
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "error getting pubkey: %v", err)
+	}
+
+	pk, err := cryptoenc.PubKeyToProto(pubKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error transitioning pubkey to proto: %v", err)
+	}
+
+	ss.logger.Info("SignerServer: GetPubKey Success")
+
+	return &privvalproto.PubKeyResponse{PubKey: pk}, nil
+}
+
+// SignVote receives a vote sign requests, attempts to sign it
+// returns SignedVoteResponse on success and error on failure
+func (ss *SignerServer) SignVote(ctx context.Context, req *privvalproto.SignVoteRequest) (
+	*privvalproto.SignedVoteResponse, error) {
+	if err := ss.checkChainID(req.ChainId); err != nil {
+		return nil, err
+	}
+	if req.Vote == nil {
+		return nil, status.Error(codes.InvalidArgument, "nil vote")
+	}
+
+	vote := req.Vote
+	if err := ss.privVal.SignVote(req.ChainId, vote); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error signing vote: %v", err)
+	}
+
+	ss.logger.Info("SignerServer: SignVote Success", "height", vote.Height, "round", vote.Round, "type", vote.Type)
+
+	return &privvalproto.SignedVoteResponse{Vote: *vote}, nil
+}
+
+// SignProposal receives a proposal sign requests, attempts to sign it
+// returns SignedProposalResponse on success and error on failure
+func (ss *SignerServer) SignProposal(ctx context.Context, req *privvalproto.SignProposalRequest) (
+	*privvalproto.SignedProposalResponse, error) {
+	if err := ss.checkChainID(req.ChainId); err != nil {
+		return nil, err
+	}
+	if req.Proposal == nil {
+		return nil, status.Error(codes.InvalidArgument, "nil proposal")
+	}
+
+	proposal := req.Proposal
+	if err := ss.privVal.SignProposal(req.ChainId, proposal); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error signing proposal: %v", err)
+	}
+
+	ss.logger.Info("SignerServer: SignProposal Success", "height", proposal.Height, "round", proposal.Round)
+
+	return &privvalproto.SignedProposalResponse{Proposal: *proposal}, nil
+}
+
+func (ss *SignerServer) checkChainID(chainID string) error {
+	if chainID != ss.chainID {
+		return status.Errorf(codes.InvalidArgument, "want chainID: %s, got chainID: %s", ss.chainID, chainID)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package grpctest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"path/filepath"
+	"time"
+)
+
+// CA is a certificate authority issuing short lived certificates for
+// 127.0.0.1, to test mutual TLS between nodes and remote signers.
+type CA struct {
+	dir    string
+	file   string
+	cert   *x509.Certificate
+	key    *ecdsa.PrivateKey
+	serial int64
+}
+
+// Certificate holds the PEM files of a certificate issued by a CA, its key and
+// the certificate of the CA.
+type Certificate struct {
+	CertFile   string
+	KeyFile    string
+	RootCAFile string
+}
+
+// NewCA writes a self-signed CA certificate named name to dir.
+func NewCA(dir, name string) (*CA, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+	file := filepath.Join(dir, name+".crt")
+	if err := writePEM(file, "CERTIFICATE", der); err != nil {
+		return nil, err
+	}
+	return &CA{dir: dir, file: file, cert: cert, key: key, serial: 1}, nil
+}
+
+// Issue writes a certificate named name, signed by the CA, and its key to the
+// directory of the CA.
+func (ca *CA) Issue(name string) (Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return Certificate{}, err
+	}
+	ca.serial++
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(ca.serial),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		return Certificate{}, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return Certificate{}, err
+	}
+
+	files := Certificate{
+		CertFile:   filepath.Join(ca.dir, name+".crt"),
+		KeyFile:    filepath.Join(ca.dir, name+".key"),
+		RootCAFile: ca.file,
+	}
+	if err := writePEM(files.CertFile, "CERTIFICATE", der); err != nil {
+		return Certificate{}, err
+	}
+	if err := writePEM(files.KeyFile, "EC PRIVATE KEY", keyDER); err != nil {
+		return Certificate{}, err
+	}
+	return files, nil
+}
+
+func writePEM(path, blockType string, der []byte) error {
+	return ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600)
+}
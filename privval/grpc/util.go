@@ -0,0 +1,130 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+
+	"github.com/creatachain/augusteum/libs/log"
+	tmnet "github.com/creatachain/augusteum/libs/net"
+	privvalproto "github.com/creatachain/augusteum/proto/augusteum/privval"
+)
+
+const (
+	// DefaultTimeout is the timeout of a request to the remote signer.
+	DefaultTimeout = 3 * time.Second
+
+	keepaliveTime    = 10 * time.Second
+	keepaliveTimeout = 5 * time.Second
+)
+
+// IsGRPCAddr returns true if the priv_validator_laddr has the grpc:// scheme,
+// in which case the node dials the remote signer rather than listening for it.
+func IsGRPCAddr(addr string) bool {
+	protocol, _ := tmnet.ProtocolAndAddress(addr)
+	return protocol == "grpc"
+}
+
+// ClientTLSConfig returns the TLS config of the node connecting to a remote
+// signer. The node authenticates with the certificate and key, and verifies
+// the certificate of the remote signer against the root CA.
+func ClientTLSConfig(certFile, keyFile, rootCAFile string) (*tls.Config, error) {
+	cert, pool, err := loadCertificates(certFile, keyFile, rootCAFile)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// ServerTLSConfig returns the TLS config of a remote signer. The signer
+// authenticates with the certificate and key, and only accepts nodes with a
+// certificate signed by the root CA.
+func ServerTLSConfig(certFile, keyFile, rootCAFile string) (*tls.Config, error) {
+	cert, pool, err := loadCertificates(certFile, keyFile, rootCAFile)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func loadCertificates(certFile, keyFile, rootCAFile string) (tls.Certificate, *x509.CertPool, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to load certificate: %w", err)
+	}
+	ca, err := ioutil.ReadFile(rootCAFile)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to read root CA: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return tls.Certificate{}, nil, errors.New("no certificate found in root CA")
+	}
+	return cert, pool, nil
+}
+
+// DialRemoteSigner returns a SignerClient for the remote signer serving
+// PrivValidatorAPI at the given grpc:// address, authenticating both ends with
+// the TLS config. Unless opts include grpc.WithBlock, the connection is
+// established in the background and kept alive with keepalive pings.
+func DialRemoteSigner(addr string, tlsConfig *tls.Config, chainID string, logger log.Logger,
+	opts ...grpc.DialOption) (*SignerClient, error) {
+	if !IsGRPCAddr(addr) {
+		return nil, fmt.Errorf("expected a grpc:// address, got %q", addr)
+	}
+	if tlsConfig == nil {
+		return nil, errors.New("a TLS config is required to connect to a remote signer")
+	}
+	_, target := tmnet.ProtocolAndAddress(addr)
+
+	opts = append([]grpc.DialOption{
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                keepaliveTime,
+			Timeout:             keepaliveTimeout,
+			PermitWithoutStream: true,
+		}),
+	}, opts...)
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, target, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to remote signer at %s: %w", target, err)
+	}
+
+	return NewSignerClient(conn, chainID, DefaultTimeout, logger), nil
+}
+
+// NewServer returns a gRPC server serving PrivValidatorAPI with the given
+// SignerServer, only accepting nodes which authenticate with the TLS config
+// and allowing their keepalive pings.
+func NewServer(tlsConfig *tls.Config, ss *SignerServer, opts ...grpc.ServerOption) *grpc.Server {
+	opts = append([]grpc.ServerOption{
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             keepaliveTime / 2,
+			PermitWithoutStream: true,
+		}),
+	}, opts...)
+	s := grpc.NewServer(opts...)
+	privvalproto.RegisterPrivValidatorAPIServer(s, ss)
+	return s
+}
@@ -32,6 +32,10 @@ func mustWrapMsg(pb proto.Message) privvalproto.Message {
 		msg.Sum = &privvalproto.Message_PingRequest{PingRequest: pb}
 	case *privvalproto.PingResponse:
 		msg.Sum = &privvalproto.Message_PingResponse{PingResponse: pb}
+	case *privvalproto.LastSignStateRequest:
+		msg.Sum = &privvalproto.Message_LastSignStateRequest{LastSignStateRequest: pb}
+	case *privvalproto.LastSignStateResponse:
+		msg.Sum = &privvalproto.Message_LastSignStateResponse{LastSignStateResponse: pb}
 	default:
 		panic(fmt.Errorf("unknown message type %T", pb))
 	}
@@ -0,0 +1,275 @@
+package privval
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/creatachain/augusteum/crypto"
+	tmsync "github.com/creatachain/augusteum/libs/sync"
+	tmproto "github.com/creatachain/augusteum/proto/augusteum/types"
+	"github.com/creatachain/augusteum/types"
+)
+
+// StatefulSigner is a PrivValidator which reports the height, round and step
+// (HRS) it last signed.
+type StatefulSigner interface {
+	types.PrivValidator
+	GetLastSignState() (*FilePVLastSignState, error)
+}
+
+var (
+	_ StatefulSigner = (*FilePV)(nil)
+	_ StatefulSigner = (*SignerClient)(nil)
+	_ StatefulSigner = (*RetrySignerClient)(nil)
+)
+
+// MultiSignerClient coordinates several signers holding the same key. Before
+// signing, it queries the last signed state of the signers, the highest of
+// which is the HRS high-water mark, and refuses to sign a regression from that
+// state. The high-water mark is only signed again if a quorum of signers
+// reached it, while the lagging signers catch up by signing a later state. A
+// signature is only returned if a quorum of signers produced it.
+type MultiSignerClient struct {
+	mtx     tmsync.Mutex
+	signers []StatefulSigner
+	quorum  int
+}
+
+var _ types.PrivValidator = (*MultiSignerClient)(nil)
+
+// NewMultiSignerClient returns a MultiSignerClient over the given signers. If
+// +quorum+ is 0, a majority of the signers is required.
+func NewMultiSignerClient(signers []StatefulSigner, quorum int) (*MultiSignerClient, error) {
+	if len(signers) == 0 {
+		return nil, fmt.Errorf("no signers")
+	}
+	if quorum == 0 {
+		quorum = len(signers)/2 + 1
+	}
+	if quorum < 0 || quorum > len(signers) {
+		return nil, fmt.Errorf("quorum must be between 1 and %d, got %d", len(signers), quorum)
+	}
+	return &MultiSignerClient{signers: signers, quorum: quorum}, nil
+}
+
+// Close closes all the signers.
+func (sc *MultiSignerClient) Close() error {
+	var firstErr error
+	for _, s := range sc.signers {
+		closer, ok := s.(interface{ Close() error })
+		if !ok {
+			continue
+		}
+		if err := closer.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+//--------------------------------------------------------
+// Implement PrivValidator
+
+// GetPubKey returns the public key of the signers. All the signers which
+// respond must report the same key, and at least a quorum must respond.
+func (sc *MultiSignerClient) GetPubKey() (crypto.PubKey, error) {
+	var (
+		pubKey crypto.PubKey
+		n      int
+		errs   []error
+	)
+	for i, s := range sc.signers {
+		pk, err := s.GetPubKey()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("signer #%d: %w", i, err))
+			continue
+		}
+		if pubKey != nil && !pubKey.Equals(pk) {
+			return nil, fmt.Errorf("%w: signer #%d has pubkey %v, expected %v", ErrSignersDisagree, i, pk, pubKey)
+		}
+		pubKey = pk
+		n++
+	}
+	if n < sc.quorum {
+		return nil, fmt.Errorf("got pubkey from %d signers, need %d: %v", n, sc.quorum, errs)
+	}
+	return pubKey, nil
+}
+
+// SignVote signs the vote once a quorum of signers agrees on the last signed
+// state and the vote is not a regression from it.
+func (sc *MultiSignerClient) SignVote(chainID string, vote *tmproto.Vote) error {
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+
+	if err := sc.checkHRS(vote.Height, vote.Round, voteToStep(vote)); err != nil {
+		return err
+	}
+
+	results := make([]*tmproto.Vote, len(sc.signers))
+	errs := sc.forEach(func(i int, s StatefulSigner) error {
+		v := *vote
+		if err := s.SignVote(chainID, &v); err != nil {
+			return err
+		}
+		results[i] = &v
+		return nil
+	})
+
+	idx, err := sc.agreeingResult(errs, func(i, j int) bool {
+		return results[i] != nil && results[j] != nil &&
+			bytes.Equal(results[i].Signature, results[j].Signature) &&
+			bytes.Equal(results[i].ExtensionSignature, results[j].ExtensionSignature) &&
+			results[i].Timestamp.Equal(results[j].Timestamp)
+	})
+	if err != nil {
+		return err
+	}
+	vote.Signature = results[idx].Signature
+	vote.ExtensionSignature = results[idx].ExtensionSignature
+	vote.Timestamp = results[idx].Timestamp
+	return nil
+}
+
+// SignProposal signs the proposal once a quorum of signers agrees on the last
+// signed state and the proposal is not a regression from it.
+func (sc *MultiSignerClient) SignProposal(chainID string, proposal *tmproto.Proposal) error {
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+
+	if err := sc.checkHRS(proposal.Height, proposal.Round, stepPropose); err != nil {
+		return err
+	}
+
+	results := make([]*tmproto.Proposal, len(sc.signers))
+	errs := sc.forEach(func(i int, s StatefulSigner) error {
+		p := *proposal
+		if err := s.SignProposal(chainID, &p); err != nil {
+			return err
+		}
+		results[i] = &p
+		return nil
+	})
+
+	idx, err := sc.agreeingResult(errs, func(i, j int) bool {
+		return results[i] != nil && results[j] != nil &&
+			bytes.Equal(results[i].Signature, results[j].Signature) &&
+			results[i].Timestamp.Equal(results[j].Timestamp)
+	})
+	if err != nil {
+		return err
+	}
+	proposal.Signature = results[idx].Signature
+	proposal.Timestamp = results[idx].Timestamp
+	return nil
+}
+
+// checkHRS queries the last signed state of all signers and checks the given
+// HRS against the high-water mark. If a quorum of signers didn't reach the
+// high-water mark, e.g. as a signer signed while the others timed out, only a
+// later HRS is signed, since the lagging signers could sign different sign
+// bytes at the high-water mark.
+func (sc *MultiSignerClient) checkHRS(height int64, round int32, step int8) error {
+	states := make([]*FilePVLastSignState, len(sc.signers))
+	errs := sc.forEach(func(i int, s StatefulSigner) error {
+		lss, err := s.GetLastSignState()
+		if err != nil {
+			return err
+		}
+		states[i] = lss
+		return nil
+	})
+
+	var hwm *FilePVLastSignState
+	for _, lss := range states {
+		if lss != nil && (hwm == nil || hrsLess(hwm, lss)) {
+			hwm = lss
+		}
+	}
+	if hwm == nil {
+		return fmt.Errorf("failed to get last sign state from any signer: %v", errs)
+	}
+
+	n := 0
+	for _, lss := range states {
+		if lss != nil && lss.Height == hwm.Height && lss.Round == hwm.Round && lss.Step == hwm.Step &&
+			bytes.Equal(lss.SignBytes, hwm.SignBytes) {
+			n++
+		}
+	}
+	if n < sc.quorum {
+		if hrsLess(hwm, &FilePVLastSignState{Height: height, Round: round, Step: step}) {
+			return nil
+		}
+		return fmt.Errorf("%w: %d of %d signers at last signed state %d/%d/%d, need %d",
+			ErrSignersDisagree, n, len(sc.signers), hwm.Height, hwm.Round, hwm.Step, sc.quorum)
+	}
+
+	// Signing the high-water mark again is left to the signers, which reuse
+	// their signature if the sign bytes only differ by timestamp.
+	if hwm.Height == height && hwm.Round == round && hwm.Step == step {
+		return nil
+	}
+	_, err := hwm.CheckHRS(height, round, step)
+	return err
+}
+
+// forEach calls fn for every signer in parallel and returns the errors by
+// signer index.
+func (sc *MultiSignerClient) forEach(fn func(i int, s StatefulSigner) error) []error {
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, len(sc.signers))
+	)
+	for i, s := range sc.signers {
+		wg.Add(1)
+		go func(i int, s StatefulSigner) {
+			defer wg.Done()
+			errs[i] = fn(i, s)
+		}(i, s)
+	}
+	wg.Wait()
+	return errs
+}
+
+// agreeingResult returns the index of a successful result shared by at least a
+// quorum of signers.
+func (sc *MultiSignerClient) agreeingResult(errs []error, equal func(i, j int) bool) (int, error) {
+	for i := range sc.signers {
+		if errs[i] != nil {
+			continue
+		}
+		n := 0
+		for j := range sc.signers {
+			if errs[j] == nil && equal(i, j) {
+				n++
+			}
+		}
+		if n >= sc.quorum {
+			return i, nil
+		}
+	}
+
+	ok := 0
+	for _, err := range errs {
+		if err == nil {
+			ok++
+		}
+	}
+	if ok >= sc.quorum {
+		return 0, fmt.Errorf("%w: no %d signers returned the same signature", ErrSignersDisagree, sc.quorum)
+	}
+	return 0, fmt.Errorf("%d of %d signers signed, need %d: %v", ok, len(sc.signers), sc.quorum, errs)
+}
+
+// hrsLess reports whether the HRS of a is lower than that of b.
+func hrsLess(a, b *FilePVLastSignState) bool {
+	if a.Height != b.Height {
+		return a.Height < b.Height
+	}
+	if a.Round != b.Round {
+		return a.Round < b.Round
+	}
+	return a.Step < b.Step
+}
@@ -0,0 +1,216 @@
+package privval
+
+import (
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/creatachain/augusteum/crypto"
+	"github.com/creatachain/augusteum/crypto/ed25519"
+	"github.com/creatachain/augusteum/crypto/tmhash"
+	tmrand "github.com/creatachain/augusteum/libs/rand"
+	tmproto "github.com/creatachain/augusteum/proto/augusteum/types"
+	"github.com/creatachain/augusteum/types"
+)
+
+// newFilePVs returns n FilePVs holding the same key.
+func newFilePVs(t *testing.T, privKey crypto.PrivKey, n int) []*FilePV {
+	pvs := make([]*FilePV, n)
+	for i := range pvs {
+		keyFile, err := ioutil.TempFile("", "priv_validator_key_")
+		require.NoError(t, err)
+		stateFile, err := ioutil.TempFile("", "priv_validator_state_")
+		require.NoError(t, err)
+		pvs[i] = NewFilePV(privKey, keyFile.Name(), stateFile.Name())
+	}
+	return pvs
+}
+
+func newMultiSignerClient(t *testing.T, pvs []*FilePV, quorum int) *MultiSignerClient {
+	signers := make([]StatefulSigner, len(pvs))
+	for i, pv := range pvs {
+		signers[i] = pv
+	}
+	sc, err := NewMultiSignerClient(signers, quorum)
+	require.NoError(t, err)
+	return sc
+}
+
+func TestNewMultiSignerClient(t *testing.T) {
+	pvs := newFilePVs(t, ed25519.GenPrivKey(), 3)
+
+	sc := newMultiSignerClient(t, pvs, 0)
+	assert.Equal(t, 2, sc.quorum)
+
+	_, err := NewMultiSignerClient(nil, 0)
+	assert.Error(t, err)
+	_, err = NewMultiSignerClient([]StatefulSigner{pvs[0]}, 2)
+	assert.Error(t, err)
+}
+
+func TestMultiSignerClientGetPubKey(t *testing.T) {
+	privKey := ed25519.GenPrivKey()
+	pvs := newFilePVs(t, privKey, 2)
+
+	pubKey, err := newMultiSignerClient(t, pvs, 0).GetPubKey()
+	require.NoError(t, err)
+	assert.Equal(t, privKey.PubKey(), pubKey)
+
+	pvs = append(pvs, newFilePVs(t, ed25519.GenPrivKey(), 1)...)
+	_, err = newMultiSignerClient(t, pvs, 0).GetPubKey()
+	assert.True(t, errors.Is(err, ErrSignersDisagree), err)
+}
+
+func TestMultiSignerClientSignVote(t *testing.T) {
+	const chainID = "mychainid"
+	privKey := ed25519.GenPrivKey()
+	blockID := types.BlockID{Hash: tmrand.Bytes(tmhash.Size),
+		PartSetHeader: types.PartSetHeader{Total: 5, Hash: tmrand.Bytes(tmhash.Size)}}
+	newVote := func(height int64, round int32) *tmproto.Vote {
+		return newVote(privKey.PubKey().Address(), 0, height, round, tmproto.PrevoteType, blockID).ToProto()
+	}
+
+	t.Run("quorum signs", func(t *testing.T) {
+		pvs := newFilePVs(t, privKey, 3)
+		sc := newMultiSignerClient(t, pvs, 0)
+
+		vote := newVote(10, 0)
+		require.NoError(t, sc.SignVote(chainID, vote))
+		assert.True(t, privKey.PubKey().VerifySignature(types.VoteSignBytes(chainID, vote), vote.Signature))
+
+		// signing the same vote again reuses the signature
+		sig := vote.Signature
+		require.NoError(t, sc.SignVote(chainID, vote))
+		assert.Equal(t, sig, vote.Signature)
+
+		// a regression from the last signed state is refused, as is another
+		// block at the same height, round and step
+		assert.Error(t, sc.SignVote(chainID, newVote(9, 0)))
+		conflicting := newVote(10, 0)
+		conflicting.BlockID.Hash = tmrand.Bytes(tmhash.Size)
+		assert.Error(t, sc.SignVote(chainID, conflicting))
+	})
+
+	t.Run("extendable precommit", func(t *testing.T) {
+		pvs := newFilePVs(t, privKey, 3)
+		sc := newMultiSignerClient(t, pvs, 0)
+
+		vote := newVote(10, 0)
+		vote.Type = tmproto.PrecommitType
+		vote.Extension = []byte("extension")
+		require.NoError(t, sc.SignVote(chainID, vote))
+		assert.NotEmpty(t, vote.ExtensionSignature)
+
+		v, err := types.VoteFromProto(vote)
+		require.NoError(t, err)
+		assert.NoError(t, v.Verify(chainID, privKey.PubKey()))
+		assert.NoError(t, v.VerifyExtension(chainID, privKey.PubKey()))
+	})
+
+	t.Run("lagging signer", func(t *testing.T) {
+		pvs := newFilePVs(t, privKey, 3)
+		sc := newMultiSignerClient(t, pvs, 0)
+
+		// two of three signers are at height 10
+		vote := newVote(10, 0)
+		for _, pv := range pvs[:2] {
+			v := *vote
+			require.NoError(t, pv.SignVote(chainID, &v))
+		}
+
+		vote = newVote(11, 0)
+		require.NoError(t, sc.SignVote(chainID, vote))
+		assert.True(t, privKey.PubKey().VerifySignature(types.VoteSignBytes(chainID, vote), vote.Signature))
+	})
+
+	t.Run("signers disagree", func(t *testing.T) {
+		pvs := newFilePVs(t, privKey, 3)
+		sc := newMultiSignerClient(t, pvs, 0)
+
+		// a single signer signed at height 10 without the others
+		require.NoError(t, pvs[0].SignVote(chainID, newVote(10, 0)))
+
+		// the others can't sign at height 10, as they may sign another block
+		vote := newVote(10, 0)
+		vote.BlockID.Hash = tmrand.Bytes(tmhash.Size)
+		err := sc.SignVote(chainID, vote)
+		assert.True(t, errors.Is(err, ErrSignersDisagree), err)
+		assert.Nil(t, vote.Signature)
+
+		lss, err := pvs[1].GetLastSignState()
+		require.NoError(t, err)
+		assert.EqualValues(t, 0, lss.Height)
+
+		// they catch up at height 11
+		vote = newVote(11, 0)
+		require.NoError(t, sc.SignVote(chainID, vote))
+		assert.True(t, privKey.PubKey().VerifySignature(types.VoteSignBytes(chainID, vote), vote.Signature))
+		for _, pv := range pvs {
+			lss, err := pv.GetLastSignState()
+			require.NoError(t, err)
+			assert.EqualValues(t, 11, lss.Height)
+		}
+	})
+
+	t.Run("signatures disagree", func(t *testing.T) {
+		pvs := append(newFilePVs(t, privKey, 2), newFilePVs(t, ed25519.GenPrivKey(), 1)...)
+		sc := newMultiSignerClient(t, pvs, 3)
+
+		err := sc.SignVote(chainID, newVote(10, 0))
+		assert.True(t, errors.Is(err, ErrSignersDisagree), err)
+	})
+}
+
+func TestMultiSignerClientSignProposal(t *testing.T) {
+	const chainID = "mychainid"
+	privKey := ed25519.GenPrivKey()
+	pvs := newFilePVs(t, privKey, 3)
+	sc := newMultiSignerClient(t, pvs, 0)
+	blockID := types.BlockID{Hash: tmrand.Bytes(tmhash.Size),
+		PartSetHeader: types.PartSetHeader{Total: 5, Hash: tmrand.Bytes(tmhash.Size)}}
+
+	proposal := newProposal(10, 0, blockID).ToProto()
+	require.NoError(t, sc.SignProposal(chainID, proposal))
+	assert.True(t, privKey.PubKey().VerifySignature(types.ProposalSignBytes(chainID, proposal), proposal.Signature))
+
+	assert.Error(t, sc.SignProposal(chainID, newProposal(9, 0, blockID).ToProto()))
+}
+
+func TestSignerGetLastSignState(t *testing.T) {
+	for _, dtc := range getDialerTestCases(t) {
+		chainID := tmrand.Str(12)
+		pv := newFilePVs(t, ed25519.GenPrivKey(), 1)[0]
+		require.NoError(t, pv.SignVote(chainID, newVote(pv.Key.Address, 0, 10, 1, tmproto.PrecommitType,
+			types.BlockID{}).ToProto()))
+
+		sl, sd := getMockEndpoints(t, dtc.addr, dtc.dialer)
+		sc, err := NewSignerClient(sl, chainID)
+		require.NoError(t, err)
+		ss := NewSignerServer(sd, chainID, pv)
+		require.NoError(t, ss.Start())
+
+		lss, err := sc.GetLastSignState()
+		require.NoError(t, err)
+		assert.EqualValues(t, 10, lss.Height)
+		assert.EqualValues(t, 1, lss.Round)
+		assert.Equal(t, stepPrecommit, lss.Step)
+		assert.Equal(t, pv.LastSignState.Signature, lss.Signature)
+		assert.Equal(t, pv.LastSignState.SignBytes, lss.SignBytes)
+
+		require.NoError(t, ss.Stop())
+		require.NoError(t, sc.Close())
+	}
+}
+
+func TestSignerGetLastSignStateNotSupported(t *testing.T) {
+	for _, tc := range getSignerTestCases(t) {
+		_, err := tc.signerClient.GetLastSignState()
+		assert.IsType(t, &RemoteSignerError{}, err)
+
+		require.NoError(t, tc.signerServer.Stop())
+		require.NoError(t, tc.signerClient.Close())
+	}
+}
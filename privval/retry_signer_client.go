@@ -94,3 +94,22 @@ func (sc *RetrySignerClient) SignProposal(chainID string, proposal *tmproto.Prop
 	}
 	return fmt.Errorf("exhausted all attempts to sign proposal: %w", err)
 }
+
+func (sc *RetrySignerClient) GetLastSignState() (*FilePVLastSignState, error) {
+	var (
+		lss *FilePVLastSignState
+		err error
+	)
+	for i := 0; i < sc.retries || sc.retries == 0; i++ {
+		lss, err = sc.next.GetLastSignState()
+		if err == nil {
+			return lss, nil
+		}
+		// If remote signer errors, we don't retry.
+		if _, ok := err.(*RemoteSignerError); ok {
+			return nil, err
+		}
+		time.Sleep(sc.timeout)
+	}
+	return nil, fmt.Errorf("exhausted all attempts to get last sign state: %w", err)
+}
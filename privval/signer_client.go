@@ -131,3 +131,28 @@ func (sc *SignerClient) SignProposal(chainID string, proposal *tmproto.Proposal)
 
 	return nil
 }
+
+// GetLastSignState requests the height, round and step last signed by the
+// remote signer
+func (sc *SignerClient) GetLastSignState() (*FilePVLastSignState, error) {
+	response, err := sc.endpoint.SendRequest(mustWrapMsg(&privvalproto.LastSignStateRequest{ChainId: sc.chainID}))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := response.GetLastSignStateResponse()
+	if resp == nil {
+		return nil, ErrUnexpectedResponse
+	}
+	if resp.Error != nil {
+		return nil, &RemoteSignerError{Code: int(resp.Error.Code), Description: resp.Error.Description}
+	}
+
+	return &FilePVLastSignState{
+		Height:    resp.Height,
+		Round:     resp.Round,
+		Step:      int8(resp.Step),
+		Signature: resp.Signature,
+		SignBytes: resp.SignBytes,
+	}, nil
+}
@@ -83,6 +83,37 @@ func DefaultValidationRequestHandler(
 		} else {
 			res = mustWrapMsg(&privvalproto.SignedProposalResponse{Proposal: *proposal, Error: nil})
 		}
+	case *privvalproto.Message_LastSignStateRequest:
+		if r.LastSignStateRequest.GetChainId() != chainID {
+			res = mustWrapMsg(&privvalproto.LastSignStateResponse{
+				Error: &privvalproto.RemoteSignerError{
+					Code: 0, Description: "unable to provide last sign state"}})
+			return res, fmt.Errorf("want chainID: %s, got chainID: %s", r.LastSignStateRequest.GetChainId(), chainID)
+		}
+
+		ss, ok := privVal.(StatefulSigner)
+		if !ok {
+			res = mustWrapMsg(&privvalproto.LastSignStateResponse{
+				Error: &privvalproto.RemoteSignerError{
+					Code: 0, Description: "last sign state is not supported"}})
+			return res, nil
+		}
+
+		var lss *FilePVLastSignState
+		lss, err = ss.GetLastSignState()
+		if err != nil {
+			res = mustWrapMsg(&privvalproto.LastSignStateResponse{
+				Error: &privvalproto.RemoteSignerError{Code: 0, Description: err.Error()}})
+		} else {
+			res = mustWrapMsg(&privvalproto.LastSignStateResponse{
+				Height:    lss.Height,
+				Round:     lss.Round,
+				Step:      int32(lss.Step),
+				Signature: lss.Signature,
+				SignBytes: lss.SignBytes,
+			})
+		}
+
 	case *privvalproto.Message_PingRequest:
 		err, res = nil, mustWrapMsg(&privvalproto.PingResponse{})
 
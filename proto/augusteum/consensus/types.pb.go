@@ -104,7 +104,7 @@ func (m *NewRoundStep) GetLastCommitRound() int32 {
 }
 
 // NewValidBlock is sent when a validator observes a valid block B in some round r,
-//i.e., there is a Proposal for block B and 2/3+ prevotes for the block B in the round r.
+// i.e., there is a Proposal for block B and 2/3+ prevotes for the block B in the round r.
 // In case the block is also committed, then IsCommit flag is set to true.
 type NewValidBlock struct {
 	Height             int64               `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
@@ -609,6 +609,143 @@ func (m *VoteSetBits) GetVotes() bits.BitArray {
 	return bits.BitArray{}
 }
 
+// LightBlock is sent to report a signed header conflicting with the one
+// committed by the chain at the same height.
+type LightBlock struct {
+	LightBlock *types.LightBlock `protobuf:"bytes,1,opt,name=light_block,json=lightBlock,proto3" json:"light_block,omitempty"`
+}
+
+func (m *LightBlock) Reset()         { *m = LightBlock{} }
+func (m *LightBlock) String() string { return proto.CompactTextString(m) }
+func (*LightBlock) ProtoMessage()    {}
+func (*LightBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a22d2efc008981, []int{9}
+}
+func (m *LightBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightBlock.Merge(m, src)
+}
+func (m *LightBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightBlock proto.InternalMessageInfo
+
+func (m *LightBlock) GetLightBlock() *types.LightBlock {
+	if m != nil {
+		return m.LightBlock
+	}
+	return nil
+}
+
+// VotesRequest asks a peer for the votes of a validator it has for its current
+// height and last commit.
+type VotesRequest struct {
+	ValidatorAddress []byte `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *VotesRequest) Reset()         { *m = VotesRequest{} }
+func (m *VotesRequest) String() string { return proto.CompactTextString(m) }
+func (*VotesRequest) ProtoMessage()    {}
+func (*VotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a22d2efc008981, []int{10}
+}
+func (m *VotesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotesRequest.Merge(m, src)
+}
+func (m *VotesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotesRequest proto.InternalMessageInfo
+
+func (m *VotesRequest) GetValidatorAddress() []byte {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+// VotesResponse is sent in response to a VotesRequest.
+type VotesResponse struct {
+	Votes []*types.Vote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes,omitempty"`
+}
+
+func (m *VotesResponse) Reset()         { *m = VotesResponse{} }
+func (m *VotesResponse) String() string { return proto.CompactTextString(m) }
+func (*VotesResponse) ProtoMessage()    {}
+func (*VotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a22d2efc008981, []int{11}
+}
+func (m *VotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotesResponse.Merge(m, src)
+}
+func (m *VotesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotesResponse proto.InternalMessageInfo
+
+func (m *VotesResponse) GetVotes() []*types.Vote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
 type Message struct {
 	// Types that are valid to be assigned to Sum:
 	//	*Message_NewRoundStep
@@ -620,6 +757,9 @@ type Message struct {
 	//	*Message_HasVote
 	//	*Message_VoteSetMaj23
 	//	*Message_VoteSetBits
+	//	*Message_LightBlock
+	//	*Message_VotesRequest
+	//	*Message_VotesResponse
 	Sum isMessage_Sum `protobuf_oneof:"sum"`
 }
 
@@ -627,7 +767,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81a22d2efc008981, []int{9}
+	return fileDescriptor_81a22d2efc008981, []int{12}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -689,6 +829,15 @@ type Message_VoteSetMaj23 struct {
 type Message_VoteSetBits struct {
 	VoteSetBits *VoteSetBits `protobuf:"bytes,9,opt,name=vote_set_bits,json=voteSetBits,proto3,oneof" json:"vote_set_bits,omitempty"`
 }
+type Message_LightBlock struct {
+	LightBlock *LightBlock `protobuf:"bytes,10,opt,name=light_block,json=lightBlock,proto3,oneof" json:"light_block,omitempty"`
+}
+type Message_VotesRequest struct {
+	VotesRequest *VotesRequest `protobuf:"bytes,11,opt,name=votes_request,json=votesRequest,proto3,oneof" json:"votes_request,omitempty"`
+}
+type Message_VotesResponse struct {
+	VotesResponse *VotesResponse `protobuf:"bytes,12,opt,name=votes_response,json=votesResponse,proto3,oneof" json:"votes_response,omitempty"`
+}
 
 func (*Message_NewRoundStep) isMessage_Sum()  {}
 func (*Message_NewValidBlock) isMessage_Sum() {}
@@ -699,6 +848,9 @@ func (*Message_Vote) isMessage_Sum()          {}
 func (*Message_HasVote) isMessage_Sum()       {}
 func (*Message_VoteSetMaj23) isMessage_Sum()  {}
 func (*Message_VoteSetBits) isMessage_Sum()   {}
+func (*Message_LightBlock) isMessage_Sum()    {}
+func (*Message_VotesRequest) isMessage_Sum()  {}
+func (*Message_VotesResponse) isMessage_Sum() {}
 
 func (m *Message) GetSum() isMessage_Sum {
 	if m != nil {
@@ -770,6 +922,27 @@ func (m *Message) GetVoteSetBits() *VoteSetBits {
 	return nil
 }
 
+func (m *Message) GetLightBlock() *LightBlock {
+	if x, ok := m.GetSum().(*Message_LightBlock); ok {
+		return x.LightBlock
+	}
+	return nil
+}
+
+func (m *Message) GetVotesRequest() *VotesRequest {
+	if x, ok := m.GetSum().(*Message_VotesRequest); ok {
+		return x.VotesRequest
+	}
+	return nil
+}
+
+func (m *Message) GetVotesResponse() *VotesResponse {
+	if x, ok := m.GetSum().(*Message_VotesResponse); ok {
+		return x.VotesResponse
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Message) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -782,6 +955,9 @@ func (*Message) XXX_OneofWrappers() []interface{} {
 		(*Message_HasVote)(nil),
 		(*Message_VoteSetMaj23)(nil),
 		(*Message_VoteSetBits)(nil),
+		(*Message_LightBlock)(nil),
+		(*Message_VotesRequest)(nil),
+		(*Message_VotesResponse)(nil),
 	}
 }
 
@@ -795,67 +971,78 @@ func init() {
 	proto.RegisterType((*HasVote)(nil), "augusteum.consensus.HasVote")
 	proto.RegisterType((*VoteSetMaj23)(nil), "augusteum.consensus.VoteSetMaj23")
 	proto.RegisterType((*VoteSetBits)(nil), "augusteum.consensus.VoteSetBits")
+	proto.RegisterType((*LightBlock)(nil), "augusteum.consensus.LightBlock")
+	proto.RegisterType((*VotesRequest)(nil), "augusteum.consensus.VotesRequest")
+	proto.RegisterType((*VotesResponse)(nil), "augusteum.consensus.VotesResponse")
 	proto.RegisterType((*Message)(nil), "augusteum.consensus.Message")
 }
 
 func init() { proto.RegisterFile("augusteum/consensus/types.proto", fileDescriptor_81a22d2efc008981) }
 
 var fileDescriptor_81a22d2efc008981 = []byte{
-	// 853 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
-	0x14, 0xb7, 0x59, 0x67, 0x93, 0x7d, 0xde, 0xec, 0xc2, 0x68, 0x5b, 0x85, 0x00, 0x49, 0x30, 0x97,
-	0x15, 0x42, 0x0e, 0xca, 0x1e, 0x90, 0x0a, 0x12, 0x60, 0xfe, 0xd4, 0xad, 0x9a, 0x36, 0x72, 0x4a,
-	0x85, 0xb8, 0x58, 0x4e, 0x3c, 0x4a, 0x86, 0xc6, 0x1e, 0xcb, 0x33, 0xc9, 0xb2, 0x57, 0x3e, 0x01,
-	0x1f, 0x80, 0xaf, 0x81, 0xc4, 0x47, 0xe8, 0xb1, 0x47, 0x4e, 0x15, 0xca, 0x7e, 0x04, 0x04, 0x67,
-	0x34, 0xe3, 0x49, 0x3c, 0xa1, 0xde, 0x85, 0xbd, 0x20, 0xf5, 0x36, 0xe3, 0xf7, 0xde, 0x6f, 0xde,
-	0xfc, 0xde, 0x7b, 0x3f, 0x0f, 0xf4, 0x38, 0x4e, 0x63, 0x9c, 0x27, 0x24, 0xe5, 0xfd, 0x29, 0x4d,
-	0x19, 0x4e, 0xd9, 0x92, 0xf5, 0xf9, 0x45, 0x86, 0x99, 0x9b, 0xe5, 0x94, 0x53, 0x74, 0x52, 0x7a,
-	0xb8, 0x5b, 0x8f, 0xf6, 0xc9, 0x8c, 0xce, 0xa8, 0x74, 0xe8, 0x8b, 0x55, 0xe1, 0xdb, 0x7e, 0x5b,
-	0x43, 0x93, 0x18, 0x3a, 0x52, 0x5b, 0x3f, 0x6b, 0x41, 0x26, 0xac, 0x3f, 0x21, 0x7c, 0xc7, 0xc3,
-	0xf9, 0xc5, 0x84, 0xc3, 0x87, 0xf8, 0x3c, 0xa0, 0xcb, 0x34, 0x1e, 0x73, 0x9c, 0xa1, 0xdb, 0xb0,
-	0x3f, 0xc7, 0x64, 0x36, 0xe7, 0x2d, 0xb3, 0x67, 0x9e, 0xee, 0x05, 0x6a, 0x87, 0x4e, 0xa0, 0x96,
-	0x0b, 0xa7, 0xd6, 0x6b, 0x3d, 0xf3, 0xb4, 0x16, 0x14, 0x1b, 0x84, 0xc0, 0x62, 0x1c, 0x67, 0xad,
-	0xbd, 0x9e, 0x79, 0xda, 0x0c, 0xe4, 0x1a, 0x7d, 0x04, 0x2d, 0x86, 0xa7, 0x34, 0x8d, 0x59, 0xc8,
-	0x48, 0x3a, 0xc5, 0x21, 0xe3, 0x51, 0xce, 0x43, 0x4e, 0x12, 0xdc, 0xb2, 0x24, 0xe6, 0x2d, 0x65,
-	0x1f, 0x0b, 0xf3, 0x58, 0x58, 0x1f, 0x93, 0x04, 0xa3, 0xf7, 0xe1, 0x8d, 0x45, 0xc4, 0x78, 0x38,
-	0xa5, 0x49, 0x42, 0x78, 0x58, 0x1c, 0x57, 0x93, 0xc7, 0x1d, 0x0b, 0xc3, 0x17, 0xf2, 0xbb, 0x4c,
-	0xd5, 0xf9, 0xd3, 0x84, 0xe6, 0x43, 0x7c, 0xfe, 0x24, 0x5a, 0x90, 0xd8, 0x5b, 0xd0, 0xe9, 0xd3,
-	0x1b, 0x26, 0xfe, 0x2d, 0xdc, 0x9a, 0x88, 0xb0, 0x30, 0x13, 0xb9, 0x31, 0xcc, 0xc3, 0x39, 0x8e,
-	0x62, 0x9c, 0xcb, 0x9b, 0xd8, 0x83, 0xae, 0xab, 0xd5, 0xa0, 0xe0, 0x6b, 0x14, 0xe5, 0x7c, 0x8c,
-	0xb9, 0x2f, 0xdd, 0x3c, 0xeb, 0xd9, 0x8b, 0xae, 0x11, 0x20, 0x89, 0xb1, 0x63, 0x41, 0x9f, 0x82,
-	0x5d, 0x22, 0x33, 0x79, 0x63, 0x7b, 0xd0, 0xd1, 0xf1, 0x44, 0x25, 0x5c, 0x51, 0x09, 0xd7, 0x23,
-	0xfc, 0xf3, 0x3c, 0x8f, 0x2e, 0x02, 0xd8, 0x02, 0x31, 0xf4, 0x16, 0x1c, 0x10, 0xa6, 0x48, 0x90,
-	0xd7, 0x6f, 0x04, 0x0d, 0xc2, 0x8a, 0xcb, 0x3b, 0x3e, 0x34, 0x46, 0x39, 0xcd, 0x28, 0x8b, 0x16,
-	0xe8, 0x13, 0x68, 0x64, 0x6a, 0x2d, 0xef, 0x6c, 0x0f, 0xda, 0x15, 0x69, 0x2b, 0x0f, 0x95, 0xf1,
-	0x36, 0xc2, 0xf9, 0xd9, 0x04, 0x7b, 0x63, 0x1c, 0x3d, 0x7a, 0x70, 0x25, 0x7f, 0x1f, 0x00, 0xda,
-	0xc4, 0x84, 0x19, 0x5d, 0x84, 0x3a, 0x99, 0xaf, 0x6f, 0x2c, 0x23, 0xba, 0x90, 0x75, 0x41, 0x77,
-	0xe1, 0x50, 0xf7, 0x56, 0x74, 0xfe, 0xcb, 0xf5, 0x55, 0x6e, 0xb6, 0x86, 0xe6, 0x3c, 0x85, 0x03,
-	0x6f, 0xc3, 0xc9, 0x0d, 0x6b, 0xfb, 0x21, 0x58, 0x82, 0x7b, 0x75, 0xf6, 0xed, 0xea, 0x52, 0xaa,
-	0x33, 0xa5, 0xa7, 0x33, 0x00, 0xeb, 0x09, 0xe5, 0xa2, 0x03, 0xad, 0x15, 0xe5, 0x58, 0xb1, 0x59,
-	0x11, 0x29, 0xbc, 0x02, 0xe9, 0xe3, 0xfc, 0x68, 0x42, 0xdd, 0x8f, 0x98, 0x8c, 0xbb, 0x59, 0x7e,
-	0x67, 0x60, 0x09, 0x34, 0x99, 0xdf, 0x51, 0x55, 0xab, 0x8d, 0xc9, 0x2c, 0xc5, 0xf1, 0x90, 0xcd,
-	0x1e, 0x5f, 0x64, 0x38, 0x90, 0xce, 0x02, 0x8a, 0xa4, 0x31, 0xfe, 0x41, 0x36, 0x54, 0x2d, 0x28,
-	0x36, 0xce, 0xaf, 0x26, 0x1c, 0x8a, 0x0c, 0xc6, 0x98, 0x0f, 0xa3, 0xef, 0x07, 0x67, 0xff, 0x47,
-	0x26, 0x5f, 0x41, 0xa3, 0x68, 0x70, 0x12, 0xab, 0xee, 0x7e, 0xf3, 0xe5, 0x40, 0x59, 0xbb, 0x7b,
-	0x5f, 0x7a, 0xc7, 0x82, 0xe5, 0xf5, 0x8b, 0x6e, 0x5d, 0x7d, 0x08, 0xea, 0x32, 0xf6, 0x5e, 0xec,
-	0xfc, 0x61, 0x82, 0xad, 0x52, 0xf7, 0x08, 0x67, 0xaf, 0x4e, 0xe6, 0xe8, 0x0e, 0xd4, 0x44, 0x07,
-	0x30, 0x39, 0x9c, 0xff, 0xb5, 0xb9, 0x8b, 0x10, 0xe7, 0x2f, 0x0b, 0xea, 0x43, 0xcc, 0x58, 0x34,
-	0xc3, 0xe8, 0x3e, 0x1c, 0xa5, 0xf8, 0xbc, 0x18, 0xa8, 0x50, 0xca, 0x68, 0xd1, 0x77, 0x8e, 0x5b,
-	0xf5, 0x03, 0x70, 0x75, 0x99, 0xf6, 0x8d, 0xe0, 0x30, 0xd5, 0x65, 0x7b, 0x08, 0xc7, 0x02, 0x6b,
-	0x25, 0xf4, 0x30, 0x94, 0x89, 0x4a, 0xbe, 0xec, 0xc1, 0x7b, 0x57, 0x82, 0x95, 0xda, 0xe9, 0x1b,
-	0x41, 0x33, 0xdd, 0x11, 0x53, 0x5d, 0x5a, 0x2a, 0x46, 0xb8, 0xc4, 0xd9, 0x28, 0x88, 0xaf, 0x49,
-	0x0b, 0xfa, 0xfa, 0x1f, 0x22, 0x50, 0x70, 0xfd, 0xee, 0xf5, 0x08, 0xa3, 0x47, 0x0f, 0xfc, 0x5d,
-	0x0d, 0x40, 0x9f, 0x01, 0x94, 0x52, 0xaa, 0xd8, 0xee, 0x56, 0xa3, 0x6c, 0xb5, 0xc2, 0x37, 0x82,
-	0x83, 0xad, 0x98, 0x0a, 0x29, 0x90, 0x03, 0xbd, 0xff, 0xb2, 0x3c, 0x96, 0xb1, 0xa2, 0x0b, 0x7d,
-	0xa3, 0x18, 0x6b, 0x74, 0x07, 0x1a, 0xf3, 0x88, 0x85, 0x32, 0xaa, 0x2e, 0xa3, 0xde, 0xa9, 0x8e,
-	0x52, 0xb3, 0xef, 0x1b, 0x41, 0x7d, 0xae, 0x64, 0xe0, 0x3e, 0x1c, 0x89, 0x38, 0xf9, 0x3b, 0x49,
-	0xc4, 0x38, 0xb6, 0x1a, 0xd7, 0x15, 0x54, 0x1f, 0x5c, 0x51, 0xd0, 0x95, 0x3e, 0xc8, 0x77, 0xa1,
-	0xb9, 0xc5, 0x12, 0xfd, 0xd4, 0x3a, 0xb8, 0x8e, 0x44, 0x6d, 0x90, 0x04, 0x89, 0xab, 0x72, 0xeb,
-	0xd5, 0x60, 0x8f, 0x2d, 0x13, 0xef, 0x9b, 0x67, 0xeb, 0x8e, 0xf9, 0x7c, 0xdd, 0x31, 0x7f, 0x5f,
-	0x77, 0xcc, 0x9f, 0x2e, 0x3b, 0xc6, 0xf3, 0xcb, 0x8e, 0xf1, 0xdb, 0x65, 0xc7, 0xf8, 0xee, 0xe3,
-	0x19, 0xe1, 0xf3, 0xe5, 0xc4, 0x9d, 0xd2, 0xa4, 0xaf, 0xbf, 0x26, 0xca, 0x65, 0xf1, 0xea, 0xa8,
-	0x7a, 0xb7, 0x4c, 0xf6, 0xa5, 0xed, 0xec, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x5c, 0x91,
-	0x04, 0xd6, 0x08, 0x00, 0x00,
+	// 981 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcf, 0x6e, 0x1b, 0x45,
+	0x18, 0xdf, 0xad, 0xed, 0xd8, 0xf9, 0xd6, 0x4e, 0xda, 0xa1, 0x41, 0x4b, 0x02, 0x8e, 0xd9, 0x53,
+	0xa0, 0xc8, 0x16, 0xee, 0x01, 0x95, 0x56, 0xa0, 0x2e, 0xa5, 0x6c, 0x20, 0x69, 0xad, 0x71, 0x54,
+	0x24, 0x2e, 0xab, 0xb5, 0x77, 0x64, 0x6f, 0x59, 0xef, 0x2e, 0x3b, 0x63, 0x87, 0x1c, 0x79, 0x03,
+	0xee, 0x3c, 0x07, 0x3c, 0x43, 0x8e, 0x3d, 0x72, 0xaa, 0x50, 0xf2, 0x02, 0x48, 0xbc, 0x00, 0x9a,
+	0x3f, 0xfb, 0xc7, 0xd8, 0x09, 0xcd, 0x05, 0x89, 0xdb, 0xcc, 0x7c, 0xdf, 0xf7, 0x9b, 0xef, 0xef,
+	0x6f, 0x06, 0xf6, 0xbd, 0xf9, 0x64, 0x4e, 0x19, 0x99, 0xcf, 0x7a, 0xe3, 0x38, 0xa2, 0x24, 0xa2,
+	0x73, 0xda, 0x63, 0x67, 0x09, 0xa1, 0xdd, 0x24, 0x8d, 0x59, 0x8c, 0xde, 0xca, 0x15, 0xba, 0xb9,
+	0xc2, 0xee, 0xdd, 0x49, 0x3c, 0x89, 0x85, 0xbc, 0xc7, 0x57, 0x52, 0x75, 0x77, 0xaf, 0xc0, 0x12,
+	0x08, 0x65, 0x9c, 0xdd, 0xd2, 0x45, 0x61, 0x30, 0xa2, 0xbd, 0x51, 0xc0, 0x96, 0x14, 0xac, 0x5f,
+	0x75, 0x68, 0x3e, 0x23, 0xa7, 0x38, 0x9e, 0x47, 0xfe, 0x90, 0x91, 0x04, 0xbd, 0x0d, 0x1b, 0x53,
+	0x12, 0x4c, 0xa6, 0xcc, 0xd4, 0x3b, 0xfa, 0x41, 0x05, 0xab, 0x1d, 0xba, 0x0b, 0xb5, 0x94, 0x2b,
+	0x99, 0xb7, 0x3a, 0xfa, 0x41, 0x0d, 0xcb, 0x0d, 0x42, 0x50, 0xa5, 0x8c, 0x24, 0x66, 0xa5, 0xa3,
+	0x1f, 0xb4, 0xb0, 0x58, 0xa3, 0x4f, 0xc0, 0xa4, 0x64, 0x1c, 0x47, 0x3e, 0x75, 0x69, 0x10, 0x8d,
+	0x89, 0x4b, 0x99, 0x97, 0x32, 0x97, 0x05, 0x33, 0x62, 0x56, 0x05, 0xe6, 0x8e, 0x92, 0x0f, 0xb9,
+	0x78, 0xc8, 0xa5, 0x27, 0xc1, 0x8c, 0xa0, 0x0f, 0xe1, 0x4e, 0xe8, 0x51, 0xe6, 0x8e, 0xe3, 0xd9,
+	0x2c, 0x60, 0xae, 0xbc, 0xae, 0x26, 0xae, 0xdb, 0xe6, 0x82, 0x2f, 0xc4, 0xb9, 0x70, 0xd5, 0xfa,
+	0x4b, 0x87, 0xd6, 0x33, 0x72, 0xfa, 0xc2, 0x0b, 0x03, 0xdf, 0x0e, 0xe3, 0xf1, 0xf7, 0x37, 0x74,
+	0xfc, 0x5b, 0xd8, 0x19, 0x71, 0x33, 0x37, 0xe1, 0xbe, 0x51, 0xc2, 0xdc, 0x29, 0xf1, 0x7c, 0x92,
+	0x8a, 0x48, 0x8c, 0x7e, 0xbb, 0x5b, 0x14, 0x40, 0xa6, 0x6b, 0xe0, 0xa5, 0x6c, 0x48, 0x98, 0x23,
+	0xb4, 0xec, 0xea, 0xf9, 0xeb, 0x7d, 0x0d, 0x23, 0x01, 0xb1, 0x24, 0x41, 0x9f, 0x81, 0x51, 0x00,
+	0x53, 0x11, 0xb0, 0xd1, 0x7f, 0xaf, 0x04, 0xc7, 0xeb, 0xd0, 0xe5, 0x75, 0xe8, 0xda, 0x01, 0x7b,
+	0x9c, 0xa6, 0xde, 0x19, 0x86, 0x1c, 0x87, 0xa2, 0x3d, 0xd8, 0x0c, 0xa8, 0x4a, 0x81, 0x08, 0xbe,
+	0x81, 0x1b, 0x01, 0x95, 0xa1, 0x5b, 0x5f, 0x41, 0x63, 0x90, 0xc6, 0x49, 0x4c, 0xbd, 0x10, 0x3d,
+	0x84, 0x46, 0xa2, 0xd6, 0x22, 0x62, 0xa3, 0xff, 0xce, 0xaa, 0xd3, 0x4a, 0x41, 0xf9, 0x9b, 0x1b,
+	0x58, 0xbf, 0xe8, 0x60, 0x64, 0xc2, 0xc1, 0xf3, 0xa3, 0x2b, 0x93, 0xf7, 0x11, 0xa0, 0xcc, 0xc6,
+	0x4d, 0xe2, 0xd0, 0x2d, 0x67, 0xf2, 0x76, 0x26, 0x19, 0xc4, 0xa1, 0x28, 0x0a, 0x7a, 0x0a, 0xcd,
+	0xb2, 0xb6, 0x59, 0x79, 0x83, 0xe0, 0x95, 0x6b, 0x46, 0x09, 0xcc, 0x7a, 0x09, 0x9b, 0x76, 0x96,
+	0x91, 0x1b, 0xd6, 0xb5, 0x07, 0x55, 0x9e, 0x78, 0x75, 0xf5, 0xce, 0xda, 0x32, 0xaa, 0x2b, 0x85,
+	0xa2, 0xf5, 0x31, 0x54, 0x5f, 0xc4, 0x8c, 0xa0, 0x0f, 0xa0, 0xba, 0x88, 0x19, 0x31, 0xf5, 0x2b,
+	0x0c, 0xb9, 0x12, 0x16, 0x2a, 0xd6, 0x4f, 0x3a, 0xd4, 0x1d, 0x8f, 0x0a, 0xb3, 0x9b, 0x79, 0xd7,
+	0x87, 0x2a, 0x47, 0x13, 0xde, 0x6d, 0xad, 0x69, 0xb2, 0x61, 0x30, 0x89, 0x88, 0x7f, 0x4c, 0x27,
+	0x27, 0x67, 0x09, 0xc1, 0x42, 0x97, 0x23, 0x05, 0x91, 0x4f, 0x7e, 0x14, 0xad, 0x54, 0xc3, 0x72,
+	0x63, 0xfd, 0xa6, 0x43, 0x93, 0x3b, 0x30, 0x24, 0xec, 0xd8, 0x7b, 0xd9, 0xbf, 0xff, 0x1f, 0x38,
+	0xf2, 0x04, 0x1a, 0xb2, 0xb3, 0x03, 0x5f, 0xb5, 0xb5, 0xb9, 0x62, 0x27, 0xca, 0x76, 0xf8, 0xc4,
+	0xde, 0xe6, 0x19, 0xbe, 0x78, 0xbd, 0x5f, 0x57, 0x07, 0xb8, 0x2e, 0x4c, 0x0f, 0x7d, 0xeb, 0x4f,
+	0x1d, 0x0c, 0xe5, 0xb8, 0x1d, 0x30, 0xfa, 0x7f, 0xf1, 0x1b, 0x3d, 0x80, 0x1a, 0x2f, 0x3e, 0x35,
+	0x6b, 0x6f, 0xde, 0xd4, 0xd2, 0xc2, 0xfa, 0x1a, 0xe0, 0x88, 0xc7, 0x24, 0x79, 0xea, 0x11, 0x18,
+	0x21, 0xdf, 0xb9, 0x02, 0x59, 0xf5, 0xdb, 0xde, 0x8a, 0x47, 0x85, 0x05, 0x86, 0x30, 0x5f, 0x5b,
+	0x0f, 0x65, 0xd9, 0x29, 0x26, 0x3f, 0xcc, 0x09, 0x65, 0xe8, 0x1e, 0xdc, 0x59, 0x70, 0x0e, 0xf4,
+	0x58, 0x9c, 0xba, 0x9e, 0xef, 0xa7, 0x84, 0x52, 0x81, 0xd9, 0xc4, 0xb7, 0x73, 0xc1, 0x63, 0x79,
+	0x6e, 0x3d, 0x82, 0x96, 0x32, 0xa6, 0x09, 0x7f, 0x55, 0xd0, 0xbd, 0x2c, 0x28, 0xbd, 0x53, 0xb9,
+	0xba, 0xeb, 0x55, 0x18, 0xe7, 0x1b, 0x50, 0x3f, 0x26, 0x94, 0x7a, 0x13, 0x82, 0x0e, 0x61, 0x2b,
+	0x22, 0xa7, 0x92, 0x0e, 0x5c, 0xf1, 0x02, 0xc8, 0x38, 0xde, 0xef, 0xae, 0x79, 0xb8, 0xba, 0xe5,
+	0x07, 0xc6, 0xd1, 0x70, 0x33, 0x2a, 0xed, 0xd1, 0x11, 0x6c, 0x73, 0x28, 0xe1, 0xac, 0xca, 0xc9,
+	0x2d, 0x81, 0x65, 0x5d, 0x85, 0x55, 0x90, 0xbe, 0xa3, 0xe1, 0x56, 0x54, 0x3e, 0x58, 0x62, 0xc5,
+	0x55, 0xfa, 0x29, 0x60, 0x32, 0xf2, 0x73, 0x4a, 0xac, 0x88, 0xbe, 0xfc, 0x07, 0x7f, 0xc9, 0x6e,
+	0xe9, 0x5c, 0x0b, 0x30, 0x78, 0x7e, 0xe4, 0x2c, 0xd3, 0x17, 0xfa, 0x1c, 0xa0, 0x78, 0x02, 0x54,
+	0xbf, 0xb4, 0xd7, 0x82, 0xe4, 0x2c, 0xe7, 0x68, 0x78, 0x33, 0x7f, 0x04, 0x38, 0x89, 0x09, 0x2e,
+	0xda, 0x58, 0xa1, 0xf5, 0xc2, 0x94, 0x57, 0xc6, 0xd1, 0x24, 0x23, 0xa1, 0x07, 0xd0, 0x98, 0x7a,
+	0xd4, 0x15, 0x46, 0x75, 0x61, 0xf4, 0xee, 0x5a, 0x23, 0xc5, 0x5a, 0x8e, 0x86, 0xeb, 0x53, 0xb9,
+	0xe4, 0x95, 0xe4, 0x66, 0xe2, 0x09, 0x9c, 0x71, 0x26, 0x31, 0x1b, 0xd7, 0x54, 0xb2, 0x4c, 0x39,
+	0xbc, 0x92, 0x8b, 0xd2, 0x1e, 0x3d, 0x85, 0x56, 0x0e, 0xc5, 0xc7, 0xc1, 0xdc, 0xbc, 0x26, 0x7f,
+	0x25, 0x0e, 0xe0, 0xf9, 0x5b, 0x14, 0x5b, 0x64, 0x2f, 0x4f, 0x08, 0x08, 0x94, 0xfd, 0xb5, 0x28,
+	0xc5, 0x94, 0x38, 0x5a, 0x79, 0x4e, 0x90, 0x23, 0x7d, 0xa1, 0x6e, 0x2a, 0x07, 0xc5, 0x34, 0xfe,
+	0x25, 0xaa, 0x6c, 0xa2, 0xb2, 0xa8, 0xf2, 0x09, 0xfb, 0x06, 0xb6, 0x32, 0x24, 0x39, 0x35, 0x66,
+	0xf3, 0x9a, 0xf6, 0x5c, 0x9a, 0x2f, 0xde, 0x9e, 0x8b, 0xf2, 0x81, 0x5d, 0x83, 0x0a, 0x9d, 0xcf,
+	0xec, 0x93, 0xf3, 0x8b, 0xb6, 0xfe, 0xea, 0xa2, 0xad, 0xff, 0x71, 0xd1, 0xd6, 0x7f, 0xbe, 0x6c,
+	0x6b, 0xaf, 0x2e, 0xdb, 0xda, 0xef, 0x97, 0x6d, 0xed, 0xbb, 0x4f, 0x27, 0x01, 0x9b, 0xce, 0x47,
+	0xdd, 0x71, 0x3c, 0xeb, 0x8d, 0x53, 0xe2, 0x31, 0x6f, 0x3c, 0xf5, 0x82, 0xa8, 0x57, 0xfc, 0xe3,
+	0xe4, 0x07, 0x70, 0xcd, 0x07, 0x72, 0xb4, 0x21, 0x44, 0xf7, 0xff, 0x1e, 0x00, 0xa5, 0x5b, 0xaa,
+	0xdd, 0x5e, 0x0a, 0x00, 0x00,
 }
 
 func (m *NewRoundStep) Marshal() (dAtA []byte, err error) {
@@ -1274,6 +1461,108 @@ func (m *VoteSetBits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LightBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LightBlock != nil {
+		{
+			size, err := m.LightBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Message) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1495,6 +1784,69 @@ func (m *Message_VoteSetBits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Message_LightBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_LightBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.LightBlock != nil {
+		{
+			size, err := m.LightBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_VotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_VotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.VotesRequest != nil {
+		{
+			size, err := m.VotesRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_VotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_VotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.VotesResponse != nil {
+		{
+			size, err := m.VotesResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1675,6 +2027,47 @@ func (m *VoteSetBits) Size() (n int) {
 	return n
 }
 
+func (m *LightBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LightBlock != nil {
+		l = m.LightBlock.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *VotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *VotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Message) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1795,11 +2188,47 @@ func (m *Message_VoteSetBits) Size() (n int) {
 	}
 	return n
 }
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
+func (m *Message_LightBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LightBlock != nil {
+		l = m.LightBlock.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_VotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotesRequest != nil {
+		l = m.VotesRequest.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_VotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotesResponse != nil {
+		l = m.VotesResponse.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
 	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *NewRoundStep) Unmarshal(dAtA []byte) error {
@@ -2974,6 +3403,260 @@ func (m *VoteSetBits) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LightBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LightBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LightBlock == nil {
+				m.LightBlock = &types.LightBlock{}
+			}
+			if err := m.LightBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VotesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VotesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, &types.Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Message) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3318,6 +4001,111 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Message_VoteSetBits{v}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LightBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LightBlock{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_LightBlock{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotesRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &VotesRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_VotesRequest{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotesResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &VotesResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_VotesResponse{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
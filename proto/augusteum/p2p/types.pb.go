@@ -152,6 +152,7 @@ type DefaultNodeInfo struct {
 	Channels        []byte               `protobuf:"bytes,6,opt,name=channels,proto3" json:"channels,omitempty"`
 	Moniker         string               `protobuf:"bytes,7,opt,name=moniker,proto3" json:"moniker,omitempty"`
 	Other           DefaultNodeInfoOther `protobuf:"bytes,8,opt,name=other,proto3" json:"other"`
+	ChannelVersions []ChannelVersion     `protobuf:"bytes,9,rep,name=channel_versions,json=channelVersions,proto3" json:"channel_versions"`
 }
 
 func (m *DefaultNodeInfo) Reset()         { *m = DefaultNodeInfo{} }
@@ -243,6 +244,75 @@ func (m *DefaultNodeInfo) GetOther() DefaultNodeInfoOther {
 	return DefaultNodeInfoOther{}
 }
 
+func (m *DefaultNodeInfo) GetChannelVersions() []ChannelVersion {
+	if m != nil {
+		return m.ChannelVersions
+	}
+	return nil
+}
+
+// ChannelVersion advertises the protocol version a node speaks on a channel,
+// and the oldest version it is still compatible with.
+type ChannelVersion struct {
+	ChannelID  uint32 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Version    uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	MinVersion uint32 `protobuf:"varint,3,opt,name=min_version,json=minVersion,proto3" json:"min_version,omitempty"`
+}
+
+func (m *ChannelVersion) Reset()         { *m = ChannelVersion{} }
+func (m *ChannelVersion) String() string { return proto.CompactTextString(m) }
+func (*ChannelVersion) ProtoMessage()    {}
+func (*ChannelVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8a29e659aeca578, []int{3}
+}
+func (m *ChannelVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelVersion.Merge(m, src)
+}
+func (m *ChannelVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelVersion proto.InternalMessageInfo
+
+func (m *ChannelVersion) GetChannelID() uint32 {
+	if m != nil {
+		return m.ChannelID
+	}
+	return 0
+}
+
+func (m *ChannelVersion) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ChannelVersion) GetMinVersion() uint32 {
+	if m != nil {
+		return m.MinVersion
+	}
+	return 0
+}
+
 type DefaultNodeInfoOther struct {
 	TxIndex    string `protobuf:"bytes,1,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
 	RPCAddress string `protobuf:"bytes,2,opt,name=rpc_address,json=rpcAddress,proto3" json:"rpc_address,omitempty"`
@@ -252,7 +322,7 @@ func (m *DefaultNodeInfoOther) Reset()         { *m = DefaultNodeInfoOther{} }
 func (m *DefaultNodeInfoOther) String() string { return proto.CompactTextString(m) }
 func (*DefaultNodeInfoOther) ProtoMessage()    {}
 func (*DefaultNodeInfoOther) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8a29e659aeca578, []int{3}
+	return fileDescriptor_c8a29e659aeca578, []int{4}
 }
 func (m *DefaultNodeInfoOther) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -299,43 +369,49 @@ func init() {
 	proto.RegisterType((*NetAddress)(nil), "augusteum.p2p.NetAddress")
 	proto.RegisterType((*ProtocolVersion)(nil), "augusteum.p2p.ProtocolVersion")
 	proto.RegisterType((*DefaultNodeInfo)(nil), "augusteum.p2p.DefaultNodeInfo")
+	proto.RegisterType((*ChannelVersion)(nil), "augusteum.p2p.ChannelVersion")
 	proto.RegisterType((*DefaultNodeInfoOther)(nil), "augusteum.p2p.DefaultNodeInfoOther")
 }
 
 func init() { proto.RegisterFile("augusteum/p2p/types.proto", fileDescriptor_c8a29e659aeca578) }
 
 var fileDescriptor_c8a29e659aeca578 = []byte{
-	// 479 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x3d, 0x8f, 0xda, 0x40,
-	0x10, 0xc5, 0xc6, 0x7c, 0xdc, 0x10, 0x8e, 0xcb, 0x0a, 0x45, 0x3e, 0x0a, 0x1b, 0xa1, 0x14, 0x54,
-	0x20, 0x39, 0x4a, 0x91, 0x2e, 0x21, 0x34, 0x34, 0x77, 0xd6, 0x2a, 0x4a, 0x91, 0xc6, 0x02, 0xef,
-	0x1e, 0xac, 0x30, 0xbb, 0xab, 0xf5, 0x5e, 0x42, 0xfe, 0x45, 0x7e, 0xd6, 0x95, 0x57, 0xa6, 0xb2,
-	0x22, 0x53, 0xe6, 0x4f, 0x44, 0x5e, 0xfb, 0x12, 0x1f, 0x4a, 0x37, 0x6f, 0xbe, 0xde, 0xcc, 0xd3,
-	0x83, 0x91, 0xa6, 0x9c, 0x50, 0x75, 0x60, 0x5c, 0xcf, 0x65, 0x20, 0xe7, 0xfa, 0xbb, 0xa4, 0xe9,
-	0x4c, 0x2a, 0xa1, 0x05, 0xba, 0xfc, 0x57, 0x9b, 0xc9, 0x40, 0x8e, 0x86, 0x5b, 0xb1, 0x15, 0xa6,
-	0x34, 0x2f, 0xa2, 0xb2, 0x6b, 0x12, 0x02, 0xdc, 0x50, 0xfd, 0x81, 0x10, 0x45, 0xd3, 0x14, 0xbd,
-	0x02, 0x9b, 0x11, 0xd7, 0x1a, 0x5b, 0xd3, 0x8b, 0x45, 0x3b, 0xcf, 0x7c, 0x7b, 0xb5, 0xc4, 0x36,
-	0x23, 0x26, 0x2f, 0x5d, 0xbb, 0x96, 0x0f, 0xb1, 0xcd, 0x24, 0x42, 0xe0, 0x48, 0xa1, 0xb4, 0xdb,
-	0x1c, 0x5b, 0xd3, 0x3e, 0x36, 0xf1, 0xe4, 0x13, 0x0c, 0xc2, 0x62, 0x75, 0x2c, 0x92, 0xcf, 0x54,
-	0xa5, 0x4c, 0x70, 0x74, 0x0d, 0x4d, 0x19, 0x48, 0xb3, 0xd7, 0x59, 0x74, 0xf2, 0xcc, 0x6f, 0x86,
-	0x41, 0x88, 0x8b, 0x1c, 0x1a, 0x42, 0x6b, 0x93, 0x88, 0x78, 0x6f, 0x96, 0x3b, 0xb8, 0x04, 0xe8,
-	0x0a, 0x9a, 0x6b, 0x29, 0xcd, 0x5a, 0x07, 0x17, 0xe1, 0xe4, 0xb7, 0x0d, 0x83, 0x25, 0xbd, 0x5b,
-	0xdf, 0x27, 0xfa, 0x46, 0x10, 0xba, 0xe2, 0x77, 0x02, 0x85, 0x70, 0x25, 0x2b, 0xa6, 0xe8, 0x6b,
-	0x49, 0x65, 0x38, 0x7a, 0x81, 0x3f, 0x7b, 0xfe, 0xfc, 0xec, 0xec, 0xa2, 0x85, 0xf3, 0x90, 0xf9,
-	0x0d, 0x3c, 0x90, 0x67, 0x87, 0xbe, 0x83, 0x01, 0x29, 0x49, 0x22, 0x2e, 0x08, 0x8d, 0x18, 0xa9,
-	0x9e, 0x7e, 0x99, 0x67, 0x7e, 0xbf, 0xce, 0xbf, 0xc4, 0x7d, 0x52, 0x83, 0x04, 0xf9, 0xd0, 0x4b,
-	0x58, 0xaa, 0x29, 0x8f, 0xd6, 0x84, 0x28, 0x73, 0xfa, 0x05, 0x86, 0x32, 0x55, 0xc8, 0x8b, 0x5c,
-	0xe8, 0x70, 0xaa, 0xbf, 0x09, 0xb5, 0x77, 0x1d, 0x53, 0x7c, 0x82, 0x45, 0xe5, 0xe9, 0xfc, 0x56,
-	0x59, 0xa9, 0x20, 0x1a, 0x41, 0x37, 0xde, 0xad, 0x39, 0xa7, 0x49, 0xea, 0xb6, 0xc7, 0xd6, 0xf4,
-	0x05, 0xfe, 0x8b, 0x8b, 0xa9, 0x83, 0xe0, 0x6c, 0x4f, 0x95, 0xdb, 0x29, 0xa7, 0x2a, 0x88, 0xde,
-	0x43, 0x4b, 0xe8, 0x1d, 0x55, 0x6e, 0xd7, 0x88, 0xf1, 0xfa, 0x5c, 0x8c, 0x33, 0x1d, 0x6f, 0x8b,
-	0xde, 0x4a, 0x91, 0x72, 0x70, 0xb2, 0x81, 0xe1, 0xff, 0x9a, 0xd0, 0x35, 0x74, 0xf5, 0x31, 0x62,
-	0x9c, 0xd0, 0x63, 0xe9, 0x12, 0xdc, 0xd1, 0xc7, 0x55, 0x01, 0xd1, 0x1c, 0x7a, 0x4a, 0xc6, 0xe6,
-	0x79, 0x9a, 0xa6, 0x95, 0x6c, 0x97, 0x79, 0xe6, 0x03, 0x0e, 0x3f, 0x56, 0xfe, 0xc2, 0xa0, 0x64,
-	0x5c, 0xc5, 0x8b, 0xdb, 0x87, 0xdc, 0xb3, 0x1e, 0x73, 0xcf, 0xfa, 0x95, 0x7b, 0xd6, 0x8f, 0x93,
-	0xd7, 0x78, 0x3c, 0x79, 0x8d, 0x9f, 0x27, 0xaf, 0xf1, 0xe5, 0xed, 0x96, 0xe9, 0xdd, 0xfd, 0x66,
-	0x16, 0x8b, 0xc3, 0xbc, 0x66, 0xf0, 0xba, 0xd7, 0x8d, 0x8d, 0x9f, 0x9b, 0x7f, 0xd3, 0x36, 0xd9,
-	0x37, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x6c, 0xb3, 0x68, 0x97, 0x15, 0x03, 0x00, 0x00,
+	// 560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xc1, 0x6e, 0xda, 0x40,
+	0x10, 0xc5, 0xe0, 0x84, 0x30, 0xd4, 0x21, 0x5d, 0x45, 0x95, 0x13, 0xa9, 0x36, 0xa2, 0x17, 0x0e,
+	0x15, 0x96, 0xdc, 0x5e, 0x7a, 0xaa, 0x4a, 0xb8, 0x70, 0x21, 0xd6, 0xaa, 0xea, 0xa1, 0x17, 0x64,
+	0xbc, 0x1b, 0x58, 0x05, 0x76, 0x57, 0xf6, 0xd2, 0x26, 0x7f, 0xd1, 0xdf, 0xe9, 0x1f, 0xe4, 0x98,
+	0x63, 0x4f, 0x56, 0xe5, 0xfc, 0x48, 0xe5, 0xf5, 0x82, 0xc0, 0xea, 0x6d, 0xde, 0xcc, 0xec, 0xbc,
+	0x99, 0xb7, 0x33, 0x70, 0x15, 0x6f, 0x97, 0xdb, 0x4c, 0xd1, 0xed, 0x26, 0x90, 0xa1, 0x0c, 0xd4,
+	0xa3, 0xa4, 0xd9, 0x48, 0xa6, 0x42, 0x09, 0xe4, 0xec, 0x43, 0x23, 0x19, 0xca, 0xeb, 0xcb, 0xa5,
+	0x58, 0x0a, 0x1d, 0x09, 0x4a, 0xab, 0x4a, 0x1a, 0x44, 0x00, 0x33, 0xaa, 0xbe, 0x10, 0x92, 0xd2,
+	0x2c, 0x43, 0x6f, 0xa0, 0xc9, 0x88, 0x6b, 0xf5, 0xad, 0x61, 0x67, 0x7c, 0x5a, 0xe4, 0x7e, 0x73,
+	0x3a, 0xc1, 0x4d, 0x46, 0xb4, 0x5f, 0xba, 0xcd, 0x03, 0x7f, 0x84, 0x9b, 0x4c, 0x22, 0x04, 0xb6,
+	0x14, 0xa9, 0x72, 0x5b, 0x7d, 0x6b, 0xe8, 0x60, 0x6d, 0x0f, 0xbe, 0x42, 0x2f, 0x2a, 0x4b, 0x27,
+	0x62, 0xfd, 0x8d, 0xa6, 0x19, 0x13, 0x1c, 0x5d, 0x41, 0x4b, 0x86, 0x52, 0xd7, 0xb5, 0xc7, 0xed,
+	0x22, 0xf7, 0x5b, 0x51, 0x18, 0xe1, 0xd2, 0x87, 0x2e, 0xe1, 0x64, 0xb1, 0x16, 0xc9, 0xbd, 0x2e,
+	0x6e, 0xe3, 0x0a, 0xa0, 0x0b, 0x68, 0xc5, 0x52, 0xea, 0xb2, 0x36, 0x2e, 0xcd, 0xc1, 0xef, 0x16,
+	0xf4, 0x26, 0xf4, 0x2e, 0xde, 0xae, 0xd5, 0x4c, 0x10, 0x3a, 0xe5, 0x77, 0x02, 0xdd, 0xc2, 0x85,
+	0x34, 0x4c, 0xf3, 0x1f, 0x15, 0x95, 0xe6, 0xe8, 0x86, 0xde, 0xe8, 0x68, 0xf6, 0x51, 0xad, 0xa1,
+	0xb1, 0xfd, 0x94, 0xfb, 0x0d, 0xdc, 0x93, 0xb5, 0x3e, 0x3f, 0x41, 0x8f, 0x54, 0x1c, 0x73, 0x2e,
+	0x08, 0x9d, 0x33, 0x62, 0x66, 0x7e, 0x5d, 0xe4, 0xbe, 0x73, 0x48, 0x3f, 0xc1, 0x0e, 0x39, 0x80,
+	0x04, 0xf9, 0xd0, 0x5d, 0xb3, 0x4c, 0x51, 0x3e, 0x8f, 0x09, 0x49, 0x75, 0xe7, 0x1d, 0x0c, 0x95,
+	0xab, 0x54, 0x17, 0xb9, 0xd0, 0xe6, 0x54, 0xfd, 0x14, 0xe9, 0xbd, 0x6b, 0xeb, 0xe0, 0x0e, 0x96,
+	0x91, 0x5d, 0xf7, 0x27, 0x55, 0xc4, 0x40, 0x74, 0x0d, 0x67, 0xc9, 0x2a, 0xe6, 0x9c, 0xae, 0x33,
+	0xf7, 0xb4, 0x6f, 0x0d, 0x5f, 0xe1, 0x3d, 0x2e, 0x5f, 0x6d, 0x04, 0x67, 0xf7, 0x34, 0x75, 0xdb,
+	0xd5, 0x2b, 0x03, 0xd1, 0x67, 0x38, 0x11, 0x6a, 0x45, 0x53, 0xf7, 0x4c, 0x6b, 0xf1, 0xae, 0xa6,
+	0x45, 0x4d, 0xc5, 0xdb, 0x32, 0xd5, 0x08, 0x52, 0xbd, 0x43, 0x33, 0xb8, 0x30, 0x34, 0x3b, 0x59,
+	0x33, 0xb7, 0xd3, 0x6f, 0x0d, 0xbb, 0xe1, 0xdb, 0x5a, 0xad, 0x9b, 0x2a, 0xad, 0x26, 0x6b, 0x72,
+	0xe4, 0xcd, 0x06, 0x8f, 0x70, 0x7e, 0x9c, 0x88, 0xde, 0x03, 0xec, 0x18, 0xcc, 0xbe, 0x39, 0x63,
+	0xa7, 0xc8, 0xfd, 0x8e, 0xc9, 0x9b, 0x4e, 0x70, 0xc7, 0x24, 0x4c, 0xc9, 0xa1, 0x40, 0x4d, 0xbd,
+	0x68, 0x7b, 0x81, 0x7c, 0xe8, 0x6e, 0x18, 0xdf, 0x7f, 0x7e, 0xb5, 0x86, 0xb0, 0x61, 0xdc, 0x10,
+	0x0d, 0x16, 0x70, 0xf9, 0xbf, 0x79, 0xd1, 0x15, 0x9c, 0xa9, 0x87, 0x39, 0xe3, 0x84, 0x3e, 0x54,
+	0xeb, 0x8e, 0xdb, 0xea, 0x61, 0x5a, 0x42, 0x14, 0x40, 0x37, 0x95, 0x89, 0xfe, 0x46, 0x9a, 0x65,
+	0x66, 0x01, 0xce, 0x8b, 0xdc, 0x07, 0x1c, 0xdd, 0x98, 0x43, 0xc1, 0x90, 0xca, 0xc4, 0xd8, 0xe3,
+	0xd9, 0x53, 0xe1, 0x59, 0xcf, 0x85, 0x67, 0xfd, 0x2d, 0x3c, 0xeb, 0xd7, 0x8b, 0xd7, 0x78, 0x7e,
+	0xf1, 0x1a, 0x7f, 0x5e, 0xbc, 0xc6, 0xf7, 0x8f, 0x4b, 0xa6, 0x56, 0xdb, 0xc5, 0x28, 0x11, 0x9b,
+	0x20, 0x49, 0x69, 0xac, 0xe2, 0x64, 0x15, 0x33, 0x1e, 0x1c, 0xdc, 0xac, 0xbe, 0xc7, 0xa3, 0x1b,
+	0x5e, 0x9c, 0x6a, 0xe7, 0x87, 0x7f, 0x03, 0x00, 0x31, 0xf1, 0x34, 0x55, 0xdb, 0x03, 0x00, 0x00,
 }
 
 func (m *NetAddress) Marshal() (dAtA []byte, err error) {
@@ -438,6 +514,20 @@ func (m *DefaultNodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChannelVersions) > 0 {
+		for iNdEx := len(m.ChannelVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	{
 		size, err := m.Other.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -503,6 +593,44 @@ func (m *DefaultNodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChannelVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinVersion != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChannelID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChannelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DefaultNodeInfoOther) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -623,6 +751,30 @@ func (m *DefaultNodeInfo) Size() (n int) {
 	}
 	l = m.Other.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	if len(m.ChannelVersions) > 0 {
+		for _, e := range m.ChannelVersions {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChannelID != 0 {
+		n += 1 + sovTypes(uint64(m.ChannelID))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTypes(uint64(m.Version))
+	}
+	if m.MinVersion != 0 {
+		n += 1 + sovTypes(uint64(m.MinVersion))
+	}
 	return n
 }
 
@@ -1178,6 +1330,147 @@ func (m *DefaultNodeInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelVersions = append(m.ChannelVersions, ChannelVersion{})
+			if err := m.ChannelVersions[len(m.ChannelVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			m.ChannelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVersion", wireType)
+			}
+			m.MinVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -0,0 +1,199 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: augusteum/privval/service.proto
+
+package privval
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() { proto.RegisterFile("augusteum/privval/service.proto", fileDescriptor_a8e3f762ee5a8163) }
+
+var fileDescriptor_a8e3f762ee5a8163 = []byte{
+	// 256 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x2c, 0x4d, 0x2f,
+	0x2d, 0x2e, 0x49, 0x2d, 0xcd, 0xd5, 0x2f, 0x28, 0xca, 0x2c, 0x2b, 0x4b, 0xcc, 0xd1, 0x2f, 0x4e,
+	0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0x2b, 0xd0,
+	0x83, 0x2a, 0x90, 0x92, 0xc5, 0xd4, 0x53, 0x52, 0x59, 0x90, 0x5a, 0x0c, 0xd1, 0x61, 0x34, 0x8f,
+	0x89, 0x4b, 0x20, 0xa0, 0x28, 0xb3, 0x2c, 0x2c, 0x31, 0x27, 0x33, 0x25, 0xb1, 0x24, 0xbf, 0xc8,
+	0x31, 0xc0, 0x53, 0x28, 0x80, 0x8b, 0xd3, 0x3d, 0xb5, 0x24, 0xa0, 0x34, 0xc9, 0x3b, 0xb5, 0x52,
+	0x48, 0x41, 0x0f, 0xc3, 0x50, 0x3d, 0x88, 0x54, 0x50, 0x6a, 0x61, 0x69, 0x6a, 0x71, 0x89, 0x94,
+	0x22, 0x1e, 0x15, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x42, 0xa1, 0x5c, 0x1c, 0xc1, 0x99, 0xe9,
+	0x79, 0x61, 0xf9, 0x25, 0xa9, 0x42, 0x4a, 0x58, 0x94, 0xc3, 0x24, 0x61, 0x46, 0xaa, 0xe2, 0x50,
+	0x93, 0x9a, 0x02, 0x51, 0x05, 0x35, 0x36, 0x91, 0x8b, 0x07, 0x24, 0x1a, 0x50, 0x94, 0x5f, 0x90,
+	0x5f, 0x9c, 0x98, 0x23, 0xa4, 0x86, 0x43, 0x1b, 0x4c, 0x01, 0xcc, 0x78, 0x4d, 0x9c, 0xc6, 0x23,
+	0x54, 0x42, 0xac, 0x70, 0x0a, 0x3a, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f,
+	0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28,
+	0x8b, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe4, 0xa2, 0xd4, 0xc4,
+	0x92, 0xc4, 0xe4, 0x8c, 0xc4, 0xcc, 0x3c, 0x7d, 0xe4, 0x00, 0xcf, 0x2f, 0xc9, 0xd7, 0xc7, 0x88,
+	0x80, 0x24, 0x36, 0xb0, 0x84, 0x31, 0x60, 0x00, 0x87, 0xd2, 0x12, 0x87, 0xd0, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PrivValidatorAPIClient is the client API for PrivValidatorAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PrivValidatorAPIClient interface {
+	GetPubKey(ctx context.Context, in *PubKeyRequest, opts ...grpc.CallOption) (*PubKeyResponse, error)
+	SignVote(ctx context.Context, in *SignVoteRequest, opts ...grpc.CallOption) (*SignedVoteResponse, error)
+	SignProposal(ctx context.Context, in *SignProposalRequest, opts ...grpc.CallOption) (*SignedProposalResponse, error)
+}
+
+type privValidatorAPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPrivValidatorAPIClient(cc *grpc.ClientConn) PrivValidatorAPIClient {
+	return &privValidatorAPIClient{cc}
+}
+
+func (c *privValidatorAPIClient) GetPubKey(ctx context.Context, in *PubKeyRequest, opts ...grpc.CallOption) (*PubKeyResponse, error) {
+	out := new(PubKeyResponse)
+	err := c.cc.Invoke(ctx, "/augusteum.privval.PrivValidatorAPI/GetPubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *privValidatorAPIClient) SignVote(ctx context.Context, in *SignVoteRequest, opts ...grpc.CallOption) (*SignedVoteResponse, error) {
+	out := new(SignedVoteResponse)
+	err := c.cc.Invoke(ctx, "/augusteum.privval.PrivValidatorAPI/SignVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *privValidatorAPIClient) SignProposal(ctx context.Context, in *SignProposalRequest, opts ...grpc.CallOption) (*SignedProposalResponse, error) {
+	out := new(SignedProposalResponse)
+	err := c.cc.Invoke(ctx, "/augusteum.privval.PrivValidatorAPI/SignProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PrivValidatorAPIServer is the server API for PrivValidatorAPI service.
+type PrivValidatorAPIServer interface {
+	GetPubKey(context.Context, *PubKeyRequest) (*PubKeyResponse, error)
+	SignVote(context.Context, *SignVoteRequest) (*SignedVoteResponse, error)
+	SignProposal(context.Context, *SignProposalRequest) (*SignedProposalResponse, error)
+}
+
+// UnimplementedPrivValidatorAPIServer can be embedded to have forward compatible implementations.
+type UnimplementedPrivValidatorAPIServer struct {
+}
+
+func (*UnimplementedPrivValidatorAPIServer) GetPubKey(ctx context.Context, req *PubKeyRequest) (*PubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPubKey not implemented")
+}
+func (*UnimplementedPrivValidatorAPIServer) SignVote(ctx context.Context, req *SignVoteRequest) (*SignedVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignVote not implemented")
+}
+func (*UnimplementedPrivValidatorAPIServer) SignProposal(ctx context.Context, req *SignProposalRequest) (*SignedProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignProposal not implemented")
+}
+
+func RegisterPrivValidatorAPIServer(s *grpc.Server, srv PrivValidatorAPIServer) {
+	s.RegisterService(&_PrivValidatorAPI_serviceDesc, srv)
+}
+
+func _PrivValidatorAPI_GetPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PubKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivValidatorAPIServer).GetPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/augusteum.privval.PrivValidatorAPI/GetPubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivValidatorAPIServer).GetPubKey(ctx, req.(*PubKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrivValidatorAPI_SignVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivValidatorAPIServer).SignVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/augusteum.privval.PrivValidatorAPI/SignVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivValidatorAPIServer).SignVote(ctx, req.(*SignVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrivValidatorAPI_SignProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivValidatorAPIServer).SignProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/augusteum.privval.PrivValidatorAPI/SignProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivValidatorAPIServer).SignProposal(ctx, req.(*SignProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PrivValidatorAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "augusteum.privval.PrivValidatorAPI",
+	HandlerType: (*PrivValidatorAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPubKey",
+			Handler:    _PrivValidatorAPI_GetPubKey_Handler,
+		},
+		{
+			MethodName: "SignVote",
+			Handler:    _PrivValidatorAPI_SignVote_Handler,
+		},
+		{
+			MethodName: "SignProposal",
+			Handler:    _PrivValidatorAPI_SignProposal_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "augusteum/privval/service.proto",
+}
@@ -424,6 +424,139 @@ func (m *SignedProposalResponse) GetError() *RemoteSignerError {
 	return nil
 }
 
+// LastSignStateRequest requests the height, round and step last signed by the
+// remote signer.
+type LastSignStateRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *LastSignStateRequest) Reset()         { *m = LastSignStateRequest{} }
+func (m *LastSignStateRequest) String() string { return proto.CompactTextString(m) }
+func (*LastSignStateRequest) ProtoMessage()    {}
+func (*LastSignStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb4e437a5328cf9c, []int{7}
+}
+func (m *LastSignStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LastSignStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LastSignStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LastSignStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastSignStateRequest.Merge(m, src)
+}
+func (m *LastSignStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LastSignStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastSignStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LastSignStateRequest proto.InternalMessageInfo
+
+func (m *LastSignStateRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+// LastSignStateResponse is a response containing the height, round and step
+// last signed by the remote signer, along with the signature and sign bytes,
+// or an error
+type LastSignStateResponse struct {
+	Height    int64              `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round     int32              `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Step      int32              `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
+	Signature []byte             `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	SignBytes []byte             `protobuf:"bytes,5,opt,name=sign_bytes,json=signBytes,proto3" json:"sign_bytes,omitempty"`
+	Error     *RemoteSignerError `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *LastSignStateResponse) Reset()         { *m = LastSignStateResponse{} }
+func (m *LastSignStateResponse) String() string { return proto.CompactTextString(m) }
+func (*LastSignStateResponse) ProtoMessage()    {}
+func (*LastSignStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb4e437a5328cf9c, []int{8}
+}
+func (m *LastSignStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LastSignStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LastSignStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LastSignStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastSignStateResponse.Merge(m, src)
+}
+func (m *LastSignStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LastSignStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastSignStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LastSignStateResponse proto.InternalMessageInfo
+
+func (m *LastSignStateResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *LastSignStateResponse) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *LastSignStateResponse) GetStep() int32 {
+	if m != nil {
+		return m.Step
+	}
+	return 0
+}
+
+func (m *LastSignStateResponse) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *LastSignStateResponse) GetSignBytes() []byte {
+	if m != nil {
+		return m.SignBytes
+	}
+	return nil
+}
+
+func (m *LastSignStateResponse) GetError() *RemoteSignerError {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
 // PingRequest is a request to confirm that the connection is alive.
 type PingRequest struct {
 }
@@ -432,7 +565,7 @@ func (m *PingRequest) Reset()         { *m = PingRequest{} }
 func (m *PingRequest) String() string { return proto.CompactTextString(m) }
 func (*PingRequest) ProtoMessage()    {}
 func (*PingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb4e437a5328cf9c, []int{7}
+	return fileDescriptor_cb4e437a5328cf9c, []int{9}
 }
 func (m *PingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -469,7 +602,7 @@ func (m *PingResponse) Reset()         { *m = PingResponse{} }
 func (m *PingResponse) String() string { return proto.CompactTextString(m) }
 func (*PingResponse) ProtoMessage()    {}
 func (*PingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb4e437a5328cf9c, []int{8}
+	return fileDescriptor_cb4e437a5328cf9c, []int{10}
 }
 func (m *PingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -508,6 +641,8 @@ type Message struct {
 	//	*Message_SignedProposalResponse
 	//	*Message_PingRequest
 	//	*Message_PingResponse
+	//	*Message_LastSignStateRequest
+	//	*Message_LastSignStateResponse
 	Sum isMessage_Sum `protobuf_oneof:"sum"`
 }
 
@@ -515,7 +650,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb4e437a5328cf9c, []int{9}
+	return fileDescriptor_cb4e437a5328cf9c, []int{11}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -574,6 +709,12 @@ type Message_PingRequest struct {
 type Message_PingResponse struct {
 	PingResponse *PingResponse `protobuf:"bytes,8,opt,name=ping_response,json=pingResponse,proto3,oneof" json:"ping_response,omitempty"`
 }
+type Message_LastSignStateRequest struct {
+	LastSignStateRequest *LastSignStateRequest `protobuf:"bytes,9,opt,name=last_sign_state_request,json=lastSignStateRequest,proto3,oneof" json:"last_sign_state_request,omitempty"`
+}
+type Message_LastSignStateResponse struct {
+	LastSignStateResponse *LastSignStateResponse `protobuf:"bytes,10,opt,name=last_sign_state_response,json=lastSignStateResponse,proto3,oneof" json:"last_sign_state_response,omitempty"`
+}
 
 func (*Message_PubKeyRequest) isMessage_Sum()          {}
 func (*Message_PubKeyResponse) isMessage_Sum()         {}
@@ -583,6 +724,8 @@ func (*Message_SignProposalRequest) isMessage_Sum()    {}
 func (*Message_SignedProposalResponse) isMessage_Sum() {}
 func (*Message_PingRequest) isMessage_Sum()            {}
 func (*Message_PingResponse) isMessage_Sum()           {}
+func (*Message_LastSignStateRequest) isMessage_Sum()   {}
+func (*Message_LastSignStateResponse) isMessage_Sum()  {}
 
 func (m *Message) GetSum() isMessage_Sum {
 	if m != nil {
@@ -647,6 +790,20 @@ func (m *Message) GetPingResponse() *PingResponse {
 	return nil
 }
 
+func (m *Message) GetLastSignStateRequest() *LastSignStateRequest {
+	if x, ok := m.GetSum().(*Message_LastSignStateRequest); ok {
+		return x.LastSignStateRequest
+	}
+	return nil
+}
+
+func (m *Message) GetLastSignStateResponse() *LastSignStateResponse {
+	if x, ok := m.GetSum().(*Message_LastSignStateResponse); ok {
+		return x.LastSignStateResponse
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Message) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -658,6 +815,8 @@ func (*Message) XXX_OneofWrappers() []interface{} {
 		(*Message_SignedProposalResponse)(nil),
 		(*Message_PingRequest)(nil),
 		(*Message_PingResponse)(nil),
+		(*Message_LastSignStateRequest)(nil),
+		(*Message_LastSignStateResponse)(nil),
 	}
 }
 
@@ -670,6 +829,8 @@ func init() {
 	proto.RegisterType((*SignedVoteResponse)(nil), "augusteum.privval.SignedVoteResponse")
 	proto.RegisterType((*SignProposalRequest)(nil), "augusteum.privval.SignProposalRequest")
 	proto.RegisterType((*SignedProposalResponse)(nil), "augusteum.privval.SignedProposalResponse")
+	proto.RegisterType((*LastSignStateRequest)(nil), "augusteum.privval.LastSignStateRequest")
+	proto.RegisterType((*LastSignStateResponse)(nil), "augusteum.privval.LastSignStateResponse")
 	proto.RegisterType((*PingRequest)(nil), "augusteum.privval.PingRequest")
 	proto.RegisterType((*PingResponse)(nil), "augusteum.privval.PingResponse")
 	proto.RegisterType((*Message)(nil), "augusteum.privval.Message")
@@ -678,54 +839,64 @@ func init() {
 func init() { proto.RegisterFile("augusteum/privval/types.proto", fileDescriptor_cb4e437a5328cf9c) }
 
 var fileDescriptor_cb4e437a5328cf9c = []byte{
-	// 750 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x4f, 0x13, 0x41,
-	0x18, 0xde, 0x85, 0x7e, 0xc0, 0x5b, 0x5a, 0xca, 0x80, 0x58, 0x1a, 0x5c, 0x6a, 0x8d, 0x4a, 0x7a,
-	0x68, 0x0d, 0x26, 0x26, 0x06, 0x2f, 0x02, 0x1b, 0xdb, 0x34, 0x6c, 0xeb, 0xb4, 0x08, 0x21, 0x31,
-	0x9b, 0x7e, 0x8c, 0xcb, 0x06, 0xba, 0x3b, 0xee, 0x6c, 0x49, 0x7a, 0xf6, 0xe6, 0xc9, 0xc4, 0x3f,
-	0xe1, 0xd9, 0x5f, 0xc1, 0x91, 0xa3, 0x27, 0x63, 0xe0, 0x8f, 0x98, 0xce, 0x4e, 0xb7, 0xdb, 0x2f,
-	0xa2, 0xe1, 0xb6, 0xf3, 0xbe, 0xef, 0x3c, 0x1f, 0x33, 0xcf, 0x66, 0x40, 0x71, 0x89, 0xd5, 0x26,
-	0x4e, 0xc7, 0xb4, 0xdc, 0x02, 0x75, 0xcc, 0xcb, 0xcb, 0xc6, 0x45, 0xc1, 0xed, 0x51, 0xc2, 0xf2,
-	0xd4, 0xb1, 0x5d, 0x1b, 0xa1, 0x61, 0x3f, 0x2f, 0xfa, 0xe9, 0xcd, 0xc0, 0x9e, 0x96, 0xd3, 0xa3,
-	0xae, 0x5d, 0x38, 0x27, 0x3d, 0xb1, 0x63, 0xa4, 0xcb, 0x91, 0x82, 0x78, 0xe9, 0x35, 0xc3, 0x36,
-	0x6c, 0xfe, 0x59, 0xe8, 0x7f, 0x79, 0xd5, 0x6c, 0x09, 0x56, 0x30, 0xe9, 0xd8, 0x2e, 0xa9, 0x99,
-	0x86, 0x45, 0x1c, 0xd5, 0x71, 0x6c, 0x07, 0x21, 0x08, 0xb5, 0xec, 0x36, 0x49, 0xc9, 0x19, 0x79,
-	0x3b, 0x8c, 0xf9, 0x37, 0xca, 0x40, 0xac, 0x4d, 0x58, 0xcb, 0x31, 0xa9, 0x6b, 0xda, 0x56, 0x6a,
-	0x2e, 0x23, 0x6f, 0x2f, 0xe2, 0x60, 0x29, 0x9b, 0x83, 0x78, 0xb5, 0xdb, 0x2c, 0x93, 0x1e, 0x26,
-	0x9f, 0xbb, 0x84, 0xb9, 0x68, 0x03, 0x16, 0x5a, 0x67, 0x0d, 0xd3, 0xd2, 0xcd, 0x36, 0x87, 0x5a,
-	0xc4, 0x51, 0xbe, 0x2e, 0xb5, 0xb3, 0x5f, 0x65, 0x48, 0x0c, 0x86, 0x19, 0xb5, 0x2d, 0x46, 0xd0,
-	0x2e, 0x44, 0x69, 0xb7, 0xa9, 0x9f, 0x93, 0x1e, 0x1f, 0x8e, 0xed, 0x6c, 0xe6, 0x03, 0x27, 0xe0,
-	0xb9, 0xcd, 0x57, 0xbb, 0xcd, 0x0b, 0xb3, 0x55, 0x26, 0xbd, 0xbd, 0xd0, 0xd5, 0xef, 0x2d, 0x09,
-	0x47, 0x28, 0x07, 0x41, 0xbb, 0x10, 0x26, 0x7d, 0xe9, 0x5c, 0x57, 0x6c, 0xe7, 0x69, 0x7e, 0xf2,
-	0xf0, 0xf2, 0x13, 0x3e, 0xb1, 0xb7, 0x27, 0x7b, 0x02, 0xcb, 0xfd, 0xea, 0x07, 0xdb, 0x25, 0x03,
-	0xe9, 0x39, 0x08, 0x5d, 0xda, 0x2e, 0x11, 0x4a, 0xd6, 0x83, 0x70, 0xde, 0x99, 0xf2, 0x61, 0x3e,
-	0x33, 0x62, 0x73, 0x6e, 0xd4, 0xe6, 0x17, 0x19, 0x10, 0x27, 0x6c, 0x7b, 0xe0, 0xc2, 0xea, 0x8b,
-	0x7f, 0x41, 0x17, 0x0e, 0x3d, 0x8e, 0x7b, 0xf9, 0x3b, 0x83, 0xd5, 0x7e, 0xb5, 0xea, 0xd8, 0xd4,
-	0x66, 0x8d, 0x8b, 0x81, 0xc7, 0x57, 0xb0, 0x40, 0x45, 0x49, 0x28, 0x49, 0x4f, 0x2a, 0xf1, 0x37,
-	0xf9, 0xb3, 0x77, 0xf9, 0xfd, 0x2e, 0xc3, 0xba, 0xe7, 0x77, 0x48, 0x26, 0x3c, 0xbf, 0xf9, 0x1f,
-	0x36, 0xe1, 0x7d, 0xc8, 0x79, 0x2f, 0xff, 0x71, 0x88, 0x55, 0x4d, 0xcb, 0x10, 0xbe, 0xb3, 0x09,
-	0x58, 0xf2, 0x96, 0x9e, 0xb2, 0xec, 0xcf, 0x30, 0x44, 0x0f, 0x09, 0x63, 0x0d, 0x83, 0xa0, 0x32,
-	0x2c, 0x8b, 0x10, 0xea, 0x8e, 0x37, 0x2e, 0xc4, 0x3e, 0x9e, 0xc6, 0x38, 0x12, 0xf7, 0xa2, 0x84,
-	0xe3, 0x74, 0x24, 0xff, 0x1a, 0x24, 0x87, 0x60, 0x1e, 0x99, 0xd0, 0x9f, 0xbd, 0x0b, 0xcd, 0x9b,
-	0x2c, 0x4a, 0x38, 0x41, 0x47, 0xff, 0x90, 0xf7, 0xb0, 0xc2, 0x4c, 0xc3, 0xd2, 0xfb, 0x89, 0xf0,
-	0xe5, 0xcd, 0x73, 0xc0, 0x27, 0xd3, 0x00, 0xc7, 0x42, 0x5d, 0x94, 0xf0, 0x32, 0x1b, 0xcb, 0xf9,
-	0x29, 0xac, 0x31, 0x7e, 0x5f, 0x03, 0x50, 0x21, 0x33, 0xc4, 0x51, 0x9f, 0xcd, 0x42, 0x1d, 0xcd,
-	0x73, 0x51, 0xc2, 0x88, 0x4d, 0xa6, 0xfc, 0x23, 0x3c, 0xe0, 0x72, 0x07, 0x97, 0xe8, 0x4b, 0x0e,
-	0x73, 0xf0, 0xe7, 0xb3, 0xc0, 0xc7, 0x72, 0x5a, 0x94, 0xf0, 0x2a, 0x9b, 0x12, 0xdf, 0x4f, 0x90,
-	0x12, 0xd2, 0x03, 0x04, 0x42, 0x7e, 0x84, 0x33, 0xe4, 0x66, 0xcb, 0x1f, 0x8f, 0x67, 0x51, 0xc2,
-	0xeb, 0x6c, 0x7a, 0x70, 0x0f, 0x60, 0x89, 0x9a, 0x96, 0xe1, 0xab, 0x8f, 0x72, 0xec, 0xad, 0xa9,
-	0x37, 0x38, 0x4c, 0x59, 0x51, 0xc2, 0x31, 0x3a, 0x5c, 0xa2, 0x77, 0x10, 0x17, 0x28, 0x42, 0xe2,
-	0x02, 0x87, 0xc9, 0xcc, 0x86, 0xf1, 0x85, 0x2d, 0xd1, 0xc0, 0x7a, 0x2f, 0x0c, 0xf3, 0xac, 0xdb,
-	0xc9, 0xfd, 0x90, 0x21, 0xc2, 0x43, 0xce, 0x10, 0x82, 0x84, 0x8a, 0x71, 0x05, 0xd7, 0xf4, 0x23,
-	0xad, 0xac, 0x55, 0x8e, 0xb5, 0xa4, 0x84, 0x14, 0x48, 0xfb, 0x35, 0xf5, 0xa4, 0xaa, 0xee, 0xd7,
-	0xd5, 0x03, 0x1d, 0xab, 0xb5, 0x6a, 0x45, 0xab, 0xa9, 0x49, 0x19, 0xa5, 0x60, 0x4d, 0xf4, 0xb5,
-	0x8a, 0xbe, 0x5f, 0xd1, 0x34, 0x75, 0xbf, 0x5e, 0xaa, 0x68, 0xc9, 0x39, 0xf4, 0x08, 0x36, 0x44,
-	0x67, 0x58, 0xd6, 0xeb, 0xa5, 0x43, 0xb5, 0x72, 0x54, 0x4f, 0xce, 0xa3, 0x87, 0xb0, 0x2a, 0xda,
-	0x58, 0x7d, 0x7b, 0xe0, 0x37, 0x42, 0x01, 0xc4, 0x63, 0x5c, 0xaa, 0xab, 0x7e, 0x27, 0xbc, 0x57,
-	0xbb, 0xba, 0x51, 0xe4, 0xeb, 0x1b, 0x45, 0xfe, 0x73, 0xa3, 0xc8, 0xdf, 0x6e, 0x15, 0xe9, 0xfa,
-	0x56, 0x91, 0x7e, 0xdd, 0x2a, 0xd2, 0xe9, 0x6b, 0xc3, 0x74, 0xcf, 0xba, 0xcd, 0x7c, 0xcb, 0xee,
-	0x14, 0x82, 0x6f, 0x57, 0xf0, 0x61, 0xec, 0xbf, 0x57, 0x93, 0x2f, 0x65, 0x33, 0xc2, 0x3b, 0x2f,
-	0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x2a, 0xe5, 0x4a, 0x46, 0x07, 0x00, 0x00,
+	// 899 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xb6, 0x9b, 0x9f, 0xb6, 0x27, 0xfd, 0x49, 0xa7, 0x69, 0x37, 0xed, 0xd2, 0x6c, 0x88, 0xf8,
+	0xe9, 0xee, 0x45, 0x22, 0x16, 0x21, 0xa1, 0xe5, 0x8a, 0x76, 0x8d, 0x12, 0x4a, 0x93, 0x68, 0x92,
+	0xa5, 0x80, 0x90, 0x8c, 0x93, 0x8c, 0x5c, 0x6b, 0xd3, 0xcc, 0xe0, 0x19, 0x57, 0xca, 0x25, 0x77,
+	0x5c, 0xc2, 0x5b, 0xf0, 0x28, 0x7b, 0xb9, 0xe2, 0x8a, 0x2b, 0x84, 0xda, 0x27, 0xe0, 0x0d, 0x90,
+	0x67, 0x26, 0xb6, 0x93, 0xb8, 0x65, 0x57, 0x7b, 0xe7, 0x39, 0xe7, 0xcc, 0x77, 0xbe, 0xef, 0xcc,
+	0x7c, 0xd6, 0xc0, 0x91, 0x13, 0xb8, 0x01, 0x17, 0x24, 0xb8, 0x6a, 0x30, 0xdf, 0xbb, 0xbe, 0x76,
+	0xc6, 0x0d, 0x31, 0x65, 0x84, 0xd7, 0x99, 0x4f, 0x05, 0x45, 0x3b, 0x51, 0xba, 0xae, 0xd3, 0x87,
+	0x0f, 0xe3, 0x1d, 0x43, 0x7f, 0xca, 0x04, 0x6d, 0xbc, 0x24, 0x53, 0x5d, 0x9f, 0x4c, 0x4a, 0x98,
+	0x24, 0xd8, 0x61, 0xc9, 0xa5, 0x2e, 0x95, 0x9f, 0x8d, 0xf0, 0x4b, 0x45, 0x6b, 0x2d, 0xd8, 0xc1,
+	0xe4, 0x8a, 0x0a, 0xd2, 0xf3, 0xdc, 0x09, 0xf1, 0x2d, 0xdf, 0xa7, 0x3e, 0x42, 0x90, 0x1d, 0xd2,
+	0x11, 0x29, 0x9b, 0x55, 0xf3, 0x38, 0x87, 0xe5, 0x37, 0xaa, 0x42, 0x61, 0x44, 0xf8, 0xd0, 0xf7,
+	0x98, 0xf0, 0xe8, 0xa4, 0xbc, 0x52, 0x35, 0x8f, 0xd7, 0x71, 0x32, 0x54, 0x7b, 0x02, 0x9b, 0xdd,
+	0x60, 0x70, 0x46, 0xa6, 0x98, 0xfc, 0x1c, 0x10, 0x2e, 0xd0, 0x01, 0xac, 0x0d, 0x2f, 0x1d, 0x6f,
+	0x62, 0x7b, 0x23, 0x09, 0xb5, 0x8e, 0x57, 0xe5, 0xba, 0x35, 0xaa, 0xfd, 0x6a, 0xc2, 0xd6, 0xac,
+	0x98, 0x33, 0x3a, 0xe1, 0x04, 0x3d, 0x83, 0x55, 0x16, 0x0c, 0xec, 0x97, 0x64, 0x2a, 0x8b, 0x0b,
+	0x4f, 0x1f, 0xd6, 0x63, 0xf9, 0x4a, 0x6b, 0xbd, 0x1b, 0x0c, 0xc6, 0xde, 0xf0, 0x8c, 0x4c, 0x4f,
+	0xb2, 0xaf, 0xfe, 0x7e, 0x64, 0xe0, 0x3c, 0x93, 0x18, 0xe8, 0x19, 0xe4, 0x48, 0xc8, 0x5c, 0xd2,
+	0x2a, 0x3c, 0xfd, 0xa0, 0xbe, 0x34, 0xb8, 0xfa, 0x92, 0x4a, 0xac, 0xb6, 0xd4, 0x2e, 0x60, 0x3b,
+	0x8c, 0x7e, 0x4b, 0x05, 0x99, 0x11, 0x7f, 0x0c, 0xd9, 0x6b, 0x2a, 0x88, 0xe6, 0xb1, 0x97, 0x40,
+	0x53, 0x03, 0x95, 0xb5, 0xb2, 0x64, 0x4e, 0xe3, 0xca, 0xbc, 0xc6, 0x5f, 0x4c, 0x40, 0xb2, 0xdf,
+	0x48, 0x61, 0x6b, 0x9d, 0x8d, 0x37, 0x00, 0xd7, 0xf2, 0x54, 0x8b, 0x77, 0x11, 0xe7, 0xc2, 0x6e,
+	0x18, 0xed, 0xfa, 0x94, 0x51, 0xee, 0x8c, 0x67, 0x02, 0x3f, 0x83, 0x35, 0xa6, 0x43, 0x9a, 0xc7,
+	0xc1, 0x12, 0x8f, 0x68, 0x4f, 0x54, 0x7a, 0x9f, 0xd8, 0xdf, 0x4d, 0xd8, 0x57, 0x62, 0xe3, 0x5e,
+	0x5a, 0xf0, 0x17, 0x6f, 0xd1, 0x4c, 0x0b, 0x8f, 0x5b, 0xbe, 0x8b, 0xf8, 0x4f, 0xa0, 0xf4, 0x8d,
+	0xc3, 0x45, 0x98, 0xe9, 0x09, 0x27, 0x3e, 0xde, 0x7b, 0xee, 0xe5, 0x9f, 0x26, 0xec, 0x2d, 0xec,
+	0xd1, 0x2a, 0xf6, 0x21, 0x7f, 0x49, 0x3c, 0xf7, 0x52, 0xc8, 0x2d, 0x19, 0xac, 0x57, 0xa8, 0x04,
+	0x39, 0x9f, 0x06, 0x13, 0x35, 0x90, 0x1c, 0x56, 0x8b, 0xd0, 0x41, 0x5c, 0x10, 0x56, 0xce, 0x28,
+	0x07, 0x85, 0xdf, 0xe8, 0x3d, 0x58, 0xe7, 0x9e, 0x3b, 0x71, 0x44, 0xe0, 0x93, 0x72, 0xb6, 0x6a,
+	0x1e, 0x6f, 0xe0, 0x38, 0x80, 0x8e, 0x00, 0xc2, 0x85, 0x3d, 0x98, 0x0a, 0xc2, 0xcb, 0xb9, 0x38,
+	0x7d, 0x12, 0x06, 0xe2, 0x39, 0xe4, 0xdf, 0x7e, 0x0e, 0x9b, 0x50, 0xe8, 0x7a, 0x13, 0x57, 0xcb,
+	0xaf, 0x6d, 0xc1, 0x86, 0x5a, 0x2a, 0x65, 0xb5, 0x7f, 0xf3, 0xb0, 0x7a, 0x4e, 0x38, 0x77, 0x5c,
+	0x82, 0xbe, 0x86, 0x6d, 0x6d, 0x42, 0xdb, 0x57, 0xe5, 0xfa, 0xc8, 0xaa, 0x29, 0x0d, 0xe7, 0xdc,
+	0xde, 0x34, 0xf0, 0x26, 0x9b, 0xb3, 0xff, 0x39, 0x14, 0x63, 0x2c, 0xd5, 0x4b, 0x9f, 0xe2, 0xfb,
+	0xf7, 0x80, 0xa9, 0xc2, 0xa6, 0x81, 0xb7, 0xd8, 0x5c, 0x04, 0x75, 0x61, 0x47, 0x0e, 0x28, 0xf4,
+	0x44, 0x44, 0x2e, 0x23, 0xf1, 0x6a, 0x29, 0x78, 0x0b, 0x9e, 0x6e, 0x1a, 0x78, 0x9b, 0x2f, 0xd8,
+	0xfc, 0x7b, 0x28, 0x71, 0x79, 0x65, 0x67, 0x98, 0x9a, 0x64, 0x56, 0x82, 0x7e, 0x78, 0x07, 0xe8,
+	0xbc, 0x9d, 0x9b, 0x06, 0x46, 0x7c, 0xd9, 0xe4, 0x3f, 0xc2, 0x9e, 0x24, 0x3b, 0xbb, 0xc7, 0x11,
+	0xe1, 0x9c, 0xc4, 0xfe, 0xe8, 0x0e, 0xec, 0x05, 0x9f, 0x36, 0x0d, 0xbc, 0xcb, 0x97, 0xc3, 0x88,
+	0x40, 0x59, 0x13, 0x4f, 0xe0, 0x6b, 0xf2, 0xea, 0x7e, 0x3c, 0xbe, 0x93, 0xfc, 0xa2, 0x3d, 0x9b,
+	0x06, 0xde, 0xe7, 0xe9, 0xc6, 0x3d, 0x85, 0x0d, 0xe6, 0x4d, 0xdc, 0x88, 0xfb, 0xaa, 0x84, 0xae,
+	0xa4, 0x1d, 0x5e, 0x7c, 0xbd, 0x9a, 0x06, 0x2e, 0xb0, 0x78, 0x89, 0xbe, 0x82, 0x4d, 0x0d, 0xa2,
+	0x09, 0xae, 0x49, 0x94, 0x47, 0x77, 0xa2, 0x44, 0xb4, 0x36, 0x58, 0x62, 0x8d, 0x7e, 0x82, 0x07,
+	0x63, 0x87, 0x0b, 0x5b, 0x8e, 0x95, 0x87, 0xd6, 0x8c, 0x78, 0xad, 0x4b, 0xc4, 0x8f, 0x53, 0x10,
+	0xd3, 0xec, 0xdf, 0x34, 0x70, 0x69, 0x9c, 0x12, 0x47, 0x43, 0x28, 0x2f, 0x77, 0xd0, 0xa4, 0x41,
+	0xb6, 0x38, 0xfe, 0xff, 0x16, 0x11, 0xfb, 0xbd, 0x71, 0x5a, 0xe2, 0x24, 0x07, 0x19, 0x1e, 0x5c,
+	0x3d, 0xf9, 0xc3, 0x84, 0xbc, 0xf4, 0x28, 0x47, 0x08, 0xb6, 0x2c, 0x8c, 0x3b, 0xb8, 0x67, 0xbf,
+	0x68, 0x9f, 0xb5, 0x3b, 0x17, 0xed, 0xa2, 0x81, 0x2a, 0x70, 0x18, 0xc5, 0xac, 0xef, 0xba, 0xd6,
+	0x69, 0xdf, 0x7a, 0x6e, 0x63, 0xab, 0xd7, 0xed, 0xb4, 0x7b, 0x56, 0xd1, 0x44, 0x65, 0x28, 0xe9,
+	0x7c, 0xbb, 0x63, 0x9f, 0x76, 0xda, 0x6d, 0xeb, 0xb4, 0xdf, 0xea, 0xb4, 0x8b, 0x2b, 0xe8, 0x08,
+	0x0e, 0x74, 0x26, 0x0e, 0xdb, 0xfd, 0xd6, 0xb9, 0xd5, 0x79, 0xd1, 0x2f, 0x66, 0xd0, 0x03, 0xd8,
+	0xd5, 0x69, 0x6c, 0x7d, 0xf9, 0x3c, 0x4a, 0x64, 0x13, 0x88, 0x17, 0xb8, 0xd5, 0xb7, 0xa2, 0x4c,
+	0xee, 0x04, 0xbf, 0xba, 0xa9, 0x98, 0xaf, 0x6f, 0x2a, 0xe6, 0x3f, 0x37, 0x15, 0xf3, 0xb7, 0xdb,
+	0x8a, 0xf1, 0xfa, 0xb6, 0x62, 0xfc, 0x75, 0x5b, 0x31, 0x7e, 0xf8, 0xdc, 0xf5, 0xc4, 0x65, 0x30,
+	0xa8, 0x0f, 0x69, 0xf8, 0x18, 0x21, 0x8e, 0x70, 0xe4, 0x6f, 0xb4, 0x91, 0x7c, 0xd4, 0x84, 0xcf,
+	0x8d, 0xa5, 0x47, 0xce, 0x20, 0x2f, 0x13, 0x9f, 0xfe, 0x37, 0x00, 0x78, 0x57, 0x1f, 0x30, 0x00,
+	0x09, 0x00, 0x00,
 }
 
 func (m *RemoteSignerError) Marshal() (dAtA []byte, err error) {
@@ -1012,6 +1183,100 @@ func (m *SignedProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *LastSignStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastSignStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastSignStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LastSignStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastSignStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastSignStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SignBytes) > 0 {
+		i -= len(m.SignBytes)
+		copy(dAtA[i:], m.SignBytes)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SignBytes)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Step != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Step))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PingRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1258,6 +1523,48 @@ func (m *Message_PingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Message_LastSignStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_LastSignStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.LastSignStateRequest != nil {
+		{
+			size, err := m.LastSignStateRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_LastSignStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_LastSignStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.LastSignStateResponse != nil {
+		{
+			size, err := m.LastSignStateResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1377,6 +1684,49 @@ func (m *SignedProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *LastSignStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *LastSignStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	if m.Step != 0 {
+		n += 1 + sovTypes(uint64(m.Step))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.SignBytes)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *PingRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1503,6 +1853,30 @@ func (m *Message_PingResponse) Size() (n int) {
 	}
 	return n
 }
+func (m *Message_LastSignStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastSignStateRequest != nil {
+		l = m.LastSignStateRequest.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_LastSignStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastSignStateResponse != nil {
+		l = m.LastSignStateResponse.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
 
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -2286,7 +2660,7 @@ func (m *SignedProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PingRequest) Unmarshal(dAtA []byte) error {
+func (m *LastSignStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2309,14 +2683,307 @@ func (m *PingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LastSignStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LastSignStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastSignStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastSignStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastSignStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			m.Step = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Step |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignBytes = append(m.SignBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignBytes == nil {
+				m.SignBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &RemoteSignerError{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
 			if err != nil {
 				return err
@@ -2695,6 +3362,76 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Message_PingResponse{v}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSignStateRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LastSignStateRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_LastSignStateRequest{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSignStateResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LastSignStateResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_LastSignStateResponse{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
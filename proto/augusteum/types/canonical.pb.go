@@ -308,48 +308,130 @@ func (m *CanonicalVote) GetChainID() string {
 	return ""
 }
 
+// CanonicalVoteExtension is signed separately from the vote, so the vote can
+// be verified without the extension. It includes the block ID of the vote, so
+// that the extension can't be moved to a vote for another block.
+type CanonicalVoteExtension struct {
+	Extension []byte            `protobuf:"bytes,1,opt,name=extension,proto3" json:"extension,omitempty"`
+	Height    int64             `protobuf:"fixed64,2,opt,name=height,proto3" json:"height,omitempty"`
+	Round     int64             `protobuf:"fixed64,3,opt,name=round,proto3" json:"round,omitempty"`
+	ChainID   string            `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	BlockID   *CanonicalBlockID `protobuf:"bytes,5,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+}
+
+func (m *CanonicalVoteExtension) Reset()         { *m = CanonicalVoteExtension{} }
+func (m *CanonicalVoteExtension) String() string { return proto.CompactTextString(m) }
+func (*CanonicalVoteExtension) ProtoMessage()    {}
+func (*CanonicalVoteExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d1a1a84ff7267ed, []int{4}
+}
+func (m *CanonicalVoteExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanonicalVoteExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanonicalVoteExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CanonicalVoteExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanonicalVoteExtension.Merge(m, src)
+}
+func (m *CanonicalVoteExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanonicalVoteExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanonicalVoteExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanonicalVoteExtension proto.InternalMessageInfo
+
+func (m *CanonicalVoteExtension) GetExtension() []byte {
+	if m != nil {
+		return m.Extension
+	}
+	return nil
+}
+
+func (m *CanonicalVoteExtension) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CanonicalVoteExtension) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *CanonicalVoteExtension) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *CanonicalVoteExtension) GetBlockID() *CanonicalBlockID {
+	if m != nil {
+		return m.BlockID
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CanonicalBlockID)(nil), "augusteum.types.CanonicalBlockID")
 	proto.RegisterType((*CanonicalPartSetHeader)(nil), "augusteum.types.CanonicalPartSetHeader")
 	proto.RegisterType((*CanonicalProposal)(nil), "augusteum.types.CanonicalProposal")
 	proto.RegisterType((*CanonicalVote)(nil), "augusteum.types.CanonicalVote")
+	proto.RegisterType((*CanonicalVoteExtension)(nil), "augusteum.types.CanonicalVoteExtension")
 }
 
 func init() { proto.RegisterFile("augusteum/types/canonical.proto", fileDescriptor_8d1a1a84ff7267ed) }
 
 var fileDescriptor_8d1a1a84ff7267ed = []byte{
-	// 487 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0xce, 0xa5, 0x4e, 0xe2, 0x5c, 0x1b, 0x08, 0xa7, 0xaa, 0xb2, 0x22, 0x64, 0x5b, 0x1e, 0x90,
-	0x59, 0x6c, 0xa9, 0x1d, 0xd8, 0x5d, 0x06, 0x82, 0x40, 0x94, 0x6b, 0xd5, 0x81, 0x25, 0xba, 0xd8,
-	0x87, 0x6d, 0xe1, 0xf8, 0x4e, 0xf6, 0x65, 0xe8, 0xc2, 0x6f, 0xe8, 0xef, 0xe0, 0x97, 0x74, 0xec,
-	0x08, 0x4b, 0x40, 0xce, 0x1f, 0x41, 0x77, 0x4e, 0xec, 0xa8, 0x01, 0x16, 0x10, 0xcb, 0xe9, 0xfd,
-	0x78, 0xee, 0x79, 0x1f, 0x3d, 0xaf, 0x5e, 0x68, 0x0b, 0x9a, 0x47, 0xb4, 0x58, 0xa4, 0xb9, 0xf0,
-	0xc5, 0x0d, 0xa7, 0xa5, 0x1f, 0x92, 0x9c, 0xe5, 0x69, 0x48, 0x32, 0x8f, 0x17, 0x4c, 0x30, 0x34,
-	0x6e, 0x11, 0x9e, 0x42, 0x4c, 0x8e, 0x63, 0x16, 0x33, 0xd5, 0xf4, 0x65, 0x54, 0xe3, 0x26, 0x4f,
-	0xf7, 0x98, 0xd4, 0xbb, 0xe9, 0x5a, 0x31, 0x63, 0x71, 0x46, 0x7d, 0x95, 0xcd, 0x97, 0x1f, 0x7d,
-	0x91, 0x2e, 0x68, 0x29, 0xc8, 0x82, 0xd7, 0x00, 0xe7, 0x33, 0x1c, 0x9f, 0x6f, 0x27, 0x07, 0x19,
-	0x0b, 0x3f, 0x4d, 0x5f, 0x22, 0x04, 0xb5, 0x84, 0x94, 0x89, 0x01, 0x6c, 0xe0, 0x1e, 0x61, 0x15,
-	0xa3, 0x6b, 0xf8, 0x98, 0x93, 0x42, 0xcc, 0x4a, 0x2a, 0x66, 0x09, 0x25, 0x11, 0x2d, 0x8c, 0xae,
-	0x0d, 0xdc, 0xc3, 0x53, 0xd7, 0x7b, 0x28, 0xd4, 0x6b, 0x08, 0x2f, 0x48, 0x21, 0x2e, 0xa9, 0x78,
-	0xa5, 0xf0, 0x81, 0x76, 0xb7, 0xb2, 0x3a, 0x78, 0xc4, 0x77, 0x8b, 0x4e, 0x00, 0x4f, 0x7e, 0x0d,
-	0x47, 0xc7, 0xb0, 0x27, 0x98, 0x20, 0x99, 0x92, 0x31, 0xc2, 0x75, 0xd2, 0x68, 0xeb, 0xb6, 0xda,
-	0x9c, 0x6f, 0x5d, 0xf8, 0xa4, 0x25, 0x29, 0x18, 0x67, 0x25, 0xc9, 0xd0, 0x19, 0xd4, 0xa4, 0x1c,
-	0xf5, 0xfd, 0xd1, 0xa9, 0xb5, 0x2f, 0xf3, 0x32, 0x8d, 0x73, 0x1a, 0xbd, 0x2d, 0xe3, 0xab, 0x1b,
-	0x4e, 0xb1, 0x02, 0xa3, 0x13, 0xd8, 0x4f, 0x68, 0x1a, 0x27, 0x42, 0x0d, 0x18, 0xe3, 0x4d, 0x26,
-	0xc5, 0x14, 0x6c, 0x99, 0x47, 0xc6, 0x81, 0x2a, 0xd7, 0x09, 0x7a, 0x0e, 0x87, 0x9c, 0x65, 0xb3,
-	0xba, 0xa3, 0xd9, 0xc0, 0x3d, 0x08, 0x8e, 0xaa, 0x95, 0xa5, 0x5f, 0xbc, 0x7b, 0x83, 0x65, 0x0d,
-	0xeb, 0x9c, 0x65, 0x2a, 0x42, 0xaf, 0xa1, 0x3e, 0x97, 0xf6, 0xce, 0xd2, 0xc8, 0xe8, 0x29, 0xe3,
-	0x9c, 0x3f, 0x18, 0xb7, 0xd9, 0x44, 0x70, 0x58, 0xad, 0xac, 0xc1, 0x26, 0xc1, 0x03, 0x45, 0x30,
-	0x8d, 0x50, 0x00, 0x87, 0xcd, 0x1a, 0x8d, 0xbe, 0x22, 0x9b, 0x78, 0xf5, 0xa2, 0xbd, 0xed, 0xa2,
-	0xbd, 0xab, 0x2d, 0x22, 0xd0, 0xa5, 0xef, 0xb7, 0xdf, 0x2d, 0x80, 0xdb, 0x6f, 0xe8, 0x19, 0xd4,
-	0xc3, 0x84, 0xa4, 0xb9, 0xd4, 0x33, 0xb0, 0x81, 0x3b, 0xac, 0x67, 0x9d, 0xcb, 0x9a, 0x9c, 0xa5,
-	0x9a, 0xd3, 0xc8, 0xf9, 0xd2, 0x85, 0xa3, 0x46, 0xd6, 0x35, 0x13, 0xf4, 0x7f, 0xf8, 0xba, 0x6b,
-	0x96, 0xf6, 0x2f, 0xcd, 0xea, 0xfd, 0xbd, 0x59, 0xfd, 0xdf, 0x9b, 0x15, 0xbc, 0xbf, 0xab, 0x4c,
-	0x70, 0x5f, 0x99, 0xe0, 0x47, 0x65, 0x82, 0xdb, 0xb5, 0xd9, 0xb9, 0x5f, 0x9b, 0x9d, 0xaf, 0x6b,
-	0xb3, 0xf3, 0xe1, 0x45, 0x9c, 0x8a, 0x64, 0x39, 0xf7, 0x42, 0xb6, 0xf0, 0x77, 0x0f, 0xb6, 0x0d,
-	0xeb, 0xc3, 0x7e, 0x78, 0xcc, 0xf3, 0xbe, 0xaa, 0x9f, 0xfd, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x6d,
-	0xdd, 0x12, 0x5d, 0x31, 0x04, 0x00, 0x00,
+	// 525 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x41, 0x6e, 0xd3, 0x40,
+	0x14, 0xcd, 0xa4, 0x4e, 0xe2, 0x4c, 0x1b, 0x5a, 0x46, 0x55, 0x64, 0x05, 0x64, 0x87, 0x2c, 0x20,
+	0x6c, 0x6c, 0x29, 0x48, 0x1c, 0xc0, 0x05, 0x89, 0x48, 0x20, 0x22, 0xb7, 0xb0, 0x60, 0x13, 0x4d,
+	0xec, 0xc1, 0xb6, 0x70, 0x3c, 0x96, 0x3d, 0x96, 0xe8, 0x82, 0x3b, 0xf4, 0x18, 0x1c, 0xa5, 0xcb,
+	0xee, 0xca, 0x2a, 0x20, 0xe7, 0x22, 0xc8, 0xdf, 0x8e, 0x1d, 0x02, 0x45, 0x42, 0x54, 0xdd, 0x44,
+	0xf3, 0xde, 0x7f, 0xf3, 0xff, 0xd3, 0xfb, 0xf1, 0x60, 0x8d, 0xa6, 0x6e, 0x9a, 0x08, 0x96, 0x2e,
+	0x0d, 0x71, 0x1e, 0xb1, 0xc4, 0xb0, 0x69, 0xc8, 0x43, 0xdf, 0xa6, 0x81, 0x1e, 0xc5, 0x5c, 0x70,
+	0x72, 0x58, 0x09, 0x74, 0x10, 0x0c, 0x8e, 0x5d, 0xee, 0x72, 0xa8, 0x19, 0xf9, 0xa9, 0x90, 0x0d,
+	0x1e, 0xec, 0xf6, 0x81, 0xdf, 0xb2, 0xa8, 0xb9, 0x9c, 0xbb, 0x01, 0x33, 0x00, 0x2d, 0xd2, 0x8f,
+	0x86, 0xf0, 0x97, 0x2c, 0x11, 0x74, 0x19, 0x15, 0x82, 0xd1, 0x17, 0x7c, 0x74, 0xb2, 0x99, 0x6b,
+	0x06, 0xdc, 0xfe, 0x34, 0x7d, 0x41, 0x08, 0x96, 0x3c, 0x9a, 0x78, 0x0a, 0x1a, 0xa2, 0xf1, 0x81,
+	0x05, 0x67, 0xf2, 0x0e, 0x1f, 0x46, 0x34, 0x16, 0xf3, 0x84, 0x89, 0xb9, 0xc7, 0xa8, 0xc3, 0x62,
+	0xa5, 0x39, 0x44, 0xe3, 0xfd, 0xc9, 0x13, 0x7d, 0xc7, 0xa6, 0x5e, 0xf5, 0x9b, 0xd1, 0x58, 0x9c,
+	0x32, 0xf1, 0x0a, 0xe4, 0xa6, 0x74, 0xb9, 0xd2, 0x1a, 0x56, 0x2f, 0xda, 0x26, 0x47, 0x26, 0xee,
+	0xff, 0x59, 0x4e, 0x8e, 0x71, 0x4b, 0x70, 0x41, 0x03, 0x70, 0xd1, 0xb3, 0x0a, 0x50, 0x59, 0x6b,
+	0xd6, 0xd6, 0x46, 0xd7, 0x4d, 0x7c, 0xbf, 0x6e, 0x12, 0xf3, 0x88, 0x27, 0x34, 0x20, 0x13, 0x2c,
+	0xe5, 0x76, 0xe0, 0xfa, 0xbd, 0x89, 0xfa, 0x9b, 0xcb, 0x53, 0xdf, 0x0d, 0x99, 0xf3, 0x26, 0x71,
+	0xcf, 0xce, 0x23, 0x66, 0x81, 0x96, 0xf4, 0x71, 0xdb, 0x63, 0xbe, 0xeb, 0x09, 0xe8, 0x7f, 0x64,
+	0x95, 0x28, 0xf7, 0x12, 0xf3, 0x34, 0x74, 0x94, 0x3d, 0xa0, 0x0b, 0x40, 0x9e, 0xe2, 0x6e, 0xc4,
+	0x83, 0x79, 0x51, 0x91, 0x86, 0x68, 0xbc, 0x67, 0x1e, 0x64, 0x2b, 0x4d, 0x9e, 0xbd, 0x7d, 0x6d,
+	0xe5, 0x9c, 0x25, 0x47, 0x3c, 0x80, 0x13, 0x99, 0x62, 0x79, 0x91, 0x87, 0x3b, 0xf7, 0x1d, 0xa5,
+	0x05, 0xb1, 0x3d, 0xba, 0x39, 0xb6, 0x72, 0x0d, 0xe6, 0x7e, 0xb6, 0xd2, 0x3a, 0x25, 0xb0, 0x3a,
+	0x70, 0x7f, 0xea, 0x10, 0x13, 0x77, 0xab, 0x1d, 0x2a, 0x6d, 0xe8, 0x35, 0xd0, 0x8b, 0x2d, 0xeb,
+	0x9b, 0x2d, 0xeb, 0x67, 0x1b, 0x85, 0x29, 0xe7, 0xa9, 0x5f, 0x7c, 0xd7, 0x90, 0x55, 0x5f, 0x23,
+	0x8f, 0xb1, 0x6c, 0x7b, 0xd4, 0x0f, 0x73, 0x3b, 0x9d, 0x21, 0x1a, 0x77, 0x8b, 0x59, 0x27, 0x39,
+	0x97, 0xcf, 0x82, 0xe2, 0xd4, 0x19, 0x7d, 0x6d, 0xe2, 0x5e, 0x65, 0xeb, 0x3d, 0x17, 0xec, 0x0e,
+	0x52, 0xdd, 0x8e, 0x4a, 0xba, 0xc5, 0xa8, 0x5a, 0xff, 0x1f, 0x55, 0xfb, 0x2f, 0x51, 0x5d, 0x23,
+	0xdc, 0xff, 0x25, 0xaa, 0x97, 0x9f, 0x05, 0x0b, 0x13, 0x9f, 0x87, 0xe4, 0x21, 0xee, 0xb2, 0x0d,
+	0x28, 0xbf, 0xa9, 0x9a, 0xf8, 0xc7, 0x74, 0xb6, 0xed, 0x48, 0x37, 0xdb, 0xb9, 0xc5, 0x3f, 0x9c,
+	0x39, 0xbb, 0xcc, 0x54, 0x74, 0x95, 0xa9, 0xe8, 0x47, 0xa6, 0xa2, 0x8b, 0xb5, 0xda, 0xb8, 0x5a,
+	0xab, 0x8d, 0x6f, 0x6b, 0xb5, 0xf1, 0xe1, 0xb9, 0xeb, 0x0b, 0x2f, 0x5d, 0xe8, 0x36, 0x5f, 0x1a,
+	0x76, 0xcc, 0xa8, 0xa0, 0x30, 0xde, 0xa8, 0x1f, 0xa4, 0xe2, 0xb1, 0xda, 0x79, 0xa0, 0x16, 0x6d,
+	0xa0, 0x9f, 0xfd, 0x1c, 0x00, 0xd0, 0x97, 0x1f, 0xb8, 0x02, 0x05, 0x00, 0x00,
 }
 
 func (m *CanonicalBlockID) Marshal() (dAtA []byte, err error) {
@@ -566,6 +648,67 @@ func (m *CanonicalVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CanonicalVoteExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanonicalVoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CanonicalVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockID != nil {
+		{
+			size, err := m.BlockID.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCanonical(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintCanonical(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Round != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Round))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.Height != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Height))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.Extension) > 0 {
+		i -= len(m.Extension)
+		copy(dAtA[i:], m.Extension)
+		i = encodeVarintCanonical(dAtA, i, uint64(len(m.Extension)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCanonical(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCanonical(v)
 	base := offset
@@ -667,6 +810,33 @@ func (m *CanonicalVote) Size() (n int) {
 	return n
 }
 
+func (m *CanonicalVoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Extension)
+	if l > 0 {
+		n += 1 + l + sovCanonical(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 9
+	}
+	if m.Round != 0 {
+		n += 9
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovCanonical(uint64(l))
+	}
+	if m.BlockID != nil {
+		l = m.BlockID.Size()
+		n += 1 + l + sovCanonical(uint64(l))
+	}
+	return n
+}
+
 func sovCanonical(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1292,6 +1462,178 @@ func (m *CanonicalVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CanonicalVoteExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCanonical
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanonicalVoteExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanonicalVoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCanonical
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCanonical
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCanonical
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extension = append(m.Extension[:0], dAtA[iNdEx:postIndex]...)
+			if m.Extension == nil {
+				m.Extension = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Height = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Round = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCanonical
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCanonical
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCanonical
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCanonical
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCanonical
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCanonical
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockID == nil {
+				m.BlockID = &CanonicalBlockID{}
+			}
+			if err := m.BlockID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCanonical(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCanonical
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCanonical(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
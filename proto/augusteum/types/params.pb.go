@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/golang/protobuf/ptypes/duration"
 	io "io"
@@ -34,6 +35,8 @@ type ConsensusParams struct {
 	Evidence  EvidenceParams  `protobuf:"bytes,2,opt,name=evidence,proto3" json:"evidence"`
 	Validator ValidatorParams `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator"`
 	Version   VersionParams   `protobuf:"bytes,4,opt,name=version,proto3" json:"version"`
+	Synchrony SynchronyParams `protobuf:"bytes,5,opt,name=synchrony,proto3" json:"synchrony"`
+	Timeout   TimeoutParams   `protobuf:"bytes,6,opt,name=timeout,proto3" json:"timeout"`
 }
 
 func (m *ConsensusParams) Reset()         { *m = ConsensusParams{} }
@@ -97,6 +100,20 @@ func (m *ConsensusParams) GetVersion() VersionParams {
 	return VersionParams{}
 }
 
+func (m *ConsensusParams) GetSynchrony() SynchronyParams {
+	if m != nil {
+		return m.Synchrony
+	}
+	return SynchronyParams{}
+}
+
+func (m *ConsensusParams) GetTimeout() TimeoutParams {
+	if m != nil {
+		return m.Timeout
+	}
+	return TimeoutParams{}
+}
+
 // BlockParams contains limits on the block size.
 type BlockParams struct {
 	// Max block size, in bytes.
@@ -330,6 +347,185 @@ func (m *VersionParams) GetAppVersion() uint64 {
 	return 0
 }
 
+// SynchronyParams configure proposer-based timestamps (PBTS), where the
+// proposer sets the block time from its own clock and validators only prevote
+// for proposals received within the bounds of their timestamp.
+type SynchronyParams struct {
+	// Bound on the clock drift between correct validators.
+	Precision time.Duration `protobuf:"bytes,1,opt,name=precision,proto3,stdduration" json:"precision"`
+	// Bound on the time it takes a proposal to reach the validators.
+	MessageDelay time.Duration `protobuf:"bytes,2,opt,name=message_delay,json=messageDelay,proto3,stdduration" json:"message_delay"`
+	// Height from which the block time is set by the proposer instead of being
+	// the median of the last commit vote times. Zero disables PBTS.
+	PBTSEnableHeight int64 `protobuf:"varint,3,opt,name=pbts_enable_height,json=pbtsEnableHeight,proto3" json:"pbts_enable_height,omitempty"`
+}
+
+func (m *SynchronyParams) Reset()         { *m = SynchronyParams{} }
+func (m *SynchronyParams) String() string { return proto.CompactTextString(m) }
+func (*SynchronyParams) ProtoMessage()    {}
+func (*SynchronyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e12598271a686f57, []int{5}
+}
+func (m *SynchronyParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SynchronyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SynchronyParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SynchronyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SynchronyParams.Merge(m, src)
+}
+func (m *SynchronyParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SynchronyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SynchronyParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SynchronyParams proto.InternalMessageInfo
+
+func (m *SynchronyParams) GetPrecision() time.Duration {
+	if m != nil {
+		return m.Precision
+	}
+	return 0
+}
+
+func (m *SynchronyParams) GetMessageDelay() time.Duration {
+	if m != nil {
+		return m.MessageDelay
+	}
+	return 0
+}
+
+func (m *SynchronyParams) GetPBTSEnableHeight() int64 {
+	if m != nil {
+		return m.PBTSEnableHeight
+	}
+	return 0
+}
+
+// TimeoutParams configure the timeouts of the consensus rounds, so that all
+// validators of a network step through them in lockstep. A timeout which is
+// not set falls back to the value in the node's local consensus config.
+type TimeoutParams struct {
+	// Time to wait for a proposal before prevoting nil in round 0.
+	Propose *time.Duration `protobuf:"bytes,1,opt,name=propose,proto3,stdduration" json:"propose,omitempty"`
+	// Increase of the propose timeout with every round.
+	ProposeDelta *time.Duration `protobuf:"bytes,2,opt,name=propose_delta,json=proposeDelta,proto3,stdduration" json:"propose_delta,omitempty"`
+	// Time to wait after receiving +2/3 prevotes for "anything".
+	Prevote *time.Duration `protobuf:"bytes,3,opt,name=prevote,proto3,stdduration" json:"prevote,omitempty"`
+	// Increase of the prevote timeout with every round.
+	PrevoteDelta *time.Duration `protobuf:"bytes,4,opt,name=prevote_delta,json=prevoteDelta,proto3,stdduration" json:"prevote_delta,omitempty"`
+	// Time to wait after receiving +2/3 precommits for "anything".
+	Precommit *time.Duration `protobuf:"bytes,5,opt,name=precommit,proto3,stdduration" json:"precommit,omitempty"`
+	// Increase of the precommit timeout with every round.
+	PrecommitDelta *time.Duration `protobuf:"bytes,6,opt,name=precommit_delta,json=precommitDelta,proto3,stdduration" json:"precommit_delta,omitempty"`
+	// Time to wait after committing a block before starting the next height.
+	Commit *time.Duration `protobuf:"bytes,7,opt,name=commit,proto3,stdduration" json:"commit,omitempty"`
+	// Make progress as soon as all the precommits are received, as if the
+	// commit timeout was zero. When not set, the local config decides.
+	BypassCommitTimeout *bool `protobuf:"bytes,8,opt,name=bypass_commit_timeout,json=bypassCommitTimeout,proto3,wktptr" json:"bypass_commit_timeout,omitempty"`
+}
+
+func (m *TimeoutParams) Reset()         { *m = TimeoutParams{} }
+func (m *TimeoutParams) String() string { return proto.CompactTextString(m) }
+func (*TimeoutParams) ProtoMessage()    {}
+func (*TimeoutParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e12598271a686f57, []int{6}
+}
+func (m *TimeoutParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeoutParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeoutParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimeoutParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeoutParams.Merge(m, src)
+}
+func (m *TimeoutParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeoutParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeoutParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeoutParams proto.InternalMessageInfo
+
+func (m *TimeoutParams) GetPropose() *time.Duration {
+	if m != nil {
+		return m.Propose
+	}
+	return nil
+}
+
+func (m *TimeoutParams) GetProposeDelta() *time.Duration {
+	if m != nil {
+		return m.ProposeDelta
+	}
+	return nil
+}
+
+func (m *TimeoutParams) GetPrevote() *time.Duration {
+	if m != nil {
+		return m.Prevote
+	}
+	return nil
+}
+
+func (m *TimeoutParams) GetPrevoteDelta() *time.Duration {
+	if m != nil {
+		return m.PrevoteDelta
+	}
+	return nil
+}
+
+func (m *TimeoutParams) GetPrecommit() *time.Duration {
+	if m != nil {
+		return m.Precommit
+	}
+	return nil
+}
+
+func (m *TimeoutParams) GetPrecommitDelta() *time.Duration {
+	if m != nil {
+		return m.PrecommitDelta
+	}
+	return nil
+}
+
+func (m *TimeoutParams) GetCommit() *time.Duration {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
+func (m *TimeoutParams) GetBypassCommitTimeout() *bool {
+	if m != nil {
+		return m.BypassCommitTimeout
+	}
+	return nil
+}
+
 // HashedParams is a subset of ConsensusParams.
 //
 // It is hashed into the Header.ConsensusHash.
@@ -342,7 +538,7 @@ func (m *HashedParams) Reset()         { *m = HashedParams{} }
 func (m *HashedParams) String() string { return proto.CompactTextString(m) }
 func (*HashedParams) ProtoMessage()    {}
 func (*HashedParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e12598271a686f57, []int{5}
+	return fileDescriptor_e12598271a686f57, []int{7}
 }
 func (m *HashedParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -391,47 +587,66 @@ func init() {
 	proto.RegisterType((*EvidenceParams)(nil), "augusteum.types.EvidenceParams")
 	proto.RegisterType((*ValidatorParams)(nil), "augusteum.types.ValidatorParams")
 	proto.RegisterType((*VersionParams)(nil), "augusteum.types.VersionParams")
+	proto.RegisterType((*SynchronyParams)(nil), "augusteum.types.SynchronyParams")
+	proto.RegisterType((*TimeoutParams)(nil), "augusteum.types.TimeoutParams")
 	proto.RegisterType((*HashedParams)(nil), "augusteum.types.HashedParams")
 }
 
 func init() { proto.RegisterFile("augusteum/types/params.proto", fileDescriptor_e12598271a686f57) }
 
 var fileDescriptor_e12598271a686f57 = []byte{
-	// 537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x18, 0xcd, 0xd5, 0xa5, 0x4d, 0xbe, 0x34, 0x4d, 0x75, 0x42, 0x22, 0x14, 0xd5, 0x0e, 0x1e, 0x50,
-	0x25, 0x24, 0x5b, 0x82, 0x01, 0xd1, 0xa5, 0xc2, 0x50, 0x15, 0x84, 0x82, 0x90, 0x05, 0x0c, 0x5d,
-	0xac, 0x73, 0x72, 0xb8, 0x56, 0x73, 0x3e, 0xcb, 0x77, 0x8e, 0x92, 0x7f, 0xc1, 0xd8, 0xb1, 0x23,
-	0xfc, 0x03, 0x7e, 0x42, 0xc7, 0x8e, 0x4c, 0x80, 0x92, 0x85, 0x9f, 0x81, 0x7c, 0xce, 0xe1, 0x38,
-	0x65, 0xf3, 0x7d, 0xdf, 0x7b, 0xef, 0xfc, 0xde, 0xd3, 0xc1, 0x81, 0xa4, 0xc9, 0x88, 0x66, 0x2c,
-	0x4e, 0xa4, 0x2b, 0x67, 0x29, 0x15, 0x6e, 0x4a, 0x32, 0xc2, 0x84, 0x93, 0x66, 0x5c, 0x72, 0xbc,
-	0x57, 0xad, 0x1d, 0xb5, 0xde, 0xbf, 0x1b, 0xf1, 0x88, 0xab, 0xa5, 0x5b, 0x7c, 0x95, 0xb8, 0x7d,
-	0x33, 0xe2, 0x3c, 0x1a, 0x53, 0x57, 0x9d, 0xc2, 0xfc, 0xb3, 0x3b, 0xca, 0x33, 0x22, 0x63, 0x9e,
-	0x94, 0x7b, 0xfb, 0x72, 0x03, 0xba, 0x2f, 0x79, 0x22, 0x68, 0x22, 0x72, 0xf1, 0x5e, 0xdd, 0x80,
-	0x9f, 0xc3, 0x9d, 0x70, 0xcc, 0x87, 0x17, 0x3d, 0xd4, 0x47, 0x87, 0xed, 0x27, 0x07, 0xce, 0xfa,
-	0x5d, 0x8e, 0x57, 0xac, 0x4b, 0xb4, 0xb7, 0x79, 0xfd, 0xd3, 0x6a, 0xf8, 0x25, 0x03, 0x7b, 0xd0,
-	0xa4, 0x93, 0x78, 0x44, 0x93, 0x21, 0xed, 0x6d, 0x28, 0x76, 0xff, 0x36, 0xfb, 0x64, 0x89, 0xa8,
-	0x09, 0xfc, 0xe3, 0xe1, 0x13, 0x68, 0x4d, 0xc8, 0x38, 0x1e, 0x11, 0xc9, 0xb3, 0x9e, 0xa1, 0x44,
-	0x1e, 0xde, 0x16, 0xf9, 0xa4, 0x21, 0x35, 0x95, 0x8a, 0x89, 0x8f, 0x61, 0x7b, 0x42, 0x33, 0x11,
-	0xf3, 0xa4, 0xb7, 0xa9, 0x44, 0xac, 0xff, 0x88, 0x94, 0x80, 0x9a, 0x84, 0x66, 0xd9, 0x14, 0xda,
-	0x2b, 0x3e, 0xf1, 0x03, 0x68, 0x31, 0x32, 0x0d, 0xc2, 0x99, 0xa4, 0x42, 0x25, 0x63, 0xf8, 0x4d,
-	0x46, 0xa6, 0x5e, 0x71, 0xc6, 0xf7, 0x60, 0xbb, 0x58, 0x46, 0x44, 0x28, 0xdb, 0x86, 0xbf, 0xc5,
-	0xc8, 0xf4, 0x94, 0x08, 0xdc, 0x87, 0x1d, 0x19, 0x33, 0x1a, 0xc4, 0x5c, 0x92, 0x80, 0x09, 0xe5,
-	0xc7, 0xf0, 0xa1, 0x98, 0xbd, 0xe1, 0x92, 0x0c, 0x84, 0xfd, 0x0d, 0xc1, 0x6e, 0x3d, 0x11, 0xfc,
-	0x18, 0x70, 0xa1, 0x46, 0x22, 0x1a, 0x24, 0x39, 0x0b, 0x54, 0xb4, 0xfa, 0xce, 0x2e, 0x23, 0xd3,
-	0x17, 0x11, 0x7d, 0x97, 0x33, 0xf5, 0x73, 0x02, 0x0f, 0x60, 0x4f, 0x83, 0x75, 0xb7, 0xcb, 0xe8,
-	0xef, 0x3b, 0x65, 0xf9, 0x8e, 0x2e, 0xdf, 0x79, 0xb5, 0x04, 0x78, 0xcd, 0xc2, 0xea, 0xe5, 0x2f,
-	0x0b, 0xf9, 0xbb, 0xa5, 0x9e, 0xde, 0xd4, 0x6d, 0x1a, 0x75, 0x9b, 0xf6, 0x31, 0x74, 0xd7, 0x72,
-	0xc7, 0x36, 0x74, 0xd2, 0x3c, 0x0c, 0x2e, 0xe8, 0x2c, 0x50, 0x99, 0xf6, 0x50, 0xdf, 0x38, 0x6c,
-	0xf9, 0xed, 0x34, 0x0f, 0xdf, 0xd2, 0xd9, 0x87, 0x62, 0x74, 0xd4, 0xfc, 0x7e, 0x65, 0xa1, 0x3f,
-	0x57, 0x16, 0xb2, 0x8f, 0xa0, 0x53, 0xcb, 0x1c, 0x5b, 0xd0, 0x26, 0x69, 0x1a, 0xe8, 0xa6, 0x0a,
-	0x8f, 0x9b, 0x3e, 0x90, 0x34, 0x5d, 0xc2, 0x56, 0xb8, 0x67, 0xb0, 0xf3, 0x9a, 0x88, 0x73, 0x3a,
-	0x5a, 0x52, 0x1f, 0x41, 0x57, 0x25, 0x13, 0xac, 0xd7, 0xd2, 0x51, 0xe3, 0x81, 0xee, 0xc6, 0x86,
-	0x4e, 0x85, 0xab, 0x1a, 0x6a, 0x6b, 0xd4, 0x29, 0x11, 0xde, 0xc7, 0xaf, 0x73, 0x13, 0x5d, 0xcf,
-	0x4d, 0x74, 0x33, 0x37, 0xd1, 0xef, 0xb9, 0x89, 0xbe, 0x2c, 0xcc, 0xc6, 0xcd, 0xc2, 0x6c, 0xfc,
-	0x58, 0x98, 0x8d, 0xb3, 0x67, 0x51, 0x2c, 0xcf, 0xf3, 0xd0, 0x19, 0x72, 0xe6, 0xae, 0x3e, 0xcb,
-	0xea, 0xb3, 0x7c, 0x77, 0xeb, 0x4f, 0x36, 0xdc, 0x52, 0xf3, 0xa7, 0x7f, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0xfe, 0xe0, 0x3d, 0x9c, 0xcd, 0x03, 0x00, 0x00,
+	// 806 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcd, 0x8a, 0xdb, 0x46,
+	0x1c, 0x5f, 0x45, 0x5e, 0xaf, 0xf7, 0x6f, 0x7b, 0xbd, 0x4c, 0x53, 0xaa, 0x6e, 0x83, 0x6c, 0x74,
+	0x28, 0x81, 0x82, 0x0c, 0x2d, 0xf4, 0x23, 0xd0, 0x96, 0x55, 0x1c, 0xe2, 0x52, 0xb6, 0x04, 0xc5,
+	0xe4, 0x90, 0x8b, 0x18, 0xd9, 0x53, 0x59, 0xc4, 0xd2, 0x0c, 0x9a, 0x91, 0x6b, 0xbd, 0x45, 0x8f,
+	0xa5, 0xa7, 0x3d, 0x36, 0x6f, 0xd0, 0x47, 0xc8, 0x31, 0xc7, 0x9e, 0xd2, 0xe2, 0xbd, 0xf4, 0x31,
+	0xca, 0x8c, 0x46, 0xf6, 0xca, 0x66, 0xa9, 0x73, 0x93, 0xe6, 0xf7, 0xa1, 0xdf, 0xff, 0x83, 0x11,
+	0x3c, 0xc0, 0x79, 0x94, 0x73, 0x41, 0xf2, 0x64, 0x28, 0x0a, 0x46, 0xf8, 0x90, 0xe1, 0x0c, 0x27,
+	0xdc, 0x65, 0x19, 0x15, 0x14, 0xf5, 0x36, 0xa8, 0xab, 0xd0, 0x8b, 0xfb, 0x11, 0x8d, 0xa8, 0xc2,
+	0x86, 0xf2, 0xa9, 0xa4, 0x5d, 0xd8, 0x11, 0xa5, 0xd1, 0x82, 0x0c, 0xd5, 0x5b, 0x98, 0xff, 0x3c,
+	0x9c, 0xe5, 0x19, 0x16, 0x31, 0x4d, 0xef, 0xc2, 0x7f, 0xc9, 0x30, 0x63, 0x24, 0xd3, 0x9f, 0x71,
+	0x7e, 0x37, 0xa1, 0xf7, 0x98, 0xa6, 0x9c, 0xa4, 0x3c, 0xe7, 0xcf, 0x54, 0x00, 0xf4, 0x35, 0x1c,
+	0x87, 0x0b, 0x3a, 0x7d, 0x65, 0x19, 0x03, 0xe3, 0x61, 0xfb, 0xf3, 0x07, 0xee, 0x4e, 0x14, 0xd7,
+	0x93, 0x68, 0x49, 0xf6, 0x1a, 0x6f, 0xde, 0xf5, 0x8f, 0xfc, 0x52, 0x80, 0x2e, 0xa1, 0x45, 0x96,
+	0xf1, 0x8c, 0xa4, 0x53, 0x62, 0xdd, 0x53, 0xe2, 0xfe, 0x9e, 0xf8, 0x89, 0x26, 0xd4, 0xf4, 0x1b,
+	0x19, 0x1a, 0xc1, 0xe9, 0x12, 0x2f, 0xe2, 0x19, 0x16, 0x34, 0xb3, 0x4c, 0xe5, 0x31, 0xd8, 0xf3,
+	0x78, 0x51, 0x31, 0x6a, 0x26, 0x5b, 0x21, 0xfa, 0x0e, 0x4e, 0x96, 0x24, 0xe3, 0x31, 0x4d, 0xad,
+	0x86, 0xf2, 0xb0, 0xf7, 0x3d, 0x4a, 0xbc, 0xe6, 0x50, 0x89, 0x64, 0x0a, 0x5e, 0xa4, 0xd3, 0x79,
+	0x46, 0xd3, 0xc2, 0x3a, 0xbe, 0x23, 0xc5, 0xf3, 0x8a, 0x51, 0x4f, 0xb1, 0x11, 0xca, 0x14, 0x22,
+	0x4e, 0x08, 0xcd, 0x85, 0xd5, 0xbc, 0x23, 0xc5, 0xa4, 0xc4, 0xeb, 0x29, 0xb4, 0xc8, 0x21, 0xd0,
+	0xbe, 0xd5, 0x6a, 0xf4, 0x09, 0x9c, 0x26, 0x78, 0x15, 0x84, 0x85, 0x20, 0x5c, 0xcd, 0xc6, 0xf4,
+	0x5b, 0x09, 0x5e, 0x79, 0xf2, 0x1d, 0x7d, 0x04, 0x27, 0x12, 0x8c, 0x30, 0x57, 0x9d, 0x37, 0xfd,
+	0x66, 0x82, 0x57, 0x4f, 0x31, 0x47, 0x03, 0xe8, 0x48, 0xbf, 0x20, 0xa6, 0x02, 0x07, 0x09, 0x57,
+	0x3d, 0x35, 0x7d, 0x90, 0x67, 0x3f, 0x50, 0x81, 0xaf, 0xb8, 0xf3, 0xda, 0x80, 0xb3, 0xfa, 0x54,
+	0xd0, 0x67, 0x80, 0xa4, 0x1b, 0x8e, 0x48, 0x90, 0xe6, 0x49, 0xa0, 0xa6, 0x5b, 0x7d, 0xb3, 0x97,
+	0xe0, 0xd5, 0x65, 0x44, 0x7e, 0xca, 0x13, 0x15, 0x8e, 0xa3, 0x2b, 0x38, 0xaf, 0xc8, 0xd5, 0xf6,
+	0xe9, 0xe9, 0x7f, 0xec, 0x96, 0xeb, 0xe7, 0x56, 0xeb, 0xe7, 0x8e, 0x34, 0xc1, 0x6b, 0xc9, 0x52,
+	0x7f, 0xfb, 0xbb, 0x6f, 0xf8, 0x67, 0xa5, 0x5f, 0x85, 0xd4, 0xcb, 0x34, 0xeb, 0x65, 0x3a, 0xdf,
+	0x43, 0x6f, 0x67, 0xf8, 0xc8, 0x81, 0x2e, 0xcb, 0xc3, 0xe0, 0x15, 0x29, 0x02, 0xd5, 0x53, 0xcb,
+	0x18, 0x98, 0x0f, 0x4f, 0xfd, 0x36, 0xcb, 0xc3, 0x1f, 0x49, 0x31, 0x91, 0x47, 0x8f, 0x5a, 0x7f,
+	0x5e, 0xf7, 0x8d, 0x7f, 0xaf, 0xfb, 0x86, 0xf3, 0x08, 0xba, 0xb5, 0xc9, 0xa3, 0x3e, 0xb4, 0x31,
+	0x63, 0x41, 0xb5, 0x2e, 0xb2, 0xc6, 0x86, 0x0f, 0x98, 0x31, 0x4d, 0xbb, 0xa5, 0x5d, 0x1b, 0xd0,
+	0xdb, 0x19, 0x3a, 0xba, 0x84, 0x53, 0x96, 0x91, 0x69, 0xbc, 0x11, 0x1f, 0x58, 0xf5, 0x56, 0x85,
+	0xc6, 0xd0, 0x4d, 0x08, 0xe7, 0xaa, 0x7f, 0x64, 0x81, 0x8b, 0xf7, 0x69, 0x5e, 0x47, 0x2b, 0x47,
+	0x52, 0x88, 0x3c, 0x40, 0x2c, 0x14, 0x3c, 0x20, 0x29, 0x0e, 0x17, 0x24, 0x98, 0x93, 0x38, 0x9a,
+	0x8b, 0xb2, 0x87, 0xde, 0xfd, 0xf5, 0xbb, 0xfe, 0xf9, 0x33, 0x6f, 0xf2, 0xfc, 0x89, 0x02, 0xc7,
+	0x0a, 0xf3, 0xcf, 0x25, 0xff, 0xf6, 0x89, 0xf3, 0xba, 0x01, 0xdd, 0xda, 0x56, 0xa2, 0x6f, 0xe0,
+	0x84, 0x65, 0x94, 0x51, 0x4e, 0xfe, 0xbf, 0xc0, 0x86, 0x4a, 0x55, 0xf1, 0xd1, 0x08, 0xba, 0xfa,
+	0x51, 0x96, 0x26, 0xb0, 0x75, 0xef, 0x30, 0x83, 0x8e, 0x56, 0x8d, 0xa4, 0xa8, 0x0c, 0x40, 0x96,
+	0x54, 0x10, 0xcb, 0x3c, 0x4c, 0x5f, 0xf1, 0xcb, 0x00, 0xea, 0x51, 0x07, 0x68, 0x1c, 0x1c, 0x40,
+	0xa9, 0xca, 0x00, 0xdf, 0x96, 0x43, 0xa6, 0x49, 0x12, 0x0b, 0xeb, 0xf8, 0x30, 0x87, 0xad, 0x02,
+	0x8d, 0xa1, 0xb7, 0x79, 0xd1, 0x31, 0x9a, 0x87, 0x99, 0x9c, 0x6d, 0x74, 0x65, 0x90, 0xaf, 0xa0,
+	0xa9, 0x53, 0x9c, 0x1c, 0x66, 0xa0, 0xe9, 0x68, 0x02, 0x1f, 0x86, 0x05, 0xc3, 0x9c, 0x07, 0x3a,
+	0x46, 0x75, 0x31, 0xb5, 0x94, 0xcf, 0xc5, 0x9e, 0x8f, 0x47, 0xe9, 0xe2, 0x05, 0x5e, 0xe4, 0xc4,
+	0x6b, 0x5c, 0x4b, 0xa3, 0x0f, 0x4a, 0xf9, 0x63, 0xa5, 0xd6, 0xfb, 0xe1, 0xbc, 0x84, 0xce, 0x18,
+	0xf3, 0x39, 0x99, 0xe9, 0x4d, 0xf9, 0x14, 0x7a, 0xea, 0xaa, 0x08, 0x76, 0xef, 0xa9, 0xae, 0x3a,
+	0xbe, 0xaa, 0x2e, 0x2b, 0x07, 0xba, 0x5b, 0xde, 0xf6, 0xca, 0x6a, 0x57, 0xac, 0xa7, 0x98, 0x7b,
+	0x93, 0x3f, 0xd6, 0xb6, 0xf1, 0x66, 0x6d, 0x1b, 0x6f, 0xd7, 0xb6, 0xf1, 0xcf, 0xda, 0x36, 0x7e,
+	0xbd, 0xb1, 0x8f, 0xde, 0xde, 0xd8, 0x47, 0x7f, 0xdd, 0xd8, 0x47, 0x2f, 0xbf, 0x8c, 0x62, 0x31,
+	0xcf, 0x43, 0x77, 0x4a, 0x93, 0xe1, 0x34, 0x23, 0x58, 0xe0, 0xe9, 0x1c, 0xc7, 0xe9, 0x70, 0xfb,
+	0x4f, 0x2d, 0x7f, 0x95, 0x3b, 0xff, 0xd8, 0xb0, 0xa9, 0x8e, 0xbf, 0xf8, 0x6f, 0x00, 0xcb, 0xd8,
+	0x67, 0x51, 0x7d, 0x07, 0x00, 0x00,
 }
 
 func (this *ConsensusParams) Equal(that interface{}) bool {
@@ -465,6 +680,12 @@ func (this *ConsensusParams) Equal(that interface{}) bool {
 	if !this.Version.Equal(&that1.Version) {
 		return false
 	}
+	if !this.Synchrony.Equal(&that1.Synchrony) {
+		return false
+	}
+	if !this.Timeout.Equal(&that1.Timeout) {
+		return false
+	}
 	return true
 }
 func (this *BlockParams) Equal(that interface{}) bool {
@@ -580,6 +801,129 @@ func (this *VersionParams) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SynchronyParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SynchronyParams)
+	if !ok {
+		that2, ok := that.(SynchronyParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Precision != that1.Precision {
+		return false
+	}
+	if this.MessageDelay != that1.MessageDelay {
+		return false
+	}
+	if this.PBTSEnableHeight != that1.PBTSEnableHeight {
+		return false
+	}
+	return true
+}
+func (this *TimeoutParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TimeoutParams)
+	if !ok {
+		that2, ok := that.(TimeoutParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Propose != nil && that1.Propose != nil {
+		if *this.Propose != *that1.Propose {
+			return false
+		}
+	} else if this.Propose != nil {
+		return false
+	} else if that1.Propose != nil {
+		return false
+	}
+	if this.ProposeDelta != nil && that1.ProposeDelta != nil {
+		if *this.ProposeDelta != *that1.ProposeDelta {
+			return false
+		}
+	} else if this.ProposeDelta != nil {
+		return false
+	} else if that1.ProposeDelta != nil {
+		return false
+	}
+	if this.Prevote != nil && that1.Prevote != nil {
+		if *this.Prevote != *that1.Prevote {
+			return false
+		}
+	} else if this.Prevote != nil {
+		return false
+	} else if that1.Prevote != nil {
+		return false
+	}
+	if this.PrevoteDelta != nil && that1.PrevoteDelta != nil {
+		if *this.PrevoteDelta != *that1.PrevoteDelta {
+			return false
+		}
+	} else if this.PrevoteDelta != nil {
+		return false
+	} else if that1.PrevoteDelta != nil {
+		return false
+	}
+	if this.Precommit != nil && that1.Precommit != nil {
+		if *this.Precommit != *that1.Precommit {
+			return false
+		}
+	} else if this.Precommit != nil {
+		return false
+	} else if that1.Precommit != nil {
+		return false
+	}
+	if this.PrecommitDelta != nil && that1.PrecommitDelta != nil {
+		if *this.PrecommitDelta != *that1.PrecommitDelta {
+			return false
+		}
+	} else if this.PrecommitDelta != nil {
+		return false
+	} else if that1.PrecommitDelta != nil {
+		return false
+	}
+	if this.Commit != nil && that1.Commit != nil {
+		if *this.Commit != *that1.Commit {
+			return false
+		}
+	} else if this.Commit != nil {
+		return false
+	} else if that1.Commit != nil {
+		return false
+	}
+	if this.BypassCommitTimeout != nil && that1.BypassCommitTimeout != nil {
+		if *this.BypassCommitTimeout != *that1.BypassCommitTimeout {
+			return false
+		}
+	} else if this.BypassCommitTimeout != nil {
+		return false
+	} else if that1.BypassCommitTimeout != nil {
+		return false
+	}
+	return true
+}
 func (this *HashedParams) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -627,6 +971,26 @@ func (m *ConsensusParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Synchrony.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -733,12 +1097,12 @@ func (m *EvidenceParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAgeDuration):])
-	if err5 != nil {
-		return 0, err5
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAgeDuration):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n5
-	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i -= n7
+	i = encodeVarintParams(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x12
 	if m.MaxAgeNumBlocks != 0 {
@@ -809,7 +1173,7 @@ func (m *VersionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *HashedParams) Marshal() (dAtA []byte, err error) {
+func (m *SynchronyParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -819,58 +1183,205 @@ func (m *HashedParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HashedParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *SynchronyParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HashedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SynchronyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockMaxGas != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.BlockMaxGas))
+	if m.PBTSEnableHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PBTSEnableHeight))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x18
 	}
-	if m.BlockMaxBytes != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.BlockMaxBytes))
-		i--
-		dAtA[i] = 0x8
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MessageDelay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MessageDelay):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintParams(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Precision, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Precision):])
+	if err9 != nil {
+		return 0, err9
 	}
+	i -= n9
+	i = encodeVarintParams(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func NewPopulatedValidatorParams(r randyParams, easy bool) *ValidatorParams {
-	this := &ValidatorParams{}
-	v1 := r.Intn(10)
-	this.PubKeyTypes = make([]string, v1)
-	for i := 0; i < v1; i++ {
-		this.PubKeyTypes[i] = string(randStringParams(r))
-	}
-	if !easy && r.Intn(10) != 0 {
+func (m *TimeoutParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedVersionParams(r randyParams, easy bool) *VersionParams {
-	this := &VersionParams{}
-	this.AppVersion = uint64(uint64(r.Uint32()))
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *TimeoutParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeoutParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BypassCommitTimeout != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.BypassCommitTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.BypassCommitTimeout):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintParams(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Commit != nil {
+		n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Commit, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Commit):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintParams(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PrecommitDelta != nil {
+		n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.PrecommitDelta, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.PrecommitDelta):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintParams(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Precommit != nil {
+		n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Precommit, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Precommit):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintParams(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PrevoteDelta != nil {
+		n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.PrevoteDelta, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.PrevoteDelta):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintParams(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Prevote != nil {
+		n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Prevote, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Prevote):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintParams(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposeDelta != nil {
+		n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ProposeDelta, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ProposeDelta):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintParams(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Propose != nil {
+		n17, err17 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Propose, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Propose):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintParams(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HashedParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HashedParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HashedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockMaxGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BlockMaxGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockMaxBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BlockMaxBytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedValidatorParams(r randyParams, easy bool) *ValidatorParams {
+	this := &ValidatorParams{}
+	v1 := r.Intn(10)
+	this.PubKeyTypes = make([]string, v1)
+	for i := 0; i < v1; i++ {
+		this.PubKeyTypes[i] = string(randStringParams(r))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedVersionParams(r randyParams, easy bool) *VersionParams {
+	this := &VersionParams{}
+	this.AppVersion = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
 
 type randyParams interface {
@@ -959,6 +1470,10 @@ func (m *ConsensusParams) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.Version.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.Synchrony.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Timeout.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -1024,6 +1539,63 @@ func (m *VersionParams) Size() (n int) {
 	return n
 }
 
+func (m *SynchronyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Precision)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MessageDelay)
+	n += 1 + l + sovParams(uint64(l))
+	if m.PBTSEnableHeight != 0 {
+		n += 1 + sovParams(uint64(m.PBTSEnableHeight))
+	}
+	return n
+}
+
+func (m *TimeoutParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Propose != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Propose)
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.ProposeDelta != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ProposeDelta)
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Prevote != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Prevote)
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.PrevoteDelta != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.PrevoteDelta)
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Precommit != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Precommit)
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.PrecommitDelta != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.PrecommitDelta)
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Commit != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Commit)
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.BypassCommitTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdBool(*m.BypassCommitTimeout)
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
 func (m *HashedParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1206,6 +1778,72 @@ func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synchrony", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Synchrony.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1606,6 +2244,479 @@ func (m *VersionParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SynchronyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SynchronyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SynchronyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Precision, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MessageDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PBTSEnableHeight", wireType)
+			}
+			m.PBTSEnableHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PBTSEnableHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeoutParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeoutParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeoutParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Propose", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Propose == nil {
+				m.Propose = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Propose, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposeDelta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProposeDelta == nil {
+				m.ProposeDelta = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ProposeDelta, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prevote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Prevote == nil {
+				m.Prevote = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Prevote, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevoteDelta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrevoteDelta == nil {
+				m.PrevoteDelta = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.PrevoteDelta, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Precommit == nil {
+				m.Precommit = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Precommit, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecommitDelta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrecommitDelta == nil {
+				m.PrecommitDelta = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.PrecommitDelta, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Commit, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassCommitTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BypassCommitTimeout == nil {
+				m.BypassCommitTimeout = new(bool)
+			}
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.BypassCommitTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *HashedParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -474,6 +474,9 @@ type Vote struct {
 	ValidatorAddress []byte        `protobuf:"bytes,6,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	ValidatorIndex   int32         `protobuf:"varint,7,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
 	Signature        []byte        `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	// application data attached to non-nil precommits, and its signature
+	Extension          []byte `protobuf:"bytes,9,opt,name=extension,proto3" json:"extension,omitempty"`
+	ExtensionSignature []byte `protobuf:"bytes,10,opt,name=extension_signature,json=extensionSignature,proto3" json:"extension_signature,omitempty"`
 }
 
 func (m *Vote) Reset()         { *m = Vote{} }
@@ -565,6 +568,20 @@ func (m *Vote) GetSignature() []byte {
 	return nil
 }
 
+func (m *Vote) GetExtension() []byte {
+	if m != nil {
+		return m.Extension
+	}
+	return nil
+}
+
+func (m *Vote) GetExtensionSignature() []byte {
+	if m != nil {
+		return m.ExtensionSignature
+	}
+	return nil
+}
+
 // Commit contains the evidence that a block was committed by a set of validators.
 type Commit struct {
 	Height     int64       `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
@@ -1049,90 +1066,91 @@ func init() {
 func init() { proto.RegisterFile("augusteum/types/types.proto", fileDescriptor_d3a6e55e2345de56) }
 
 var fileDescriptor_d3a6e55e2345de56 = []byte{
-	// 1314 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xda, 0x9b, 0xd8, 0x7e, 0xb6, 0x13, 0x67, 0x95, 0xb6, 0xae, 0xdb, 0x38, 0x2b, 0x23,
-	0x20, 0x2d, 0x68, 0x53, 0x52, 0xc4, 0x9f, 0x03, 0x07, 0xdb, 0x49, 0x5b, 0xab, 0x89, 0x63, 0xd6,
-	0x6e, 0x11, 0x5c, 0x56, 0x6b, 0xef, 0xd4, 0x5e, 0xba, 0xde, 0x59, 0xed, 0x8c, 0x43, 0xd2, 0x4f,
-	0x80, 0x72, 0xea, 0x89, 0x5b, 0x4e, 0x70, 0xe0, 0xce, 0x17, 0x40, 0x9c, 0x7a, 0xec, 0x0d, 0x2e,
-	0x14, 0x94, 0x4a, 0x88, 0x8f, 0x81, 0xe6, 0x8f, 0xd7, 0xeb, 0x38, 0x86, 0xaa, 0xaa, 0xb8, 0x58,
-	0x3b, 0xef, 0xfd, 0xde, 0xcc, 0x7b, 0xbf, 0xf7, 0x9b, 0x3f, 0x86, 0xeb, 0x14, 0xf9, 0x0e, 0x0a,
-	0x87, 0xae, 0x4f, 0xb7, 0xe8, 0x71, 0x80, 0x88, 0xf8, 0x35, 0x82, 0x10, 0x53, 0xac, 0x15, 0x26,
-	0x5e, 0x83, 0xdb, 0x4b, 0x6b, 0x7d, 0xdc, 0xc7, 0xdc, 0xb9, 0xc5, 0xbe, 0x04, 0xae, 0xb4, 0xd1,
-	0xc7, 0xb8, 0xef, 0xa1, 0x2d, 0x3e, 0xea, 0x8e, 0x1e, 0x6d, 0x51, 0x77, 0x88, 0x08, 0xb5, 0x87,
-	0x81, 0x04, 0xac, 0xc7, 0x96, 0xe9, 0x85, 0xc7, 0x01, 0xc5, 0x0c, 0x8b, 0x1f, 0x49, 0x77, 0x39,
-	0xe6, 0x3e, 0x44, 0x21, 0x71, 0xb1, 0x1f, 0xcf, 0xa3, 0xa4, 0xcf, 0x64, 0x79, 0x68, 0x7b, 0xae,
-	0x63, 0x53, 0x1c, 0x0a, 0x44, 0xe5, 0x53, 0xc8, 0xb7, 0xec, 0x90, 0xb6, 0x11, 0xbd, 0x87, 0x6c,
-	0x07, 0x85, 0xda, 0x1a, 0x2c, 0x52, 0x4c, 0x6d, 0xaf, 0xa8, 0xe8, 0xca, 0x66, 0xde, 0x14, 0x03,
-	0x4d, 0x03, 0x75, 0x60, 0x93, 0x41, 0x31, 0xa1, 0x2b, 0x9b, 0x39, 0x93, 0x7f, 0x57, 0x06, 0xa0,
-	0xb2, 0x50, 0x16, 0xe1, 0xfa, 0x0e, 0x3a, 0x1a, 0x47, 0xf0, 0x01, 0xb3, 0x76, 0x8f, 0x29, 0x22,
-	0x32, 0x44, 0x0c, 0xb4, 0x0f, 0x61, 0x91, 0xe7, 0x5f, 0x4c, 0xea, 0xca, 0x66, 0x76, 0xbb, 0x68,
-	0xc4, 0x88, 0x12, 0xf5, 0x19, 0x2d, 0xe6, 0xaf, 0xa9, 0xcf, 0x5e, 0x6c, 0x2c, 0x98, 0x02, 0x5c,
-	0xf1, 0x20, 0x55, 0xf3, 0x70, 0xef, 0x71, 0x63, 0x27, 0x4a, 0x44, 0x99, 0x24, 0xa2, 0xed, 0xc3,
-	0x4a, 0x60, 0x87, 0xd4, 0x22, 0x88, 0x5a, 0x03, 0x5e, 0x05, 0x5f, 0x34, 0xbb, 0xbd, 0x61, 0x9c,
-	0xef, 0x83, 0x31, 0x55, 0xac, 0x5c, 0x25, 0x1f, 0xc4, 0x8d, 0x95, 0xbf, 0x54, 0x58, 0x92, 0x64,
-	0x7c, 0x06, 0x29, 0x49, 0x2b, 0x5f, 0x30, 0xbb, 0xbd, 0x1e, 0x9f, 0x51, 0xba, 0x8c, 0x3a, 0xf6,
-	0x09, 0xf2, 0xc9, 0x88, 0xc8, 0xf9, 0xc6, 0x31, 0xda, 0x3b, 0x90, 0xee, 0x0d, 0x6c, 0xd7, 0xb7,
-	0x5c, 0x87, 0x67, 0x94, 0xa9, 0x65, 0xcf, 0x5e, 0x6c, 0xa4, 0xea, 0xcc, 0xd6, 0xd8, 0x31, 0x53,
-	0xdc, 0xd9, 0x70, 0xb4, 0xcb, 0xb0, 0x34, 0x40, 0x6e, 0x7f, 0x40, 0x39, 0x2d, 0x49, 0x53, 0x8e,
-	0xb4, 0x4f, 0x40, 0x65, 0x82, 0x28, 0xaa, 0x7c, 0xed, 0x92, 0x21, 0xd4, 0x62, 0x8c, 0xd5, 0x62,
-	0x74, 0xc6, 0x6a, 0xa9, 0xa5, 0xd9, 0xc2, 0x4f, 0xff, 0xd8, 0x50, 0x4c, 0x1e, 0xa1, 0xd5, 0x21,
-	0xef, 0xd9, 0x84, 0x5a, 0x5d, 0x46, 0x1b, 0x5b, 0x7e, 0x91, 0x4f, 0x71, 0x75, 0x96, 0x10, 0x49,
-	0xac, 0x4c, 0x3d, 0xcb, 0xa2, 0x84, 0xc9, 0xd1, 0x36, 0xa1, 0xc0, 0x27, 0xe9, 0xe1, 0xe1, 0xd0,
-	0xa5, 0x16, 0xe7, 0x7d, 0x89, 0xf3, 0xbe, 0xcc, 0xec, 0x75, 0x6e, 0xbe, 0xc7, 0x3a, 0x70, 0x0d,
-	0x32, 0x8e, 0x4d, 0x6d, 0x01, 0x49, 0x71, 0x48, 0x9a, 0x19, 0xb8, 0xf3, 0x5d, 0x58, 0x89, 0x54,
-	0x47, 0x04, 0x24, 0x2d, 0x66, 0x99, 0x98, 0x39, 0xf0, 0x16, 0xac, 0xf9, 0xe8, 0x88, 0x5a, 0xe7,
-	0xd1, 0x19, 0x8e, 0xd6, 0x98, 0xef, 0xe1, 0x74, 0xc4, 0xdb, 0xb0, 0xdc, 0x1b, 0x93, 0x2f, 0xb0,
-	0xc0, 0xb1, 0xf9, 0xc8, 0xca, 0x61, 0x57, 0x21, 0x6d, 0x07, 0x81, 0x00, 0x64, 0x39, 0x20, 0x65,
-	0x07, 0x01, 0x77, 0xdd, 0x84, 0x55, 0x5e, 0x63, 0x88, 0xc8, 0xc8, 0xa3, 0x72, 0x92, 0x1c, 0xc7,
-	0xac, 0x30, 0x87, 0x29, 0xec, 0x1c, 0xfb, 0x16, 0xe4, 0xd1, 0xa1, 0xeb, 0x20, 0xbf, 0x87, 0x04,
-	0x2e, 0xcf, 0x71, 0xb9, 0xb1, 0x91, 0x83, 0x6e, 0x40, 0x21, 0x08, 0x71, 0x80, 0x09, 0x0a, 0x2d,
-	0xdb, 0x71, 0x42, 0x44, 0x48, 0x71, 0x59, 0xcc, 0x37, 0xb6, 0x57, 0x85, 0xb9, 0x52, 0x04, 0x75,
-	0xc7, 0xa6, 0xb6, 0x56, 0x80, 0x24, 0x3d, 0x22, 0x45, 0x45, 0x4f, 0x6e, 0xe6, 0x4c, 0xf6, 0x59,
-	0xf9, 0x3b, 0x01, 0xea, 0x43, 0x4c, 0x91, 0x76, 0x1b, 0x54, 0xd6, 0x26, 0xae, 0xbe, 0xe5, 0x8b,
-	0xf4, 0xdc, 0x76, 0xfb, 0x3e, 0x72, 0xf6, 0x49, 0xbf, 0x73, 0x1c, 0x20, 0x93, 0x83, 0x63, 0x72,
-	0x4a, 0x4c, 0xc9, 0x69, 0x0d, 0x16, 0x43, 0x3c, 0xf2, 0x1d, 0xae, 0xb2, 0x45, 0x53, 0x0c, 0xb4,
-	0x5d, 0x48, 0x47, 0x2a, 0x51, 0xff, 0x4b, 0x25, 0x2b, 0x4c, 0x25, 0x4c, 0xc3, 0xd2, 0x60, 0xa6,
-	0xba, 0x52, 0x2c, 0x35, 0xc8, 0x44, 0x87, 0x97, 0x54, 0xdb, 0xab, 0x09, 0x76, 0x12, 0xa6, 0xbd,
-	0x07, 0xab, 0x51, 0xef, 0x23, 0xf2, 0x84, 0xe2, 0x0a, 0x91, 0x43, 0xb2, 0x37, 0x25, 0x2b, 0x4b,
-	0x1c, 0x40, 0x29, 0x5e, 0xd7, 0x44, 0x56, 0x0d, 0x7e, 0x12, 0x5d, 0x87, 0x0c, 0x71, 0xfb, 0xbe,
-	0x4d, 0x47, 0x21, 0x92, 0xca, 0x9b, 0x18, 0x2a, 0x3f, 0x2b, 0xb0, 0x24, 0x94, 0x1c, 0xe3, 0x4d,
-	0xb9, 0x98, 0xb7, 0xc4, 0x3c, 0xde, 0x92, 0xaf, 0xcf, 0x5b, 0x15, 0x20, 0x4a, 0x86, 0x14, 0x55,
-	0x3d, 0xb9, 0x99, 0xdd, 0xbe, 0x36, 0x3b, 0x91, 0x48, 0xb1, 0xed, 0xf6, 0xe5, 0x46, 0x8d, 0x05,
-	0x55, 0x7e, 0x57, 0x20, 0x13, 0xf9, 0xb5, 0x2a, 0xe4, 0xc7, 0x79, 0x59, 0x8f, 0x3c, 0xbb, 0x2f,
-	0xb5, 0xb3, 0x3e, 0x37, 0xb9, 0x3b, 0x9e, 0xdd, 0x37, 0xb3, 0x32, 0x1f, 0x36, 0xb8, 0xb8, 0x0f,
-	0x89, 0x39, 0x7d, 0x98, 0x6a, 0x7c, 0xf2, 0xf5, 0x1a, 0x3f, 0xd5, 0x22, 0xf5, 0x7c, 0x8b, 0x7e,
-	0x4a, 0x40, 0xba, 0xc5, 0xf7, 0x8e, 0xed, 0xfd, 0x1f, 0x3b, 0xe2, 0x1a, 0x64, 0x02, 0xec, 0x59,
-	0xc2, 0xa3, 0x72, 0x4f, 0x3a, 0xc0, 0x9e, 0x39, 0xd3, 0xf6, 0xc5, 0x37, 0xb4, 0x5d, 0x96, 0xde,
-	0x00, 0x6b, 0xa9, 0xf3, 0xac, 0x85, 0x90, 0x13, 0x54, 0xc8, 0xbb, 0xec, 0x16, 0xe3, 0x80, 0x5f,
-	0x8e, 0xca, 0xec, 0xdd, 0x2b, 0xd2, 0x16, 0x48, 0x53, 0xe2, 0x58, 0x84, 0x38, 0xfa, 0xe5, 0x75,
-	0x5a, 0x9c, 0x27, 0x4b, 0x53, 0xe2, 0x2a, 0xdf, 0x29, 0x00, 0x7b, 0x8c, 0x59, 0x5e, 0x2f, 0xbb,
-	0x85, 0x08, 0x4f, 0xc1, 0x9a, 0x5a, 0xb9, 0x3c, 0xaf, 0x69, 0x72, 0xfd, 0x1c, 0x89, 0xe7, 0x5d,
-	0x87, 0xfc, 0x44, 0x8c, 0x04, 0x8d, 0x93, 0xb9, 0x60, 0x92, 0xe8, 0x72, 0x68, 0x23, 0x6a, 0xe6,
-	0x0e, 0x63, 0xa3, 0xca, 0x2f, 0x0a, 0x64, 0x78, 0x4e, 0xfb, 0x88, 0xda, 0x53, 0x3d, 0x54, 0x5e,
-	0xbf, 0x87, 0xeb, 0x00, 0x62, 0x1a, 0xe2, 0x3e, 0x41, 0x52, 0x59, 0x19, 0x6e, 0x69, 0xbb, 0x4f,
-	0x90, 0xf6, 0x51, 0x44, 0x78, 0xf2, 0xdf, 0x09, 0x97, 0x5b, 0x7a, 0x4c, 0xfb, 0x15, 0x48, 0xf9,
-	0xa3, 0xa1, 0xc5, 0xae, 0x04, 0x55, 0xa8, 0xd5, 0x1f, 0x0d, 0x3b, 0x47, 0xa4, 0xf2, 0x35, 0xa4,
-	0x3a, 0x47, 0xfc, 0x79, 0xc4, 0x24, 0x1a, 0x62, 0x2c, 0xef, 0x64, 0xf1, 0x16, 0x4a, 0x33, 0x03,
-	0xbf, 0x82, 0x34, 0x50, 0xd9, 0xe5, 0x3b, 0x7e, 0xac, 0xb1, 0x6f, 0xcd, 0x78, 0xc5, 0x87, 0x97,
-	0x7c, 0x72, 0xdd, 0xfc, 0x55, 0x81, 0x6c, 0xec, 0x7c, 0xd0, 0x3e, 0x80, 0x4b, 0xb5, 0xbd, 0x83,
-	0xfa, 0x7d, 0xab, 0xb1, 0x63, 0xdd, 0xd9, 0xab, 0xde, 0xb5, 0x1e, 0x34, 0xef, 0x37, 0x0f, 0xbe,
-	0x68, 0x16, 0x16, 0x4a, 0x97, 0x4f, 0x4e, 0x75, 0x2d, 0x86, 0x7d, 0xe0, 0x3f, 0xf6, 0xf1, 0x37,
-	0xbe, 0xb6, 0x05, 0x6b, 0xd3, 0x21, 0xd5, 0x5a, 0x7b, 0xb7, 0xd9, 0x29, 0x28, 0xa5, 0x4b, 0x27,
-	0xa7, 0xfa, 0x6a, 0x2c, 0xa2, 0xda, 0x25, 0xc8, 0xa7, 0xb3, 0x01, 0xf5, 0x83, 0xfd, 0xfd, 0x46,
-	0xa7, 0x90, 0x98, 0x09, 0x90, 0x07, 0xf6, 0x0d, 0x58, 0x9d, 0x0e, 0x68, 0x36, 0xf6, 0x0a, 0xc9,
-	0x92, 0x76, 0x72, 0xaa, 0x2f, 0xc7, 0xd0, 0x4d, 0xd7, 0x2b, 0xa5, 0xbf, 0xfd, 0xbe, 0xbc, 0xf0,
-	0xe3, 0x0f, 0x65, 0x85, 0x55, 0x96, 0x9f, 0x3a, 0x23, 0xb4, 0xf7, 0xe1, 0x4a, 0xbb, 0x71, 0xb7,
-	0xb9, 0xbb, 0x63, 0xed, 0xb7, 0xef, 0x5a, 0x9d, 0x2f, 0x5b, 0xbb, 0xb1, 0xea, 0x56, 0x4e, 0x4e,
-	0xf5, 0xac, 0x2c, 0x69, 0x1e, 0xba, 0x65, 0xee, 0x3e, 0x3c, 0xe8, 0xec, 0x16, 0x14, 0x81, 0x6e,
-	0x85, 0xe8, 0x10, 0x53, 0xc4, 0xd1, 0xb7, 0xe0, 0xea, 0x05, 0xe8, 0xa8, 0xb0, 0xd5, 0x93, 0x53,
-	0x3d, 0xdf, 0x0a, 0x91, 0xd8, 0x3f, 0x3c, 0xc2, 0x80, 0xe2, 0x6c, 0xc4, 0x41, 0xeb, 0xa0, 0x5d,
-	0xdd, 0x2b, 0xe8, 0xa5, 0xc2, 0xc9, 0xa9, 0x9e, 0x1b, 0x1f, 0x86, 0x0c, 0x3f, 0xa9, 0xac, 0xf6,
-	0xf9, 0xb3, 0xb3, 0xb2, 0xf2, 0xfc, 0xac, 0xac, 0xfc, 0x79, 0x56, 0x56, 0x9e, 0xbe, 0x2c, 0x2f,
-	0x3c, 0x7f, 0x59, 0x5e, 0xf8, 0xed, 0x65, 0x79, 0xe1, 0xab, 0x8f, 0xfb, 0x2e, 0x1d, 0x8c, 0xba,
-	0x46, 0x0f, 0x0f, 0xb7, 0xe2, 0x7f, 0x09, 0x26, 0x9f, 0xe2, 0xaf, 0xc9, 0xf9, 0xbf, 0x0b, 0xdd,
-	0x25, 0x6e, 0xbf, 0xfd, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4c, 0x78, 0x43, 0xdf, 0xef, 0x0c,
-	0x00, 0x00,
+	// 1341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcb, 0x6f, 0x1b, 0xd5,
+	0x17, 0xce, 0xc4, 0xe3, 0xd7, 0xb1, 0x9d, 0x38, 0xf7, 0x97, 0xb6, 0x53, 0x37, 0x75, 0x2c, 0xff,
+	0x04, 0xa4, 0x05, 0xec, 0x92, 0x0a, 0x04, 0x12, 0x8b, 0xc6, 0x49, 0xda, 0x5a, 0x4d, 0x1c, 0x6b,
+	0xec, 0x16, 0xc1, 0x66, 0x34, 0xb6, 0x6f, 0xc7, 0xa3, 0xda, 0x33, 0xa3, 0xb9, 0xd7, 0x21, 0xe9,
+	0x5f, 0x80, 0xb2, 0xea, 0x82, 0x6d, 0x56, 0xb0, 0x60, 0xcb, 0x82, 0x2d, 0x62, 0xd9, 0x65, 0x77,
+	0xb0, 0x40, 0x05, 0xa5, 0xfc, 0x21, 0xe8, 0x3e, 0xe6, 0x61, 0x3b, 0xe1, 0x51, 0x55, 0x6c, 0xac,
+	0xb9, 0xe7, 0x7c, 0xe7, 0xde, 0x73, 0xbe, 0xf3, 0xdd, 0x87, 0xe1, 0x9a, 0x39, 0xb1, 0x26, 0x84,
+	0xe2, 0xc9, 0xb8, 0x4e, 0x8f, 0x3d, 0x4c, 0xc4, 0x6f, 0xcd, 0xf3, 0x5d, 0xea, 0xa2, 0xe5, 0xd0,
+	0x59, 0xe3, 0xe6, 0xd2, 0xaa, 0xe5, 0x5a, 0x2e, 0xf7, 0xd5, 0xd9, 0x97, 0x80, 0x95, 0xd6, 0x2d,
+	0xd7, 0xb5, 0x46, 0xb8, 0xce, 0x47, 0xbd, 0xc9, 0xe3, 0x3a, 0xb5, 0xc7, 0x98, 0x50, 0x73, 0xec,
+	0x49, 0xc0, 0x5a, 0xb4, 0x48, 0xdf, 0x3f, 0xf6, 0xa8, 0xcb, 0xa0, 0xee, 0x63, 0xe9, 0xbd, 0x1e,
+	0x79, 0x0f, 0xb1, 0x4f, 0x6c, 0xd7, 0x89, 0x27, 0x51, 0x5a, 0x9f, 0xcd, 0xf0, 0xd0, 0x1c, 0xd9,
+	0x03, 0x93, 0xba, 0xbe, 0x00, 0x54, 0x3f, 0x81, 0x42, 0xdb, 0xf4, 0x69, 0x07, 0xd3, 0xfb, 0xd8,
+	0x1c, 0x60, 0x1f, 0xad, 0x42, 0x92, 0xba, 0xd4, 0x1c, 0x69, 0x4a, 0x45, 0xd9, 0x28, 0xe8, 0x62,
+	0x80, 0x10, 0xa8, 0x43, 0x93, 0x0c, 0xb5, 0xc5, 0x8a, 0xb2, 0x91, 0xd7, 0xf9, 0x77, 0xd5, 0x02,
+	0x95, 0x85, 0xb2, 0x08, 0xdb, 0x19, 0xe0, 0xa3, 0x20, 0x82, 0x0f, 0x98, 0xb5, 0x77, 0x4c, 0x31,
+	0x91, 0x21, 0x62, 0x80, 0x6e, 0x43, 0x92, 0x67, 0xaf, 0x25, 0x2a, 0xca, 0x46, 0x6e, 0xf3, 0x4a,
+	0x2d, 0x22, 0x49, 0x14, 0x57, 0x6b, 0x33, 0x77, 0x43, 0x7d, 0xfe, 0x72, 0x7d, 0x41, 0x17, 0xd8,
+	0xea, 0x13, 0x48, 0x37, 0x46, 0x6e, 0xff, 0x49, 0x73, 0x27, 0xcc, 0x43, 0x89, 0xf2, 0x40, 0x7b,
+	0xb0, 0xec, 0x99, 0x3e, 0x35, 0x08, 0xa6, 0xc6, 0x90, 0x17, 0xc1, 0xd7, 0xcc, 0x6d, 0x96, 0x6b,
+	0x33, 0x2d, 0xa8, 0x4d, 0x95, 0x2a, 0x17, 0x29, 0x78, 0x71, 0x63, 0xf5, 0x0f, 0x15, 0x52, 0x92,
+	0x8a, 0x4f, 0x21, 0x2d, 0x39, 0xe5, 0xeb, 0xe5, 0x36, 0xd7, 0x62, 0x13, 0x4a, 0x4f, 0x6d, 0xdb,
+	0x75, 0x08, 0x76, 0xc8, 0x84, 0xc8, 0xe9, 0x82, 0x10, 0xf4, 0x36, 0x64, 0xfa, 0x43, 0xd3, 0x76,
+	0x0c, 0x7b, 0xc0, 0xf3, 0xc9, 0x36, 0x72, 0x67, 0x2f, 0xd7, 0xd3, 0xdb, 0xcc, 0xd6, 0xdc, 0xd1,
+	0xd3, 0xdc, 0xd9, 0x1c, 0xa0, 0xcb, 0x90, 0x1a, 0x62, 0xdb, 0x1a, 0x52, 0xce, 0x49, 0x42, 0x97,
+	0x23, 0xf4, 0x31, 0xa8, 0x4c, 0x0a, 0x9a, 0xca, 0x97, 0x2e, 0xd5, 0x84, 0x4e, 0x6a, 0x81, 0x4e,
+	0x6a, 0xdd, 0x40, 0x27, 0x8d, 0x0c, 0x5b, 0xf8, 0xd9, 0x6f, 0xeb, 0x8a, 0xce, 0x23, 0x50, 0x03,
+	0x0a, 0x23, 0x93, 0x50, 0xa3, 0xc7, 0x48, 0x63, 0xcb, 0x27, 0xf9, 0x14, 0xda, 0x1c, 0x1d, 0x92,
+	0x55, 0x99, 0x79, 0x8e, 0x05, 0x09, 0xd3, 0x00, 0x6d, 0x40, 0x91, 0xcf, 0xd1, 0x77, 0xc7, 0x63,
+	0x9b, 0x1a, 0x9c, 0xf4, 0x14, 0x27, 0x7d, 0x89, 0xd9, 0xb7, 0xb9, 0xf9, 0x3e, 0xa3, 0xff, 0x1a,
+	0x64, 0x07, 0x26, 0x35, 0x05, 0x24, 0xcd, 0x21, 0x19, 0x66, 0xe0, 0xce, 0x77, 0x60, 0x39, 0x54,
+	0x1c, 0x11, 0x90, 0x8c, 0x98, 0x25, 0x32, 0x73, 0xe0, 0x2d, 0x58, 0x75, 0xf0, 0x11, 0x35, 0x66,
+	0xd1, 0x59, 0x8e, 0x46, 0xcc, 0xf7, 0x68, 0x3a, 0xe2, 0x2d, 0x58, 0xea, 0x07, 0xdc, 0x0b, 0x2c,
+	0x70, 0x6c, 0x21, 0xb4, 0x72, 0xd8, 0x55, 0xc8, 0x98, 0x9e, 0x27, 0x00, 0x39, 0x0e, 0x48, 0x9b,
+	0x9e, 0xc7, 0x5d, 0x37, 0x61, 0x85, 0xd7, 0xe8, 0x63, 0x32, 0x19, 0x51, 0x39, 0x49, 0x9e, 0x63,
+	0x96, 0x99, 0x43, 0x17, 0x76, 0x8e, 0xfd, 0x3f, 0x14, 0xf0, 0xa1, 0x3d, 0xc0, 0x4e, 0x1f, 0x0b,
+	0x5c, 0x81, 0xe3, 0xf2, 0x81, 0x91, 0x83, 0x6e, 0x40, 0xd1, 0xf3, 0x5d, 0xcf, 0x25, 0xd8, 0x37,
+	0xcc, 0xc1, 0xc0, 0xc7, 0x84, 0x68, 0x4b, 0x62, 0xbe, 0xc0, 0xbe, 0x25, 0xcc, 0x55, 0x0d, 0xd4,
+	0x1d, 0x93, 0x9a, 0xa8, 0x08, 0x09, 0x7a, 0x44, 0x34, 0xa5, 0x92, 0xd8, 0xc8, 0xeb, 0xec, 0xb3,
+	0xfa, 0x43, 0x02, 0xd4, 0x47, 0x2e, 0xc5, 0x68, 0x13, 0x54, 0xd6, 0x26, 0xae, 0xbd, 0xa5, 0x73,
+	0xc4, 0xdc, 0xb1, 0x2d, 0x07, 0x0f, 0xf6, 0x89, 0xd5, 0x3d, 0xf6, 0xb0, 0xce, 0xb1, 0x31, 0x31,
+	0x2d, 0x4e, 0x89, 0x69, 0x15, 0x92, 0xbe, 0x3b, 0x71, 0x06, 0x5c, 0x63, 0x49, 0x5d, 0x0c, 0xd0,
+	0x0e, 0x64, 0x42, 0x8d, 0xa8, 0x7f, 0xa3, 0x91, 0x65, 0xa6, 0x11, 0x26, 0x60, 0x69, 0xd0, 0xd3,
+	0x3d, 0x29, 0x95, 0x06, 0x64, 0xc3, 0x33, 0x4b, 0x4b, 0xfe, 0x0b, 0xb5, 0x46, 0x61, 0xe8, 0x5d,
+	0x58, 0x09, 0x3b, 0x1f, 0x52, 0x27, 0xf4, 0x56, 0x0c, 0x1d, 0x92, 0xbb, 0x29, 0x51, 0x19, 0xe2,
+	0xe8, 0x49, 0xf3, 0xb2, 0x22, 0x51, 0x35, 0x99, 0x15, 0xad, 0x41, 0x96, 0xd8, 0x96, 0x63, 0xd2,
+	0x89, 0x8f, 0xa5, 0xee, 0x22, 0x03, 0xf3, 0xe2, 0x23, 0x8a, 0x1d, 0xbe, 0xc1, 0x85, 0xce, 0x22,
+	0x03, 0xaa, 0xc3, 0xff, 0xc2, 0x81, 0x11, 0xcd, 0x22, 0x34, 0x86, 0x42, 0x57, 0x27, 0xf0, 0x54,
+	0x7f, 0x54, 0x20, 0x25, 0xb6, 0x45, 0xac, 0x0b, 0xca, 0xf9, 0x5d, 0x58, 0xbc, 0xa8, 0x0b, 0x89,
+	0xd7, 0xee, 0xc2, 0x1d, 0x80, 0x30, 0x4b, 0xa2, 0xa9, 0x95, 0x04, 0x6f, 0xc3, 0xec, 0x3c, 0x22,
+	0xc1, 0x8e, 0x6d, 0xc9, 0x3d, 0x1f, 0x8b, 0xa9, 0xfe, 0xaa, 0x40, 0x36, 0xf4, 0xa3, 0x3b, 0x50,
+	0x08, 0xb2, 0x32, 0x1e, 0x8f, 0x4c, 0x4b, 0xca, 0x70, 0xed, 0xa2, 0xd4, 0xee, 0x8e, 0x4c, 0x4b,
+	0xcf, 0xc9, 0x6c, 0xd8, 0xe0, 0xfc, 0x9e, 0x2e, 0x5e, 0xd0, 0xd3, 0x29, 0x11, 0x25, 0x5e, 0x4f,
+	0x44, 0x53, 0xed, 0x56, 0x67, 0xda, 0x5d, 0xfd, 0x7e, 0x11, 0x32, 0x6d, 0xbe, 0x0b, 0xcd, 0xd1,
+	0x7f, 0xb0, 0xb7, 0xae, 0x41, 0xd6, 0x73, 0x47, 0x86, 0xf0, 0xa8, 0xdc, 0x93, 0xf1, 0xdc, 0x91,
+	0x3e, 0xd7, 0xf2, 0xe4, 0x9b, 0xd9, 0x78, 0xa9, 0x37, 0xc0, 0x59, 0x7a, 0x96, 0x33, 0x0f, 0xf2,
+	0x82, 0x09, 0x79, 0x23, 0xd6, 0x19, 0x05, 0xec, 0x4b, 0x53, 0xe6, 0xee, 0x6f, 0x91, 0xb5, 0x00,
+	0xea, 0xa9, 0x61, 0x18, 0x20, 0x6e, 0x10, 0x6d, 0xf1, 0x82, 0x00, 0xa1, 0x38, 0x5d, 0xc2, 0xaa,
+	0x5f, 0x2b, 0x00, 0x7b, 0x8c, 0x56, 0x5e, 0x2d, 0xbb, 0xca, 0x08, 0x4f, 0xc0, 0x98, 0x5a, 0xf7,
+	0xfa, 0x05, 0x0d, 0x93, 0xab, 0xe7, 0x49, 0x3c, 0xe9, 0x06, 0x14, 0x22, 0x1d, 0x12, 0x1c, 0xa4,
+	0x32, 0x3f, 0x47, 0x78, 0xc1, 0x74, 0x30, 0xd5, 0xf3, 0x87, 0xb1, 0x51, 0xf5, 0x27, 0x05, 0xb2,
+	0x3c, 0xa3, 0x7d, 0x4c, 0xcd, 0xa9, 0xf6, 0x29, 0xaf, 0xdd, 0xbe, 0xeb, 0x00, 0x62, 0x16, 0x62,
+	0x3f, 0xc5, 0x52, 0x53, 0x59, 0x6e, 0xe9, 0xd8, 0x4f, 0x31, 0xfa, 0x30, 0xe4, 0x3a, 0xf1, 0x97,
+	0x5c, 0xcb, 0x9d, 0x1c, 0x30, 0x7e, 0x05, 0xd2, 0xce, 0x64, 0x6c, 0xb0, 0x4b, 0x45, 0x15, 0x32,
+	0x75, 0x26, 0xe3, 0xee, 0x11, 0xa9, 0xda, 0x90, 0xee, 0x1e, 0xf1, 0xd7, 0x15, 0xd3, 0xa6, 0xef,
+	0xba, 0xf2, 0x56, 0x17, 0x4f, 0xa9, 0x0c, 0x33, 0xf0, 0x4b, 0x0c, 0x81, 0xca, 0xae, 0xef, 0xe0,
+	0xa9, 0xc7, 0xbe, 0xd1, 0xfb, 0xff, 0xec, 0xd9, 0x26, 0x1f, 0x6c, 0x37, 0x7f, 0x56, 0x20, 0x17,
+	0x3b, 0x16, 0xd0, 0x07, 0x70, 0xa9, 0xb1, 0x77, 0xb0, 0xfd, 0xc0, 0x68, 0xee, 0x18, 0x77, 0xf7,
+	0xb6, 0xee, 0x19, 0x0f, 0x5b, 0x0f, 0x5a, 0x07, 0x9f, 0xb5, 0x8a, 0x0b, 0xa5, 0xcb, 0x27, 0xa7,
+	0x15, 0x14, 0xc3, 0x3e, 0x74, 0x9e, 0x38, 0xee, 0x97, 0xec, 0xf8, 0x5d, 0x9d, 0x0e, 0xd9, 0x6a,
+	0x74, 0x76, 0x5b, 0xdd, 0xa2, 0x52, 0xba, 0x74, 0x72, 0x5a, 0x59, 0x89, 0x45, 0x6c, 0xf5, 0x08,
+	0x76, 0xe8, 0x7c, 0xc0, 0xf6, 0xc1, 0xfe, 0x7e, 0xb3, 0x5b, 0x5c, 0x9c, 0x0b, 0x90, 0x87, 0xf4,
+	0x0d, 0x58, 0x99, 0x0e, 0x68, 0x35, 0xf7, 0x8a, 0x89, 0x12, 0x3a, 0x39, 0xad, 0x2c, 0xc5, 0xd0,
+	0x2d, 0x7b, 0x54, 0xca, 0x7c, 0xf5, 0x4d, 0x79, 0xe1, 0xbb, 0x6f, 0xcb, 0x0a, 0xab, 0xac, 0x30,
+	0x75, 0x36, 0xa0, 0xf7, 0xe0, 0x4a, 0xa7, 0x79, 0xaf, 0xb5, 0xbb, 0x63, 0xec, 0x77, 0xee, 0x19,
+	0xdd, 0xcf, 0xdb, 0xbb, 0xb1, 0xea, 0x96, 0x4f, 0x4e, 0x2b, 0x39, 0x59, 0xd2, 0x45, 0xe8, 0xb6,
+	0xbe, 0xfb, 0xe8, 0xa0, 0xbb, 0x5b, 0x54, 0x04, 0xba, 0xed, 0xe3, 0x43, 0x97, 0x62, 0x8e, 0xbe,
+	0x05, 0x57, 0xcf, 0x41, 0x87, 0x85, 0xad, 0x9c, 0x9c, 0x56, 0x0a, 0x6d, 0x1f, 0x8b, 0xad, 0xc3,
+	0x23, 0x6a, 0xa0, 0xcd, 0x47, 0x1c, 0xb4, 0x0f, 0x3a, 0x5b, 0x7b, 0xc5, 0x4a, 0xa9, 0x78, 0x72,
+	0x5a, 0xc9, 0x07, 0x67, 0x20, 0xc3, 0x47, 0x95, 0x35, 0xda, 0xcf, 0xcf, 0xca, 0xca, 0x8b, 0xb3,
+	0xb2, 0xf2, 0xfb, 0x59, 0x59, 0x79, 0xf6, 0xaa, 0xbc, 0xf0, 0xe2, 0x55, 0x79, 0xe1, 0x97, 0x57,
+	0xe5, 0x85, 0x2f, 0x3e, 0xb2, 0x6c, 0x3a, 0x9c, 0xf4, 0x6a, 0x7d, 0x97, 0xfd, 0x03, 0xc1, 0x26,
+	0x35, 0xf9, 0xd3, 0xb5, 0x1e, 0xfd, 0xb5, 0x10, 0x7f, 0x6a, 0x66, 0xfe, 0x6a, 0xf4, 0x52, 0xdc,
+	0x7c, 0xfb, 0xcf, 0x01, 0x00, 0x64, 0xf6, 0x53, 0x18, 0x26, 0x0d, 0x00, 0x00,
 }
 
 func (m *PartSetHeader) Marshal() (dAtA []byte, err error) {
@@ -1433,6 +1451,20 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExtensionSignature) > 0 {
+		i -= len(m.ExtensionSignature)
+		copy(dAtA[i:], m.ExtensionSignature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExtensionSignature)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Extension) > 0 {
+		i -= len(m.Extension)
+		copy(dAtA[i:], m.Extension)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Extension)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Signature) > 0 {
 		i -= len(m.Signature)
 		copy(dAtA[i:], m.Signature)
@@ -2022,6 +2054,14 @@ func (m *Vote) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.Extension)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ExtensionSignature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -3362,6 +3402,74 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extension = append(m.Extension[:0], dAtA[iNdEx:postIndex]...)
+			if m.Extension == nil {
+				m.Extension = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionSignature = append(m.ExtensionSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtensionSignature == nil {
+				m.ExtensionSignature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -179,3 +179,45 @@ func TestHTTPReturnsErrorIfClientIsNotRunning(t *testing.T) {
 	err = c.UnsubscribeAll(context.Background(), "TestHeaderEvents")
 	assert.Error(t, err)
 }
+
+func TestEvents(t *testing.T) {
+	for _, c := range GetClients() {
+		c := c
+		t.Run(reflect.TypeOf(c).String(), func(t *testing.T) {
+			// read from the start of the log to get a cursor
+			res, err := c.Events(context.Background(), "", "", nil, 0)
+			require.NoError(t, err)
+			after := res.Next
+
+			_, _, tx := MakeTxKV()
+			_, err = c.BroadcastTxAsync(context.Background(), tx)
+			require.NoError(t, err)
+
+			// the tx event is read after the cursor, whatever happened since
+			query := fmt.Sprintf("tm.event='Tx' AND tx.hash='%X'", types.Tx(tx).Hash())
+			var items []*ctypes.EventItem
+			for len(items) == 0 {
+				res, err = c.Events(context.Background(), query, after, nil, waitForEventTimeout)
+				require.NoError(t, err)
+				require.NotEmpty(t, res.Next)
+				items, after = res.Items, res.Next
+			}
+			require.Len(t, items, 1)
+			assert.Equal(t, types.EventTx, items[0].Event)
+			// the next cursor skips the events which don't match
+			assert.LessOrEqual(t, items[0].Cursor, after)
+			txe, ok := items[0].Data.(types.EventDataTx)
+			require.True(t, ok)
+			assert.EqualValues(t, tx, txe.Tx)
+
+			// nothing more matches
+			res, err = c.Events(context.Background(), query, after, nil, 10*time.Millisecond)
+			require.NoError(t, err)
+			assert.Empty(t, res.Items)
+
+			// cursors of a previous log were pruned
+			_, err = c.Events(context.Background(), "", "0000000000000001", nil, 0)
+			assert.Error(t, err)
+		})
+	}
+}
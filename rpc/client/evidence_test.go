@@ -132,6 +132,16 @@ func TestBroadcastEvidence_DuplicateVoteEvidence(t *testing.T) {
 		err = client.WaitForHeight(c, status.SyncInfo.LatestBlockHeight+2, nil)
 		require.NoError(t, err)
 
+		evResult, err := c.Evidence(context.Background(), correct.Hash(), correct.Height())
+		require.NoError(t, err)
+		assert.False(t, evResult.Pending)
+		assert.Greater(t, evResult.Height, correct.Height())
+		assert.Equal(t, correct.Hash(), evResult.Evidence.Hash())
+
+		pending, err := c.PendingEvidence(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, pending.Count)
+
 		ed25519pub := pv.Key.PubKey.(ed25519.PubKey)
 		rawpub := ed25519pub.Bytes()
 		result2, err := c.MSMQuery(context.Background(), "/val", rawpub)
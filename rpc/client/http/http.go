@@ -435,6 +435,46 @@ func (c *baseRPCClient) BlockResults(
 	return result, nil
 }
 
+func (c *baseRPCClient) BlocksRange(
+	ctx context.Context,
+	minHeight,
+	maxHeight int64,
+) (*ctypes.ResultBlocksRange, error) {
+	result := new(ctypes.ResultBlocksRange)
+	_, err := c.caller.Call(ctx, "blocks_range",
+		map[string]interface{}{"minHeight": minHeight, "maxHeight": maxHeight},
+		result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) Header(ctx context.Context, height *int64) (*ctypes.ResultHeader, error) {
+	result := new(ctypes.ResultHeader)
+	params := make(map[string]interface{})
+	if height != nil {
+		params["height"] = height
+	}
+	_, err := c.caller.Call(ctx, "header", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) HeaderByHash(ctx context.Context, hash []byte) (*ctypes.ResultHeader, error) {
+	result := new(ctypes.ResultHeader)
+	params := map[string]interface{}{
+		"hash": hash,
+	}
+	_, err := c.caller.Call(ctx, "header_by_hash", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) Commit(ctx context.Context, height *int64) (*ctypes.ResultCommit, error) {
 	result := new(ctypes.ResultCommit)
 	params := make(map[string]interface{})
@@ -489,6 +529,29 @@ func (c *baseRPCClient) TxSearch(
 	return result, nil
 }
 
+func (c *baseRPCClient) Events(
+	ctx context.Context,
+	query,
+	after string,
+	maxItems *int,
+	waitTime time.Duration,
+) (*ctypes.ResultEvents, error) {
+	result := new(ctypes.ResultEvents)
+	params := map[string]interface{}{
+		"query":     query,
+		"after":     after,
+		"wait_time": waitTime,
+	}
+	if maxItems != nil {
+		params["max_items"] = maxItems
+	}
+	_, err := c.caller.Call(ctx, "events", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) Validators(
 	ctx context.Context,
 	height *int64,
@@ -525,12 +588,32 @@ func (c *baseRPCClient) BroadcastEvidence(
 	return result, nil
 }
 
+func (c *baseRPCClient) PendingEvidence(ctx context.Context) (*ctypes.ResultPendingEvidence, error) {
+	result := new(ctypes.ResultPendingEvidence)
+	_, err := c.caller.Call(ctx, "pending_evidence", map[string]interface{}{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) Evidence(ctx context.Context, hash []byte, height int64) (*ctypes.ResultEvidence, error) {
+	result := new(ctypes.ResultEvidence)
+	params := map[string]interface{}{"hash": hash, "height": height}
+	_, err := c.caller.Call(ctx, "evidence", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 //-----------------------------------------------------------------------------
 // WSEvents
 
 var errNotRunning = errors.New("client is not running. Use .Start() method to start")
 
-// WSEvents is a wrapper around WSClient, which implements EventsClient.
+// WSEvents is a wrapper around WSClient, which implements the subscriptions of
+// EventsClient.
 type WSEvents struct {
 	service.BaseService
 	remote   string
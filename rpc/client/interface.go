@@ -22,6 +22,7 @@ implementation.
 
 import (
 	"context"
+	"time"
 
 	"github.com/creatachain/augusteum/libs/bytes"
 	"github.com/creatachain/augusteum/libs/service"
@@ -67,6 +68,8 @@ type SignClient interface {
 	Block(ctx context.Context, height *int64) (*ctypes.ResultBlock, error)
 	BlockByHash(ctx context.Context, hash []byte) (*ctypes.ResultBlock, error)
 	BlockResults(ctx context.Context, height *int64) (*ctypes.ResultBlockResults, error)
+	Header(ctx context.Context, height *int64) (*ctypes.ResultHeader, error)
+	HeaderByHash(ctx context.Context, hash []byte) (*ctypes.ResultHeader, error)
 	Commit(ctx context.Context, height *int64) (*ctypes.ResultCommit, error)
 	Validators(ctx context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error)
 	Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error)
@@ -78,6 +81,7 @@ type SignClient interface {
 type HistoryClient interface {
 	Genesis(context.Context) (*ctypes.ResultGenesis, error)
 	BlockchainInfo(ctx context.Context, minHeight, maxHeight int64) (*ctypes.ResultBlockchainInfo, error)
+	BlocksRange(ctx context.Context, minHeight, maxHeight int64) (*ctypes.ResultBlocksRange, error)
 }
 
 // StatusClient provides access to general chain info.
@@ -110,6 +114,12 @@ type EventsClient interface {
 	Unsubscribe(ctx context.Context, subscriber, query string) error
 	// UnsubscribeAll unsubscribes given subscriber from all the queries.
 	UnsubscribeAll(ctx context.Context, subscriber string) error
+	// Events returns the events of the node's event log matching the query,
+	// added after the cursor. If there are none, it waits for one up to
+	// waitTime. Reading again after the Next cursor of the result misses no
+	// event.
+	Events(ctx context.Context, query, after string, maxItems *int,
+		waitTime time.Duration) (*ctypes.ResultEvents, error)
 }
 
 // MempoolClient shows us data about current mempool state.
@@ -120,9 +130,11 @@ type MempoolClient interface {
 }
 
 // EvidenceClient is used for submitting an evidence of the malicious
-// behaviour.
+// behaviour, and for looking up the evidence known to the node.
 type EvidenceClient interface {
 	BroadcastEvidence(context.Context, types.Evidence) (*ctypes.ResultBroadcastEvidence, error)
+	PendingEvidence(context.Context) (*ctypes.ResultPendingEvidence, error)
+	Evidence(ctx context.Context, hash []byte, height int64) (*ctypes.ResultEvidence, error)
 }
 
 // RemoteClient is a Client, which can also return the remote network address.
@@ -165,6 +165,18 @@ func (c *Local) BlockResults(ctx context.Context, height *int64) (*ctypes.Result
 	return core.BlockResults(c.ctx, height)
 }
 
+func (c *Local) BlocksRange(ctx context.Context, minHeight, maxHeight int64) (*ctypes.ResultBlocksRange, error) {
+	return core.BlocksRange(c.ctx, minHeight, maxHeight)
+}
+
+func (c *Local) Header(ctx context.Context, height *int64) (*ctypes.ResultHeader, error) {
+	return core.Header(c.ctx, height)
+}
+
+func (c *Local) HeaderByHash(ctx context.Context, hash []byte) (*ctypes.ResultHeader, error) {
+	return core.HeaderByHash(c.ctx, hash)
+}
+
 func (c *Local) Commit(ctx context.Context, height *int64) (*ctypes.ResultCommit, error) {
 	return core.Commit(c.ctx, height)
 }
@@ -192,6 +204,24 @@ func (c *Local) BroadcastEvidence(ctx context.Context, ev types.Evidence) (*ctyp
 	return core.BroadcastEvidence(c.ctx, ev)
 }
 
+func (c *Local) PendingEvidence(ctx context.Context) (*ctypes.ResultPendingEvidence, error) {
+	return core.PendingEvidence(c.ctx)
+}
+
+func (c *Local) Evidence(ctx context.Context, hash []byte, height int64) (*ctypes.ResultEvidence, error) {
+	return core.Evidence(c.ctx, hash, height)
+}
+
+func (c *Local) Events(
+	ctx context.Context,
+	query,
+	after string,
+	maxItems *int,
+	waitTime time.Duration,
+) (*ctypes.ResultEvents, error) {
+	return core.Events(c.ctx, query, after, maxItems, waitTime)
+}
+
 func (c *Local) Subscribe(
 	ctx context.Context,
 	subscriber,
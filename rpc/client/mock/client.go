@@ -17,6 +17,7 @@ want to directly call a augusteum node in process, you can use the
 import (
 	"context"
 	"reflect"
+	"time"
 
 	"github.com/creatachain/augusteum/libs/bytes"
 	"github.com/creatachain/augusteum/libs/service"
@@ -47,7 +48,6 @@ var _ client.Client = Client{}
 
 // Call is used by recorders to save a call and response.
 // It can also be used to configure mock responses.
-//
 type Call struct {
 	Name     string
 	Args     interface{}
@@ -166,6 +166,18 @@ func (c Client) BlockByHash(ctx context.Context, hash []byte) (*ctypes.ResultBlo
 	return core.BlockByHash(&rpctypes.Context{}, hash)
 }
 
+func (c Client) BlocksRange(ctx context.Context, minHeight, maxHeight int64) (*ctypes.ResultBlocksRange, error) {
+	return core.BlocksRange(&rpctypes.Context{}, minHeight, maxHeight)
+}
+
+func (c Client) Header(ctx context.Context, height *int64) (*ctypes.ResultHeader, error) {
+	return core.Header(&rpctypes.Context{}, height)
+}
+
+func (c Client) HeaderByHash(ctx context.Context, hash []byte) (*ctypes.ResultHeader, error) {
+	return core.HeaderByHash(&rpctypes.Context{}, hash)
+}
+
 func (c Client) Commit(ctx context.Context, height *int64) (*ctypes.ResultCommit, error) {
 	return core.Commit(&rpctypes.Context{}, height)
 }
@@ -177,3 +189,16 @@ func (c Client) Validators(ctx context.Context, height *int64, page, perPage *in
 func (c Client) BroadcastEvidence(ctx context.Context, ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
 	return core.BroadcastEvidence(&rpctypes.Context{}, ev)
 }
+
+func (c Client) Events(ctx context.Context, query, after string, maxItems *int,
+	waitTime time.Duration) (*ctypes.ResultEvents, error) {
+	return core.Events(&rpctypes.Context{}, query, after, maxItems, waitTime)
+}
+
+func (c Client) PendingEvidence(ctx context.Context) (*ctypes.ResultPendingEvidence, error) {
+	return core.PendingEvidence(&rpctypes.Context{})
+}
+
+func (c Client) Evidence(ctx context.Context, hash []byte, height int64) (*ctypes.ResultEvidence, error) {
+	return core.Evidence(&rpctypes.Context{}, hash, height)
+}
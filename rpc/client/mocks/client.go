@@ -14,6 +14,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	types "github.com/creatachain/augusteum/types"
 )
 
@@ -183,6 +185,29 @@ func (_m *Client) BlockchainInfo(ctx context.Context, minHeight int64, maxHeight
 	return r0, r1
 }
 
+// BlocksRange provides a mock function with given fields: ctx, minHeight, maxHeight
+func (_m *Client) BlocksRange(ctx context.Context, minHeight int64, maxHeight int64) (*coretypes.ResultBlocksRange, error) {
+	ret := _m.Called(ctx, minHeight, maxHeight)
+
+	var r0 *coretypes.ResultBlocksRange
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) *coretypes.ResultBlocksRange); ok {
+		r0 = rf(ctx, minHeight, maxHeight)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultBlocksRange)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, minHeight, maxHeight)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BroadcastEvidence provides a mock function with given fields: _a0, _a1
 func (_m *Client) BroadcastEvidence(_a0 context.Context, _a1 types.Evidence) (*coretypes.ResultBroadcastEvidence, error) {
 	ret := _m.Called(_a0, _a1)
@@ -390,6 +415,52 @@ func (_m *Client) DumpConsensusState(_a0 context.Context) (*coretypes.ResultDump
 	return r0, r1
 }
 
+// Evidence provides a mock function with given fields: ctx, hash, height
+func (_m *Client) Evidence(ctx context.Context, hash []byte, height int64) (*coretypes.ResultEvidence, error) {
+	ret := _m.Called(ctx, hash, height)
+
+	var r0 *coretypes.ResultEvidence
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, int64) *coretypes.ResultEvidence); ok {
+		r0 = rf(ctx, hash, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultEvidence)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, int64) error); ok {
+		r1 = rf(ctx, hash, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Events provides a mock function with given fields: ctx, query, after, maxItems, waitTime
+func (_m *Client) Events(ctx context.Context, query string, after string, maxItems *int, waitTime time.Duration) (*coretypes.ResultEvents, error) {
+	ret := _m.Called(ctx, query, after, maxItems, waitTime)
+
+	var r0 *coretypes.ResultEvents
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *int, time.Duration) *coretypes.ResultEvents); ok {
+		r0 = rf(ctx, query, after, maxItems, waitTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultEvents)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *int, time.Duration) error); ok {
+		r1 = rf(ctx, query, after, maxItems, waitTime)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Genesis provides a mock function with given fields: _a0
 func (_m *Client) Genesis(_a0 context.Context) (*coretypes.ResultGenesis, error) {
 	ret := _m.Called(_a0)
@@ -436,6 +507,52 @@ func (_m *Client) Health(_a0 context.Context) (*coretypes.ResultHealth, error) {
 	return r0, r1
 }
 
+// Header provides a mock function with given fields: ctx, height
+func (_m *Client) Header(ctx context.Context, height *int64) (*coretypes.ResultHeader, error) {
+	ret := _m.Called(ctx, height)
+
+	var r0 *coretypes.ResultHeader
+	if rf, ok := ret.Get(0).(func(context.Context, *int64) *coretypes.ResultHeader); ok {
+		r0 = rf(ctx, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultHeader)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *int64) error); ok {
+		r1 = rf(ctx, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HeaderByHash provides a mock function with given fields: ctx, hash
+func (_m *Client) HeaderByHash(ctx context.Context, hash []byte) (*coretypes.ResultHeader, error) {
+	ret := _m.Called(ctx, hash)
+
+	var r0 *coretypes.ResultHeader
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) *coretypes.ResultHeader); ok {
+		r0 = rf(ctx, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultHeader)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsRunning provides a mock function with given fields:
 func (_m *Client) IsRunning() bool {
 	ret := _m.Called()
@@ -529,6 +646,29 @@ func (_m *Client) OnStop() {
 	_m.Called()
 }
 
+// PendingEvidence provides a mock function with given fields: _a0
+func (_m *Client) PendingEvidence(_a0 context.Context) (*coretypes.ResultPendingEvidence, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *coretypes.ResultPendingEvidence
+	if rf, ok := ret.Get(0).(func(context.Context) *coretypes.ResultPendingEvidence); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultPendingEvidence)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Quit provides a mock function with given fields:
 func (_m *Client) Quit() <-chan struct{} {
 	ret := _m.Called()
@@ -267,6 +267,25 @@ func TestAppCalls(t *testing.T) {
 			assert.EqualValues(0, blockResults.TxsResults[0].Code)
 		}
 
+		// check the headers
+		header, err := c.Header(context.Background(), &apph)
+		require.NoError(err)
+		assert.Equal(block.Block.Header, *header.Header)
+
+		headerByHash, err := c.HeaderByHash(context.Background(), block.BlockID.Hash)
+		require.NoError(err)
+		assert.Equal(header, headerByHash)
+
+		// check the blocks with their results
+		blocks, err := c.BlocksRange(context.Background(), txh, apph)
+		require.NoError(err)
+		assert.True(blocks.LastHeight >= apph)
+		if assert.Equal(2, len(blocks.Blocks)) {
+			assert.Equal(txh, blocks.Blocks[0].Block.Height)
+			assert.Equal(blockResults, blocks.Blocks[0].Results)
+			assert.Equal(block.BlockID, blocks.Blocks[1].BlockID)
+		}
+
 		// check blockchain info, now that we know there is info
 		info, err := c.BlockchainInfo(context.Background(), apph, apph)
 		require.NoError(err)
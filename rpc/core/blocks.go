@@ -80,12 +80,29 @@ func Block(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultBlock, error)
 		return nil, err
 	}
 
+	res, found := loadBlock(height)
+	if found && heightPtr != nil {
+		ctx.MarkCacheable()
+	}
+	return res, nil
+}
+
+// loadBlock returns the block at the given height, and whether it was found.
+func loadBlock(height int64) (*ctypes.ResultBlock, bool) {
+	// committed blocks never change
+	key := cacheKey("block", height)
+	if res, ok := env.responseCache.Get(key); ok {
+		return res.(*ctypes.ResultBlock), true
+	}
+
 	block := env.BlockStore.LoadBlock(height)
 	blockMeta := env.BlockStore.LoadBlockMeta(height)
 	if blockMeta == nil {
-		return &ctypes.ResultBlock{BlockID: types.BlockID{}, Block: block}, nil
+		return &ctypes.ResultBlock{BlockID: types.BlockID{}, Block: block}, false
 	}
-	return &ctypes.ResultBlock{BlockID: blockMeta.BlockID, Block: block}, nil
+	res := &ctypes.ResultBlock{BlockID: blockMeta.BlockID, Block: block}
+	env.responseCache.Add(key, res)
+	return res, true
 }
 
 // BlockByHash gets block by hash.
@@ -109,6 +126,15 @@ func Commit(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultCommit, erro
 		return nil, err
 	}
 
+	// canonical commits never change
+	key := cacheKey("commit", height)
+	if res, ok := env.responseCache.Get(key); ok {
+		if heightPtr != nil {
+			ctx.MarkCacheable()
+		}
+		return res.(*ctypes.ResultCommit), nil
+	}
+
 	blockMeta := env.BlockStore.LoadBlockMeta(height)
 	if blockMeta == nil {
 		return nil, nil
@@ -124,7 +150,12 @@ func Commit(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultCommit, erro
 
 	// Return the canonical commit (comes from the block at height+1)
 	commit := env.BlockStore.LoadBlockCommit(height)
-	return ctypes.NewResultCommit(&header, commit, true), nil
+	res := ctypes.NewResultCommit(&header, commit, true)
+	env.responseCache.Add(key, res)
+	if heightPtr != nil {
+		ctx.MarkCacheable()
+	}
+	return res, nil
 }
 
 // BlockResults gets MSMResults at a given height.
@@ -140,17 +171,131 @@ func BlockResults(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultBlockR
 		return nil, err
 	}
 
+	res, err := loadBlockResults(height)
+	if err != nil {
+		return nil, err
+	}
+	if heightPtr != nil {
+		ctx.MarkCacheable()
+	}
+	return res, nil
+}
+
+// loadBlockResults returns the results of the block at the given height.
+func loadBlockResults(height int64) (*ctypes.ResultBlockResults, error) {
+	// the results of committed blocks never change
+	key := cacheKey("block_results", height)
+	if res, ok := env.responseCache.Get(key); ok {
+		return res.(*ctypes.ResultBlockResults), nil
+	}
+
 	results, err := env.StateStore.LoadMSMResponses(height)
 	if err != nil {
 		return nil, err
 	}
 
-	return &ctypes.ResultBlockResults{
+	res := &ctypes.ResultBlockResults{
 		Height:                height,
 		TxsResults:            results.DeliverTxs,
 		BeginBlockEvents:      results.BeginBlock.Events,
 		EndBlockEvents:        results.EndBlock.Events,
 		ValidatorUpdates:      results.EndBlock.ValidatorUpdates,
 		ConsensusParamUpdates: results.EndBlock.ConsensusParamUpdates,
-	}, nil
+	}
+	env.responseCache.Add(key, res)
+	return res, nil
+}
+
+// BlocksRange gets the blocks for minHeight <= height <= maxHeight, in
+// ascending order, with their results. If minHeight is 0, it starts from the
+// lowest height available; if maxHeight is 0, it ends at the latest height.
+//
+// At most max_blocks_range blocks are returned, up to max_blocks_range_bytes
+// in total, so more blocks may follow the last one returned.
+// More: https://docs.augusteum.com/master/rpc/#/Info/blocks_range
+func BlocksRange(ctx *rpctypes.Context, minHeight, maxHeight int64) (*ctypes.ResultBlocksRange, error) {
+	if minHeight < 0 || maxHeight < 0 {
+		return nil, fmt.Errorf("heights must be non-negative")
+	}
+
+	base, latestHeight := env.BlockStore.Base(), env.BlockStore.Height()
+	// the range is only fixed if both ends are given and committed
+	fixed := minHeight > 0 && maxHeight > 0 && maxHeight <= latestHeight
+	if minHeight == 0 {
+		minHeight = base
+	}
+	if maxHeight == 0 || maxHeight > latestHeight {
+		maxHeight = latestHeight
+	}
+	if minHeight < base {
+		return nil, fmt.Errorf("height %d is not available, lowest height is %d", minHeight, base)
+	}
+	if minHeight > maxHeight {
+		return nil, fmt.Errorf("min height %d can't be greater than max height %d", minHeight, maxHeight)
+	}
+
+	var (
+		blocks = make([]*ctypes.BlockWithResults, 0)
+		size   int64
+	)
+	for height := minHeight; height <= maxHeight && len(blocks) < env.Config.MaxBlocksRange; height++ {
+		blockMeta := env.BlockStore.LoadBlockMeta(height)
+		if blockMeta == nil {
+			return nil, fmt.Errorf("block %d not found", height)
+		}
+		size += int64(blockMeta.BlockSize)
+		if len(blocks) > 0 && size > env.Config.MaxBlocksRangeBytes {
+			break
+		}
+
+		block, _ := loadBlock(height)
+		results, err := loadBlockResults(height)
+		if err != nil {
+			// the results of the latest block may not be saved yet
+			if len(blocks) > 0 {
+				fixed = false
+				break
+			}
+			return nil, err
+		}
+		blocks = append(blocks, &ctypes.BlockWithResults{
+			BlockID: block.BlockID,
+			Block:   block.Block,
+			Results: results,
+		})
+	}
+	if fixed {
+		ctx.MarkCacheable()
+	}
+	return &ctypes.ResultBlocksRange{LastHeight: latestHeight, Blocks: blocks}, nil
+}
+
+// Header gets the header of the block at a given height.
+// If no height is provided, it will fetch the latest header.
+// More: https://docs.augusteum.com/master/rpc/#/Info/header
+func Header(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultHeader, error) {
+	height, err := getHeight(env.BlockStore.Height(), heightPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	blockMeta := env.BlockStore.LoadBlockMeta(height)
+	if blockMeta == nil {
+		return &ctypes.ResultHeader{}, nil
+	}
+	if heightPtr != nil {
+		ctx.MarkCacheable()
+	}
+	return &ctypes.ResultHeader{Header: &blockMeta.Header}, nil
+}
+
+// HeaderByHash gets the header of the block with the given hash.
+// More: https://docs.augusteum.com/master/rpc/#/Info/header_by_hash
+func HeaderByHash(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultHeader, error) {
+	blockMeta := env.BlockStore.LoadBlockMetaByHash(hash)
+	if blockMeta == nil {
+		return &ctypes.ResultHeader{}, nil
+	}
+	ctx.MarkCacheable()
+	return &ctypes.ResultHeader{Header: &blockMeta.Header}, nil
 }
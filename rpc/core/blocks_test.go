@@ -9,6 +9,7 @@ import (
 
 	dbm "github.com/creatachain/tm-db"
 
+	cfg "github.com/creatachain/augusteum/config"
 	msm "github.com/creatachain/augusteum/msm/types"
 	tmstate "github.com/creatachain/augusteum/proto/augusteum/state"
 	ctypes "github.com/creatachain/augusteum/rpc/core/types"
@@ -115,6 +116,25 @@ func TestBlockResults(t *testing.T) {
 	}
 }
 
+func TestBlocksRange(t *testing.T) {
+	env = &Environment{}
+	env.Config = *cfg.DefaultRPCConfig()
+	env.BlockStore = mockBlockStore{height: 100}
+
+	testCases := []struct {
+		min, max int64
+	}{
+		{-1, 10},
+		{1, -10},
+		{20, 10},
+		{101, 0},
+	}
+	for _, tc := range testCases {
+		_, err := BlocksRange(&rpctypes.Context{}, tc.min, tc.max)
+		assert.Error(t, err, "min %d max %d", tc.min, tc.max)
+	}
+}
+
 type mockBlockStore struct {
 	height int64
 }
@@ -126,9 +146,12 @@ func (mockBlockStore) LoadBaseMeta() *types.BlockMeta                    { retur
 func (mockBlockStore) LoadBlockMeta(height int64) *types.BlockMeta       { return nil }
 func (mockBlockStore) LoadBlock(height int64) *types.Block               { return nil }
 func (mockBlockStore) LoadBlockByHash(hash []byte) *types.Block          { return nil }
+func (mockBlockStore) LoadBlockMetaByHash(hash []byte) *types.BlockMeta  { return nil }
 func (mockBlockStore) LoadBlockPart(height int64, index int) *types.Part { return nil }
 func (mockBlockStore) LoadBlockCommit(height int64) *types.Commit        { return nil }
 func (mockBlockStore) LoadSeenCommit(height int64) *types.Commit         { return nil }
 func (mockBlockStore) PruneBlocks(height int64) (uint64, error)          { return 0, nil }
 func (mockBlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
 }
+func (mockBlockStore) SaveExtendedVotes(height int64, votes []*types.Vote) {}
+func (mockBlockStore) LoadExtendedVotes(height int64) []*types.Vote        { return nil }
@@ -0,0 +1,80 @@
+package core
+
+import (
+	"container/list"
+	"fmt"
+
+	tmsync "github.com/creatachain/augusteum/libs/sync"
+)
+
+// responseCache is a LRU cache of the results of the handlers for past
+// heights, which never change. A nil responseCache caches nothing.
+type responseCache struct {
+	mtx   tmsync.Mutex
+	size  int
+	items map[string]*list.Element
+	list  *list.List // most recently used first
+}
+
+type cacheEntry struct {
+	key    string
+	result interface{}
+}
+
+// newResponseCache returns a cache of up to size results, or nil if size is
+// not positive.
+func newResponseCache(size int) *responseCache {
+	if size <= 0 {
+		return nil
+	}
+	return &responseCache{
+		size:  size,
+		items: make(map[string]*list.Element, size),
+		list:  list.New(),
+	}
+}
+
+// cacheKey returns the key of the result of method for the given arguments.
+func cacheKey(method string, args ...interface{}) string {
+	return fmt.Sprint(method, args)
+}
+
+// Get returns the cached result for the key.
+func (c *responseCache) Get(key string) (interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	e, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.list.MoveToFront(e)
+	return e.Value.(*cacheEntry).result, true
+}
+
+// Add caches the result for the key, evicting the least recently used result
+// if the cache is full. The result must not be modified afterwards.
+func (c *responseCache) Add(key string, result interface{}) {
+	if c == nil {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if e, ok := c.items[key]; ok {
+		e.Value.(*cacheEntry).result = result
+		c.list.MoveToFront(e)
+		return
+	}
+	if c.list.Len() >= c.size {
+		oldest := c.list.Back()
+		delete(c.items, oldest.Value.(*cacheEntry).key)
+		c.list.Remove(oldest)
+	}
+	c.items[key] = c.list.PushFront(&cacheEntry{key: key, result: result})
+}
@@ -0,0 +1,83 @@
+package core
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/creatachain/tm-db"
+
+	msm "github.com/creatachain/augusteum/msm/types"
+	tmstate "github.com/creatachain/augusteum/proto/augusteum/state"
+	rpctypes "github.com/creatachain/augusteum/rpc/jsonrpc/types"
+	sm "github.com/creatachain/augusteum/state"
+)
+
+func TestResponseCache(t *testing.T) {
+	assert.Nil(t, newResponseCache(0))
+	var disabled *responseCache
+	disabled.Add("a", 1)
+	_, ok := disabled.Get("a")
+	assert.False(t, ok)
+
+	c := newResponseCache(2)
+	c.Add("a", 1)
+	c.Add("b", 2)
+	// a is now the most recently used
+	v, ok := c.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	c.Add("c", 3)
+	_, ok = c.Get("b")
+	assert.False(t, ok, "the least recently used result should have been evicted")
+	v, ok = c.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, 1, v)
+	v, ok = c.Get("c")
+	require.True(t, ok)
+	assert.Equal(t, 3, v)
+
+	c.Add("c", 4)
+	v, _ = c.Get("c")
+	assert.Equal(t, 4, v)
+	assert.Equal(t, 2, c.list.Len())
+}
+
+func TestBlockResultsCached(t *testing.T) {
+	env = &Environment{
+		StateStore:    sm.NewStore(dbm.NewMemDB()),
+		BlockStore:    mockBlockStore{height: 100},
+		responseCache: newResponseCache(10),
+	}
+
+	results := &tmstate.MSMResponses{
+		EndBlock:   &msm.ResponseEndBlock{},
+		BeginBlock: &msm.ResponseBeginBlock{},
+	}
+	require.NoError(t, env.StateStore.SaveMSMResponses(100, results))
+
+	height := int64(100)
+	ctx := &rpctypes.Context{HTTPReq: httptest.NewRequest(http.MethodGet, "/block_results", nil)}
+	res, err := BlockResults(ctx, &height)
+	require.NoError(t, err)
+	assert.True(t, ctx.Cacheable())
+
+	// served from the cache from now on
+	env.StateStore = sm.NewStore(dbm.NewMemDB())
+	cached, err := BlockResults(&rpctypes.Context{}, &height)
+	require.NoError(t, err)
+	assert.Same(t, res, cached)
+
+	// the latest height is not cacheable by HTTP caches, as it changes
+	ctx = &rpctypes.Context{HTTPReq: httptest.NewRequest(http.MethodGet, "/block_results", nil)}
+	_, err = BlockResults(ctx, nil)
+	require.NoError(t, err)
+	assert.False(t, ctx.Cacheable())
+
+	_, ok := env.responseCache.Get(cacheKey("block_results", height))
+	assert.True(t, ok)
+}
@@ -22,13 +22,26 @@ func Validators(ctx *rpctypes.Context, heightPtr *int64, pagePtr, perPagePtr *in
 		return nil, err
 	}
 
+	// the validators of committed blocks never change
+	perPage := validatePerPage(perPagePtr)
+	requestedPage := 1
+	if pagePtr != nil {
+		requestedPage = *pagePtr
+	}
+	key := cacheKey("validators", height, requestedPage, perPage)
+	if res, ok := env.responseCache.Get(key); ok {
+		if heightPtr != nil {
+			ctx.MarkCacheable()
+		}
+		return res.(*ctypes.ResultValidators), nil
+	}
+
 	validators, err := env.StateStore.LoadValidators(height)
 	if err != nil {
 		return nil, err
 	}
 
 	totalCount := len(validators.Validators)
-	perPage := validatePerPage(perPagePtr)
 	page, err := validatePage(pagePtr, perPage, totalCount)
 	if err != nil {
 		return nil, err
@@ -38,11 +51,18 @@ func Validators(ctx *rpctypes.Context, heightPtr *int64, pagePtr, perPagePtr *in
 
 	v := validators.Validators[skipCount : skipCount+tmmath.MinInt(perPage, totalCount-skipCount)]
 
-	return &ctypes.ResultValidators{
+	res := &ctypes.ResultValidators{
 		BlockHeight: height,
 		Validators:  v,
 		Count:       len(v),
-		Total:       totalCount}, nil
+		Total:       totalCount}
+	if height <= env.BlockStore.Height() {
+		env.responseCache.Add(key, res)
+		if heightPtr != nil {
+			ctx.MarkCacheable()
+		}
+	}
+	return res, nil
 }
 
 // DumpConsensusState dumps consensus state.
@@ -87,6 +107,18 @@ func ConsensusState(ctx *rpctypes.Context) (*ctypes.ResultConsensusState, error)
 	return &ctypes.ResultConsensusState{RoundState: bz}, err
 }
 
+// ConsensusHistory returns the round summaries of the last decided heights,
+// most recent first: how long each step took, when the proposal, the
+// proposal block and +2/3 of the votes arrived, and which votes came late.
+// UNSTABLE
+// More: https://docs.augusteum.com/master/rpc/#/Info/consensus_history
+func ConsensusHistory(ctx *rpctypes.Context, limitPtr *int) (*ctypes.ResultConsensusHistory, error) {
+	// reuse per_page validator
+	limit := validatePerPage(limitPtr)
+
+	return &ctypes.ResultConsensusHistory{Heights: env.ConsensusState.GetHeightSummaries(limit)}, nil
+}
+
 // ConsensusParams gets the consensus parameters at the given block height.
 // If no height is provided, it will fetch the latest consensus params.
 // More: https://docs.augusteum.com/master/rpc/#/Info/consensus_params
@@ -6,7 +6,9 @@ import (
 
 	cfg "github.com/creatachain/augusteum/config"
 	"github.com/creatachain/augusteum/consensus"
+	cstypes "github.com/creatachain/augusteum/consensus/types"
 	"github.com/creatachain/augusteum/crypto"
+	"github.com/creatachain/augusteum/libs/eventlog"
 	"github.com/creatachain/augusteum/libs/log"
 	mempl "github.com/creatachain/augusteum/mempool"
 	"github.com/creatachain/augusteum/p2p"
@@ -34,6 +36,7 @@ var (
 // SetEnvironment sets up the given Environment.
 // It will race if multiple Node call SetEnvironment.
 func SetEnvironment(e *Environment) {
+	e.responseCache = newResponseCache(e.Config.ResponseCacheSize)
 	env = e
 }
 
@@ -46,6 +49,12 @@ type Consensus interface {
 	GetLastHeight() int64
 	GetRoundStateJSON() ([]byte, error)
 	GetRoundStateSimpleJSON() ([]byte, error)
+	GetHeightSummaries(limit int) []cstypes.HeightSummary
+}
+
+type evidencePool interface {
+	sm.EvidencePool
+	Evidence(height int64, hash []byte) (ev types.Evidence, blockHeight int64, err error)
 }
 
 type transport interface {
@@ -73,7 +82,7 @@ type Environment struct {
 	// interfaces defined in types and above
 	StateStore     sm.Store
 	BlockStore     sm.BlockStore
-	EvidencePool   sm.EvidencePool
+	EvidencePool   evidencePool
 	ConsensusState Consensus
 	P2PPeers       peers
 	P2PTransport   transport
@@ -84,11 +93,15 @@ type Environment struct {
 	TxIndexer        txindex.TxIndexer
 	ConsensusReactor *consensus.Reactor
 	EventBus         *types.EventBus // thread safe
+	EventLog         *eventlog.Log   // thread safe, nil if disabled
 	Mempool          mempl.Mempool
 
 	Logger log.Logger
 
 	Config cfg.RPCConfig
+
+	// results for past heights, set by SetEnvironment
+	responseCache *responseCache
 }
 
 //----------------------------------------------
@@ -2,13 +2,16 @@ package core
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/creatachain/augusteum/libs/eventlog"
 	tmpubsub "github.com/creatachain/augusteum/libs/pubsub"
 	tmquery "github.com/creatachain/augusteum/libs/pubsub/query"
 	ctypes "github.com/creatachain/augusteum/rpc/core/types"
 	rpctypes "github.com/creatachain/augusteum/rpc/jsonrpc/types"
+	"github.com/creatachain/augusteum/types"
 )
 
 const (
@@ -110,3 +113,103 @@ func UnsubscribeAll(ctx *rpctypes.Context) (*ctypes.ResultUnsubscribe, error) {
 	}
 	return &ctypes.ResultUnsubscribe{}, nil
 }
+
+// Events returns the events of the event log matching the query, added after
+// the cursor, oldest first. If there are none, it waits for one up to
+// waitTime. Unlike a subscription, no event is missed as long as the next call
+// is made with the cursor returned in Next before the events following it
+// leave the retention window of the log; otherwise an error is returned.
+// More: https://docs.augusteum.com/master/rpc/#/Info/events
+func Events(ctx *rpctypes.Context, query, after string, maxItemsPtr *int, waitTime time.Duration) (
+	*ctypes.ResultEvents, error) {
+	if env.EventLog == nil {
+		return nil, errors.New("event log is disabled")
+	}
+
+	cursor, err := eventlog.ParseCursor(after)
+	if err != nil {
+		return nil, err
+	}
+
+	match := func(*eventlog.Item) (bool, error) { return true, nil }
+	if query != "" {
+		q, err := tmquery.New(query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse query: %w", err)
+		}
+		match = func(item *eventlog.Item) (bool, error) { return q.Matches(item.Events) }
+	}
+
+	// wait no longer than a broadcast_tx_commit, for which the server's write
+	// timeout is adjusted
+	if waitTime < 0 {
+		return nil, fmt.Errorf("wait_time can't be negative, got %v", waitTime)
+	}
+	if waitTime > env.Config.TimeoutBroadcastTxCommit {
+		waitTime = env.Config.TimeoutBroadcastTxCommit
+	}
+	waitCtx, cancel := context.WithTimeout(ctx.Context(), waitTime)
+	defer cancel()
+
+	page, err := env.EventLog.WaitScan(waitCtx, cursor, validatePerPage(maxItemsPtr), match)
+	if err != nil && !errors.Is(err, context.DeadlineExceeded) {
+		return nil, err
+	}
+
+	items := make([]*ctypes.EventItem, len(page.Items))
+	for i, item := range page.Items {
+		var eventType string
+		if eventTypes := item.Events[types.EventTypeKey]; len(eventTypes) > 0 {
+			eventType = eventTypes[0]
+		}
+		items[i] = &ctypes.EventItem{
+			Cursor: item.Cursor.String(),
+			Event:  eventType,
+			Data:   item.Data.(types.TMEventData),
+			Events: item.Events,
+		}
+	}
+
+	info := env.EventLog.Info()
+	return &ctypes.ResultEvents{
+		Items:  items,
+		More:   page.More,
+		Next:   page.Next.String(),
+		Oldest: info.Oldest.String(),
+		Newest: info.Newest.String(),
+	}, nil
+}
+
+// SubscribeEvents subscribes subscriber to the events matching query on the
+// event bus, within the same limits as Subscribe. It's used by the gRPC API,
+// which streams the events itself instead of writing to a WebSocket.
+func SubscribeEvents(ctx context.Context, subscriber, query string) (types.Subscription, error) {
+	if env.EventBus.NumClients() >= env.Config.MaxSubscriptionClients {
+		return nil, fmt.Errorf("max_subscription_clients %d reached", env.Config.MaxSubscriptionClients)
+	} else if env.EventBus.NumClientSubscriptions(subscriber) >= env.Config.MaxSubscriptionsPerClient {
+		return nil, fmt.Errorf("max_subscriptions_per_client %d reached", env.Config.MaxSubscriptionsPerClient)
+	}
+
+	env.Logger.Info("Subscribe to query", "remote", subscriber, "query", query)
+
+	q, err := tmquery.New(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse query: %w", err)
+	}
+
+	subCtx, cancel := context.WithTimeout(ctx, SubscribeTimeout)
+	defer cancel()
+
+	return env.EventBus.Subscribe(subCtx, subscriber, q, subBufferSize)
+}
+
+// UnsubscribeEvents removes the subscription of subscriber to query made with
+// SubscribeEvents.
+func UnsubscribeEvents(subscriber, query string) error {
+	env.Logger.Info("Unsubscribe from query", "remote", subscriber, "query", query)
+	q, err := tmquery.New(query)
+	if err != nil {
+		return fmt.Errorf("failed to parse query: %w", err)
+	}
+	return env.EventBus.Unsubscribe(context.Background(), subscriber, q)
+}
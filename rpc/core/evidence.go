@@ -1,6 +1,7 @@
 package core
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 
@@ -25,3 +26,80 @@ func BroadcastEvidence(ctx *rpctypes.Context, ev types.Evidence) (*ctypes.Result
 	}
 	return &ctypes.ResultBroadcastEvidence{Hash: ev.Hash()}, nil
 }
+
+// PendingEvidence returns the evidence which has been verified by the node and
+// is waiting to be committed.
+// More: https://docs.augusteum.com/master/rpc/#/Info/pending_evidence
+func PendingEvidence(ctx *rpctypes.Context) (*ctypes.ResultPendingEvidence, error) {
+	evidence, size := env.EvidencePool.PendingEvidence(-1)
+	return &ctypes.ResultPendingEvidence{
+		Count:      len(evidence),
+		TotalBytes: size,
+		Evidence:   evidence,
+	}, nil
+}
+
+// Evidence returns the pending or committed evidence of the given height with
+// the given hash. Committed evidence is returned with the height of the block
+// which includes it.
+// More: https://docs.augusteum.com/master/rpc/#/Info/evidence
+func Evidence(ctx *rpctypes.Context, hash []byte, height int64) (*ctypes.ResultEvidence, error) {
+	if len(hash) == 0 {
+		return nil, errors.New("no evidence hash was provided")
+	}
+	if height <= 0 {
+		return nil, fmt.Errorf("height must be greater than 0, but got %d", height)
+	}
+
+	ev, blockHeight, err := env.EvidencePool.Evidence(height, hash)
+	if err != nil {
+		return nil, err
+	}
+	if blockHeight == 0 {
+		return &ctypes.ResultEvidence{Evidence: ev, Pending: true}, nil
+	}
+
+	if blockHeight <= height {
+		// evidence committed before the pool recorded the height of its block
+		// has its own height instead, which is below that of the block. The
+		// evidence is looked up in the blocks up to its max age, past which it
+		// is rarely committed.
+		state, err := env.StateStore.Load()
+		if err != nil {
+			return nil, err
+		}
+		from, to := height+1, height+state.ConsensusParams.Evidence.MaxAgeNumBlocks
+		if base := env.BlockStore.Base(); from < base {
+			from = base
+		}
+		if top := env.BlockStore.Height(); to > top {
+			to = top
+		}
+		for h := from; h <= to; h++ {
+			if ev := committedEvidence(h, hash); ev != nil {
+				return &ctypes.ResultEvidence{Evidence: ev, Height: h}, nil
+			}
+		}
+		return nil, fmt.Errorf("evidence was committed, but is not in the blocks from height %d to %d", from, to)
+	}
+
+	if ev := committedEvidence(blockHeight, hash); ev != nil {
+		return &ctypes.ResultEvidence{Evidence: ev, Height: blockHeight}, nil
+	}
+	return nil, fmt.Errorf("evidence was committed at height %d, but the block is not available", blockHeight)
+}
+
+// committedEvidence returns the evidence with the given hash from the block at
+// the given height, or nil if the block is not available or doesn't include it.
+func committedEvidence(height int64, hash []byte) types.Evidence {
+	block := env.BlockStore.LoadBlock(height)
+	if block == nil {
+		return nil
+	}
+	for _, ev := range block.Evidence.Evidence {
+		if bytes.Equal(ev.Hash(), hash) {
+			return ev
+		}
+	}
+	return nil
+}
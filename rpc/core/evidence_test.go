@@ -0,0 +1,84 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/creatachain/tm-db"
+
+	rpctypes "github.com/creatachain/augusteum/rpc/jsonrpc/types"
+	sm "github.com/creatachain/augusteum/state"
+	"github.com/creatachain/augusteum/types"
+)
+
+type committedEvidencePool struct {
+	sm.EmptyEvidencePool
+	blockHeight int64
+}
+
+func (p committedEvidencePool) Evidence(height int64, hash []byte) (types.Evidence, int64, error) {
+	return nil, p.blockHeight, nil
+}
+
+type evidenceBlockStore struct {
+	mockBlockStore
+	blocks map[int64]*types.Block
+}
+
+func (store evidenceBlockStore) LoadBlock(height int64) *types.Block {
+	return store.blocks[height]
+}
+
+func TestCommittedEvidence(t *testing.T) {
+	const height = 10
+	ev := types.NewMockDuplicateVoteEvidence(height, time.Now(), "test-chain")
+	blocks := make(map[int64]*types.Block)
+	for h := int64(1); h <= 20; h++ {
+		blocks[h] = &types.Block{Header: types.Header{Height: h}}
+	}
+	blocks[13].Evidence.Evidence = types.EvidenceList{ev}
+
+	val, _ := types.RandValidator(false, 10)
+	state, err := sm.MakeGenesisState(&types.GenesisDoc{
+		ChainID:    "test-chain",
+		Validators: []types.GenesisValidator{{PubKey: val.PubKey, Power: val.VotingPower}},
+	})
+	require.NoError(t, err)
+	state.ConsensusParams.Evidence.MaxAgeNumBlocks = 5
+	stateStore := sm.NewStore(dbm.NewMemDB())
+	require.NoError(t, stateStore.Save(state))
+
+	env = &Environment{}
+	env.BlockStore = evidenceBlockStore{mockBlockStore{height: 20}, blocks}
+	env.StateStore = stateStore
+
+	// the pool recorded the height of the block
+	env.EvidencePool = committedEvidencePool{blockHeight: 13}
+	res, err := Evidence(&rpctypes.Context{}, ev.Hash(), height)
+	require.NoError(t, err)
+	assert.EqualValues(t, 13, res.Height)
+	assert.Equal(t, ev.Hash(), res.Evidence.Hash())
+
+	// the pool recorded the height of the evidence, before it recorded that of
+	// the block
+	env.EvidencePool = committedEvidencePool{blockHeight: height}
+	res, err = Evidence(&rpctypes.Context{}, ev.Hash(), height)
+	require.NoError(t, err)
+	assert.EqualValues(t, 13, res.Height)
+	assert.Equal(t, ev.Hash(), res.Evidence.Hash())
+
+	// the block doesn't include the evidence
+	env.EvidencePool = committedEvidencePool{blockHeight: 14}
+	_, err = Evidence(&rpctypes.Context{}, ev.Hash(), height)
+	assert.Error(t, err)
+
+	// the blocks past the max age of the evidence aren't looked up
+	blocks[13].Evidence.Evidence = nil
+	blocks[16].Evidence.Evidence = types.EvidenceList{ev}
+	env.EvidencePool = committedEvidencePool{blockHeight: height}
+	_, err = Evidence(&rpctypes.Context{}, ev.Hash(), height)
+	assert.Error(t, err)
+}
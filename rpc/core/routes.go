@@ -12,6 +12,7 @@ var Routes = map[string]*rpc.RPCFunc{
 	"subscribe":       rpc.NewWSRPCFunc(Subscribe, "query"),
 	"unsubscribe":     rpc.NewWSRPCFunc(Unsubscribe, "query"),
 	"unsubscribe_all": rpc.NewWSRPCFunc(UnsubscribeAll, ""),
+	"events":          rpc.NewRPCFunc(Events, "query,after,max_items,wait_time"),
 
 	// info API
 	"health":               rpc.NewRPCFunc(Health, ""),
@@ -22,6 +23,9 @@ var Routes = map[string]*rpc.RPCFunc{
 	"block":                rpc.NewRPCFunc(Block, "height"),
 	"block_by_hash":        rpc.NewRPCFunc(BlockByHash, "hash"),
 	"block_results":        rpc.NewRPCFunc(BlockResults, "height"),
+	"blocks_range":         rpc.NewRPCFunc(BlocksRange, "minHeight,maxHeight"),
+	"header":               rpc.NewRPCFunc(Header, "height"),
+	"header_by_hash":       rpc.NewRPCFunc(HeaderByHash, "hash"),
 	"commit":               rpc.NewRPCFunc(Commit, "height"),
 	"check_tx":             rpc.NewRPCFunc(CheckTx, "tx"),
 	"tx":                   rpc.NewRPCFunc(Tx, "hash,prove"),
@@ -29,6 +33,7 @@ var Routes = map[string]*rpc.RPCFunc{
 	"validators":           rpc.NewRPCFunc(Validators, "height,page,per_page"),
 	"dump_consensus_state": rpc.NewRPCFunc(DumpConsensusState, ""),
 	"consensus_state":      rpc.NewRPCFunc(ConsensusState, ""),
+	"consensus_history":    rpc.NewRPCFunc(ConsensusHistory, "limit"),
 	"consensus_params":     rpc.NewRPCFunc(ConsensusParams, "height"),
 	"unconfirmed_txs":      rpc.NewRPCFunc(UnconfirmedTxs, "limit"),
 	"num_unconfirmed_txs":  rpc.NewRPCFunc(NumUnconfirmedTxs, ""),
@@ -44,12 +49,24 @@ var Routes = map[string]*rpc.RPCFunc{
 
 	// evidence API
 	"broadcast_evidence": rpc.NewRPCFunc(BroadcastEvidence, "evidence"),
+	"pending_evidence":   rpc.NewRPCFunc(PendingEvidence, ""),
+	"evidence":           rpc.NewRPCFunc(Evidence, "hash,height"),
 }
 
 // AddUnsafeRoutes adds unsafe routes.
 func AddUnsafeRoutes() {
+	addUnsafeRoutes(Routes)
+}
+
+func addUnsafeRoutes(routes map[string]*rpc.RPCFunc) {
 	// control API
-	Routes["dial_seeds"] = rpc.NewRPCFunc(UnsafeDialSeeds, "seeds")
-	Routes["dial_peers"] = rpc.NewRPCFunc(UnsafeDialPeers, "peers,persistent,unconditional,private")
-	Routes["unsafe_flush_mempool"] = rpc.NewRPCFunc(UnsafeFlushMempool, "")
+	routes["dial_seeds"] = rpc.NewRPCFunc(UnsafeDialSeeds, "seeds")
+	routes["dial_peers"] = rpc.NewRPCFunc(UnsafeDialPeers, "peers,persistent,unconditional,private")
+	routes["unsafe_flush_mempool"] = rpc.NewRPCFunc(UnsafeFlushMempool, "")
+}
+
+// Schema returns the OpenAPI schema of the Routes, generated from their Go
+// types, as served at rpc.SchemaPath.
+func Schema() map[string]interface{} {
+	return rpc.Schema(Routes)
 }
@@ -0,0 +1,98 @@
+package core
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rpc "github.com/creatachain/augusteum/rpc/jsonrpc/server"
+)
+
+var (
+	rePath    = regexp.MustCompile(`^   /(\w+):$`)
+	reInQuery = regexp.MustCompile(`^\s+- in: query$`)
+	reParam   = regexp.MustCompile(`^\s+name: (\w+)$`)
+	reRef     = regexp.MustCompile(`"#/components/schemas/([^"]+)"`)
+)
+
+// documentedRoutes returns the query parameters of the paths documented in
+// openapi.yaml, by route.
+func documentedRoutes(t *testing.T) map[string][]string {
+	f, err := os.Open("../openapi/openapi.yaml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	routes := make(map[string][]string)
+	var (
+		route   string
+		inQuery bool
+	)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "components:" {
+			break
+		}
+		if m := rePath.FindStringSubmatch(line); m != nil {
+			route = m[1]
+			routes[route] = []string{}
+			continue
+		}
+		if m := reParam.FindStringSubmatch(line); m != nil && inQuery && route != "" {
+			routes[route] = append(routes[route], m[1])
+		}
+		inQuery = reInQuery.MatchString(line)
+	}
+	require.NoError(t, scanner.Err())
+	return routes
+}
+
+func TestRoutesMatchSchema(t *testing.T) {
+	// the global routes are left alone
+	routes := make(map[string]*rpc.RPCFunc, len(Routes))
+	for name, f := range Routes {
+		routes[name] = f
+	}
+	addUnsafeRoutes(routes)
+
+	schema := rpc.Schema(routes)
+	paths := schema["paths"].(map[string]interface{})
+	require.Len(t, paths, len(routes))
+
+	documented := documentedRoutes(t)
+	for name := range documented {
+		assert.Contains(t, routes, name, "openapi.yaml documents the unknown route %s", name)
+	}
+
+	for name := range routes {
+		require.Contains(t, paths, "/"+name)
+		operation := paths["/"+name].(map[string]interface{})["get"].(map[string]interface{})
+		params := []string{}
+		for _, param := range operation["parameters"].([]interface{}) {
+			params = append(params, param.(map[string]interface{})["name"].(string))
+		}
+		sort.Strings(params)
+
+		docParams, ok := documented[name]
+		if !assert.True(t, ok, "route %s is not documented in openapi.yaml", name) {
+			continue
+		}
+		sort.Strings(docParams)
+		assert.Equal(t, params, docParams, "parameters of route %s", name)
+	}
+
+	// the references resolve
+	bz, err := json.Marshal(schema)
+	require.NoError(t, err)
+	defs := schema["components"].(map[string]interface{})["schemas"].(map[string]interface{})
+	for _, ref := range reRef.FindAllSubmatch(bz, -1) {
+		assert.Contains(t, defs, string(ref[1]))
+	}
+	assert.Contains(t, defs, "rpc.core.types.ResultBlock")
+}
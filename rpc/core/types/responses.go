@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"time"
 
+	cstypes "github.com/creatachain/augusteum/consensus/types"
 	"github.com/creatachain/augusteum/crypto"
 	"github.com/creatachain/augusteum/libs/bytes"
 	msm "github.com/creatachain/augusteum/msm/types"
@@ -29,6 +30,24 @@ type ResultBlock struct {
 	Block   *types.Block  `json:"block"`
 }
 
+// Header of a block
+type ResultHeader struct {
+	Header *types.Header `json:"header"`
+}
+
+// A block of a range, with its results
+type BlockWithResults struct {
+	BlockID types.BlockID       `json:"block_id"`
+	Block   *types.Block        `json:"block"`
+	Results *ResultBlockResults `json:"results"`
+}
+
+// Range of blocks, in ascending order
+type ResultBlocksRange struct {
+	LastHeight int64               `json:"last_height"`
+	Blocks     []*BlockWithResults `json:"blocks"`
+}
+
 // Commit and Header
 type ResultCommit struct {
 	types.SignedHeader `json:"signed_header"`
@@ -156,6 +175,11 @@ type ResultConsensusState struct {
 	RoundState json.RawMessage `json:"round_state"`
 }
 
+// UNSTABLE
+type ResultConsensusHistory struct {
+	Heights []cstypes.HeightSummary `json:"heights"`
+}
+
 // CheckTx result
 type ResultBroadcastTx struct {
 	Code      uint32         `json:"code"`
@@ -218,6 +242,21 @@ type ResultBroadcastEvidence struct {
 	Hash []byte `json:"hash"`
 }
 
+// List of the evidence waiting to be committed
+type ResultPendingEvidence struct {
+	Count      int              `json:"n_evidence"`
+	TotalBytes int64            `json:"total_bytes"`
+	Evidence   []types.Evidence `json:"evidence"`
+}
+
+// Pending or committed evidence. Height is the height of the block which
+// includes the evidence, or zero while it is pending.
+type ResultEvidence struct {
+	Evidence types.Evidence `json:"evidence"`
+	Height   int64          `json:"height"`
+	Pending  bool           `json:"pending"`
+}
+
 // empty results
 type (
 	ResultUnsafeFlushMempool struct{}
@@ -227,6 +266,26 @@ type (
 	ResultHealth             struct{}
 )
 
+// An event of the event log. Cursor identifies it in the log, and Event is
+// its type.
+type EventItem struct {
+	Cursor string              `json:"cursor"`
+	Event  string              `json:"event"`
+	Data   types.TMEventData   `json:"data"`
+	Events map[string][]string `json:"events"`
+}
+
+// Events read from the event log. Next is the cursor to read the following
+// events after. Oldest and Newest are the cursors of the oldest and newest
+// events retained by the log.
+type ResultEvents struct {
+	Items  []*EventItem `json:"items"`
+	More   bool         `json:"more"`
+	Next   string       `json:"next"`
+	Oldest string       `json:"oldest"`
+	Newest string       `json:"newest"`
+}
+
 // Event data from a subscription
 type ResultEvent struct {
 	Query  string              `json:"query"`
@@ -14,12 +14,13 @@ type Config struct {
 	MaxOpenConnections int
 }
 
-// StartGRPCServer starts a new gRPC BroadcastAPIServer using the given
-// net.Listener.
+// StartGRPCServer starts a new gRPC server serving the BroadcastAPI and the
+// QueryAPI using the given net.Listener.
 // NOTE: This function blocks - you may want to call it in a go-routine.
 func StartGRPCServer(ln net.Listener) error {
 	grpcServer := grpc.NewServer()
 	RegisterBroadcastAPIServer(grpcServer, &broadcastAPI{})
+	RegisterQueryAPIServer(grpcServer, &queryAPI{})
 	return grpcServer.Serve(ln)
 }
 
@@ -33,6 +34,16 @@ func StartGRPCClient(protoAddr string) BroadcastAPIClient {
 	return NewBroadcastAPIClient(conn)
 }
 
+// StartGRPCQueryClient dials the gRPC server using protoAddr and returns a new
+// QueryAPIClient.
+func StartGRPCQueryClient(protoAddr string) QueryAPIClient {
+	conn, err := grpc.Dial(protoAddr, grpc.WithInsecure(), grpc.WithContextDialer(dialerFunc))
+	if err != nil {
+		panic(err)
+	}
+	return NewQueryAPIClient(conn)
+}
+
 func dialerFunc(ctx context.Context, addr string) (net.Conn, error) {
 	return tmnet.Connect(addr)
 }
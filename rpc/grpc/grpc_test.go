@@ -2,14 +2,18 @@ package coregrpc_test
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	tmjson "github.com/creatachain/augusteum/libs/json"
 	"github.com/creatachain/augusteum/msm/example/kvstore"
 	core_grpc "github.com/creatachain/augusteum/rpc/grpc"
 	rpctest "github.com/creatachain/augusteum/rpc/test"
+	"github.com/creatachain/augusteum/types"
 )
 
 func TestMain(m *testing.M) {
@@ -33,3 +37,87 @@ func TestBroadcastTx(t *testing.T) {
 	require.EqualValues(t, 0, res.CheckTx.Code)
 	require.EqualValues(t, 0, res.DeliverTx.Code)
 }
+
+func TestQueryAPI(t *testing.T) {
+	client := rpctest.GetGRPCQueryClient()
+	ctx := context.Background()
+
+	tx := []byte("grpc=query")
+	bres, err := rpctest.GetGRPCClient().BroadcastTx(ctx, &core_grpc.RequestBroadcastTx{Tx: tx})
+	require.NoError(t, err)
+	require.EqualValues(t, 0, bres.DeliverTx.Code)
+
+	status, err := client.Status(ctx, &core_grpc.RequestStatus{})
+	require.NoError(t, err)
+	assert.Equal(t, rpctest.GetConfig().ChainID(), status.NodeInfo.Network)
+	height := status.SyncInfo.LatestBlockHeight
+	require.Greater(t, height, int64(0))
+
+	block, err := client.Block(ctx, &core_grpc.RequestBlock{Height: height})
+	require.NoError(t, err)
+	assert.Equal(t, height, block.Block.Header.Height)
+
+	byHash, err := client.BlockByHash(ctx, &core_grpc.RequestBlockByHash{Hash: block.BlockID.Hash})
+	require.NoError(t, err)
+	assert.Equal(t, block.Block.Header, byHash.Block.Header)
+
+	_, err = client.BlockResults(ctx, &core_grpc.RequestBlockResults{})
+	require.NoError(t, err)
+
+	commit, err := client.Commit(ctx, &core_grpc.RequestCommit{Height: height})
+	require.NoError(t, err)
+	assert.Equal(t, block.BlockID.Hash, commit.SignedHeader.Commit.BlockID.Hash)
+
+	txRes, err := client.Tx(ctx, &core_grpc.RequestTx{Hash: types.Tx(tx).Hash(), Prove: true})
+	require.NoError(t, err)
+	assert.Equal(t, tx, txRes.Tx)
+	assert.NotNil(t, txRes.Proof)
+
+	search, err := client.TxSearch(ctx, &core_grpc.RequestTxSearch{Query: fmt.Sprintf("tx.height=%d", txRes.Height)})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, search.TotalCount)
+
+	vals, err := client.Validators(ctx, &core_grpc.RequestValidators{})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, vals.Total)
+	assert.Equal(t, status.ValidatorInfo.Address, vals.Validators[0].Address)
+
+	params, err := client.ConsensusParams(ctx, &core_grpc.RequestConsensusParams{Height: height})
+	require.NoError(t, err)
+	assert.NotNil(t, params.ConsensusParams.Block)
+
+	_, err = client.UnconfirmedTxs(ctx, &core_grpc.RequestUnconfirmedTxs{Limit: 10})
+	require.NoError(t, err)
+	_, err = client.NumUnconfirmedTxs(ctx, &core_grpc.RequestNumUnconfirmedTxs{})
+	require.NoError(t, err)
+
+	_, err = client.Block(ctx, &core_grpc.RequestBlock{Height: height + 1000})
+	assert.Error(t, err)
+}
+
+func TestSubscribeEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := rpctest.GetGRPCQueryClient().SubscribeEvents(ctx,
+		&core_grpc.RequestSubscribeEvents{Query: types.EventQueryNewBlock.String()})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		res, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, types.EventQueryNewBlock.String(), res.Query)
+
+		var data types.TMEventData
+		require.NoError(t, tmjson.Unmarshal(res.Data, &data))
+		assert.IsType(t, types.EventDataNewBlock{}, data)
+		assert.NotEmpty(t, res.Events)
+	}
+
+	// errors of a stream are returned on receiving
+	stream, err = rpctest.GetGRPCQueryClient().SubscribeEvents(ctx,
+		&core_grpc.RequestSubscribeEvents{Query: "invalid query"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Error(t, err)
+}
@@ -0,0 +1,301 @@
+package coregrpc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"google.golang.org/grpc/peer"
+
+	cryptoenc "github.com/creatachain/augusteum/crypto/encoding"
+	tmjson "github.com/creatachain/augusteum/libs/json"
+	tmpubsub "github.com/creatachain/augusteum/libs/pubsub"
+	msm "github.com/creatachain/augusteum/msm/types"
+	tmproto "github.com/creatachain/augusteum/proto/augusteum/types"
+	core "github.com/creatachain/augusteum/rpc/core"
+	ctypes "github.com/creatachain/augusteum/rpc/core/types"
+	rpctypes "github.com/creatachain/augusteum/rpc/jsonrpc/types"
+)
+
+// queryAPI serves the queries of rpc/core over gRPC, sharing its environment.
+type queryAPI struct {
+}
+
+func (qapi *queryAPI) Status(ctx context.Context, req *RequestStatus) (*ResponseStatus, error) {
+	res, err := core.Status(&rpctypes.Context{})
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := cryptoenc.PubKeyToProto(res.ValidatorInfo.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResponseStatus{
+		NodeInfo: res.NodeInfo.ToProto(),
+		SyncInfo: &SyncInfo{
+			LatestBlockHash:     res.SyncInfo.LatestBlockHash,
+			LatestAppHash:       res.SyncInfo.LatestAppHash,
+			LatestBlockHeight:   res.SyncInfo.LatestBlockHeight,
+			LatestBlockTime:     res.SyncInfo.LatestBlockTime,
+			EarliestBlockHash:   res.SyncInfo.EarliestBlockHash,
+			EarliestAppHash:     res.SyncInfo.EarliestAppHash,
+			EarliestBlockHeight: res.SyncInfo.EarliestBlockHeight,
+			EarliestBlockTime:   res.SyncInfo.EarliestBlockTime,
+			CatchingUp:          res.SyncInfo.CatchingUp,
+		},
+		ValidatorInfo: &ValidatorInfo{
+			Address:     res.ValidatorInfo.Address,
+			PubKey:      &pubKey,
+			VotingPower: res.ValidatorInfo.VotingPower,
+		},
+	}, nil
+}
+
+func (qapi *queryAPI) Block(ctx context.Context, req *RequestBlock) (*ResponseBlock, error) {
+	res, err := core.Block(&rpctypes.Context{}, heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+	return toResponseBlock(res)
+}
+
+func (qapi *queryAPI) BlockByHash(ctx context.Context, req *RequestBlockByHash) (*ResponseBlock, error) {
+	res, err := core.BlockByHash(&rpctypes.Context{}, req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	return toResponseBlock(res)
+}
+
+func (qapi *queryAPI) BlockResults(ctx context.Context, req *RequestBlockResults) (*ResponseBlockResults, error) {
+	res, err := core.BlockResults(&rpctypes.Context{}, heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+
+	validatorUpdates := make([]*msm.ValidatorUpdate, len(res.ValidatorUpdates))
+	for i := range res.ValidatorUpdates {
+		validatorUpdates[i] = &res.ValidatorUpdates[i]
+	}
+
+	return &ResponseBlockResults{
+		Height:                res.Height,
+		TxsResults:            res.TxsResults,
+		BeginBlockEvents:      eventPtrs(res.BeginBlockEvents),
+		EndBlockEvents:        eventPtrs(res.EndBlockEvents),
+		ValidatorUpdates:      validatorUpdates,
+		ConsensusParamUpdates: res.ConsensusParamUpdates,
+	}, nil
+}
+
+func (qapi *queryAPI) Commit(ctx context.Context, req *RequestCommit) (*ResponseCommit, error) {
+	res, err := core.Commit(&rpctypes.Context{}, heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseCommit{
+		SignedHeader: res.SignedHeader.ToProto(),
+		Canonical:    res.CanonicalCommit,
+	}, nil
+}
+
+func (qapi *queryAPI) Tx(ctx context.Context, req *RequestTx) (*ResponseTx, error) {
+	res, err := core.Tx(&rpctypes.Context{}, req.Hash, req.Prove)
+	if err != nil {
+		return nil, err
+	}
+	return toResponseTx(res, req.Prove), nil
+}
+
+func (qapi *queryAPI) TxSearch(ctx context.Context, req *RequestTxSearch) (*ResponseTxSearch, error) {
+	res, err := core.TxSearch(&rpctypes.Context{}, req.Query, req.Prove, intPtr(req.Page), intPtr(req.PerPage),
+		req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]*ResponseTx, len(res.Txs))
+	for i, tx := range res.Txs {
+		txs[i] = toResponseTx(tx, req.Prove)
+	}
+	return &ResponseTxSearch{Txs: txs, TotalCount: int32(res.TotalCount)}, nil
+}
+
+func (qapi *queryAPI) Validators(ctx context.Context, req *RequestValidators) (*ResponseValidators, error) {
+	res, err := core.Validators(&rpctypes.Context{}, heightPtr(req.Height), intPtr(req.Page), intPtr(req.PerPage))
+	if err != nil {
+		return nil, err
+	}
+
+	vals := make([]*tmproto.Validator, len(res.Validators))
+	for i, val := range res.Validators {
+		vals[i], err = val.ToProto()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &ResponseValidators{
+		BlockHeight: res.BlockHeight,
+		Validators:  vals,
+		Count:       int32(res.Count),
+		Total:       int32(res.Total),
+	}, nil
+}
+
+func (qapi *queryAPI) ConsensusParams(ctx context.Context, req *RequestConsensusParams) (
+	*ResponseConsensusParams, error) {
+	res, err := core.ConsensusParams(&rpctypes.Context{}, heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseConsensusParams{
+		BlockHeight:     res.BlockHeight,
+		ConsensusParams: &res.ConsensusParams,
+	}, nil
+}
+
+func (qapi *queryAPI) UnconfirmedTxs(ctx context.Context, req *RequestUnconfirmedTxs) (
+	*ResponseUnconfirmedTxs, error) {
+	res, err := core.UnconfirmedTxs(&rpctypes.Context{}, intPtr(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	return toResponseUnconfirmedTxs(res), nil
+}
+
+func (qapi *queryAPI) NumUnconfirmedTxs(ctx context.Context, req *RequestNumUnconfirmedTxs) (
+	*ResponseUnconfirmedTxs, error) {
+	res, err := core.NumUnconfirmedTxs(&rpctypes.Context{})
+	if err != nil {
+		return nil, err
+	}
+	return toResponseUnconfirmedTxs(res), nil
+}
+
+// SubscribeEvents streams the events matching the query until the client goes
+// away or the subscription is cancelled. The subscriber is the address of the
+// client, so that the subscription limits apply per connection.
+func (qapi *queryAPI) SubscribeEvents(req *RequestSubscribeEvents, stream QueryAPI_SubscribeEventsServer) error {
+	ctx := stream.Context()
+	subscriber := "grpc"
+	if p, ok := peer.FromContext(ctx); ok {
+		subscriber = p.Addr.String()
+	}
+
+	sub, err := core.SubscribeEvents(ctx, subscriber, req.Query)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// the subscription may be gone already if Augusteum is stopping
+		_ = core.UnsubscribeEvents(subscriber, req.Query)
+	}()
+
+	for {
+		select {
+		case msg := <-sub.Out():
+			res, err := toResponseEvent(req.Query, msg)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+		case <-sub.Cancelled():
+			reason := "Augusteum exited"
+			if sub.Err() != nil {
+				reason = sub.Err().Error()
+			}
+			return fmt.Errorf("subscription was cancelled (reason: %s)", reason)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+//-----------------------------------------------------------------------------
+
+// heightPtr returns nil for a height of 0, which stands for the latest height.
+func heightPtr(height int64) *int64 {
+	if height == 0 {
+		return nil
+	}
+	return &height
+}
+
+// intPtr returns nil for 0, which stands for the default value.
+func intPtr(i int32) *int {
+	if i == 0 {
+		return nil
+	}
+	v := int(i)
+	return &v
+}
+
+func eventPtrs(events []msm.Event) []*msm.Event {
+	ptrs := make([]*msm.Event, len(events))
+	for i := range events {
+		ptrs[i] = &events[i]
+	}
+	return ptrs
+}
+
+func toResponseBlock(res *ctypes.ResultBlock) (*ResponseBlock, error) {
+	if res.Block == nil {
+		return &ResponseBlock{}, nil
+	}
+	block, err := res.Block.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	blockID := res.BlockID.ToProto()
+	return &ResponseBlock{BlockID: &blockID, Block: block}, nil
+}
+
+func toResponseTx(res *ctypes.ResultTx, prove bool) *ResponseTx {
+	tx := &ResponseTx{
+		Hash:     res.Hash,
+		Height:   res.Height,
+		Index:    res.Index,
+		TxResult: &res.TxResult,
+		Tx:       res.Tx,
+	}
+	if prove {
+		proof := res.Proof.ToProto()
+		tx.Proof = &proof
+	}
+	return tx
+}
+
+func toResponseUnconfirmedTxs(res *ctypes.ResultUnconfirmedTxs) *ResponseUnconfirmedTxs {
+	txs := make([][]byte, len(res.Txs))
+	for i, tx := range res.Txs {
+		txs[i] = tx
+	}
+	return &ResponseUnconfirmedTxs{
+		Count:      int32(res.Count),
+		Total:      int32(res.Total),
+		TotalBytes: res.TotalBytes,
+		Txs:        txs,
+	}
+}
+
+func toResponseEvent(query string, msg tmpubsub.Message) (*ResponseEvent, error) {
+	data, err := tmjson.Marshal(msg.Data())
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(msg.Events()))
+	for key := range msg.Events() {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	events := make([]*EventAttributes, len(keys))
+	for i, key := range keys {
+		events[i] = &EventAttributes{Key: key, Values: msg.Events()[key]}
+	}
+	return &ResponseEvent{Query: query, Data: data, Events: events}, nil
+}
@@ -0,0 +1,218 @@
+package server
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	tmsync "github.com/creatachain/augusteum/libs/sync"
+	types "github.com/creatachain/augusteum/rpc/jsonrpc/types"
+)
+
+// APIKeyHeader is the HTTP header carrying the API key of a client, for the
+// HTTP and WebSocket handlers alike.
+const APIKeyHeader = "X-API-Key"
+
+// bucketSweepInterval is how often the buckets which have refilled are
+// dropped, as they are indistinguishable from new ones.
+const bucketSweepInterval = time.Minute
+
+var (
+	// ErrMethodNotAllowed is returned for a method the access control denies.
+	ErrMethodNotAllowed = errors.New("method not allowed")
+	// ErrRateLimited is returned when a client has exhausted its quota.
+	ErrRateLimited = errors.New("rate limit exceeded")
+	// ErrInvalidAPIKey is returned for an unknown API key.
+	ErrInvalidAPIKey = errors.New("invalid API key")
+)
+
+// Quota is a token bucket: a client can spend up to Burst tokens at once,
+// and is given Rate tokens per second. A zero Rate means no limit.
+type Quota struct {
+	Rate  float64
+	Burst float64
+}
+
+// AccessConfig configures AccessControl.
+type AccessConfig struct {
+	// Quota of every client IP without an API key.
+	PerIP Quota
+	// Quotas of the API keys, which clients send in the APIKeyHeader header.
+	// Each key has a single bucket, whatever the IP of the client.
+	APIKeys map[string]Quota
+	// Number of tokens a call to a method costs; 1 if not listed.
+	MethodCosts map[string]float64
+	// If not empty, only these methods can be called.
+	AllowedMethods []string
+	// Methods which can't be called.
+	DeniedMethods []string
+}
+
+// AccessControl rate limits the calls of the clients of the RPC server with
+// per-client token buckets, and restricts the methods they can call.
+type AccessControl struct {
+	config  AccessConfig
+	allowed map[string]bool
+	denied  map[string]bool
+
+	now func() time.Time
+
+	mtx       tmsync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewAccessControl returns an AccessControl enforcing the given config.
+func NewAccessControl(config AccessConfig) (*AccessControl, error) {
+	if err := config.PerIP.validate(); err != nil {
+		return nil, fmt.Errorf("per IP quota: %w", err)
+	}
+	for key, quota := range config.APIKeys {
+		if key == "" {
+			return nil, errors.New("empty API key")
+		}
+		if err := quota.validate(); err != nil {
+			// the key is a secret, so it is only referred to by its hash
+			hash := sha256.Sum256([]byte(key))
+			return nil, fmt.Errorf("quota of API key with SHA-256 %X...: %w", hash[:4], err)
+		}
+	}
+	for method, cost := range config.MethodCosts {
+		if cost < 0 {
+			return nil, fmt.Errorf("negative cost %v of method %s", cost, method)
+		}
+	}
+
+	ac := &AccessControl{
+		config:  config,
+		allowed: make(map[string]bool, len(config.AllowedMethods)),
+		denied:  make(map[string]bool, len(config.DeniedMethods)),
+		now:     time.Now,
+		buckets: make(map[string]*bucket),
+	}
+	for _, method := range config.AllowedMethods {
+		ac.allowed[method] = true
+	}
+	for _, method := range config.DeniedMethods {
+		ac.denied[method] = true
+	}
+	ac.lastSweep = ac.now()
+	return ac, nil
+}
+
+func (q Quota) validate() error {
+	if q.Rate < 0 {
+		return errors.New("rate can't be negative")
+	}
+	if q.Rate > 0 && q.Burst < 1 {
+		return errors.New("burst must be at least 1")
+	}
+	return nil
+}
+
+// Allow checks that the client of the request can call the method, and
+// takes its cost from the client's bucket. It returns ErrMethodNotAllowed,
+// ErrInvalidAPIKey or ErrRateLimited otherwise.
+func (ac *AccessControl) Allow(r *http.Request, method string) error {
+	if ac.denied[method] || (len(ac.allowed) > 0 && !ac.allowed[method]) {
+		return ErrMethodNotAllowed
+	}
+
+	client, quota, err := ac.identify(r)
+	if err != nil {
+		return err
+	}
+	if quota.Rate == 0 {
+		return nil
+	}
+
+	cost, ok := ac.config.MethodCosts[method]
+	if !ok {
+		cost = 1
+	}
+	return ac.take(client, quota, cost)
+}
+
+// identify returns the bucket key of the client and its quota: the API key if
+// one is given, the IP otherwise.
+func (ac *AccessControl) identify(r *http.Request) (string, Quota, error) {
+	if key := r.Header.Get(APIKeyHeader); key != "" {
+		quota, ok := ac.config.APIKeys[key]
+		if !ok {
+			return "", Quota{}, ErrInvalidAPIKey
+		}
+		return "key:" + key, quota, nil
+	}
+
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		// unix sockets have no port
+		ip = r.RemoteAddr
+	}
+	return "ip:" + ip, ac.config.PerIP, nil
+}
+
+func (ac *AccessControl) take(client string, quota Quota, cost float64) error {
+	ac.mtx.Lock()
+	defer ac.mtx.Unlock()
+
+	now := ac.now()
+	if now.Sub(ac.lastSweep) >= bucketSweepInterval {
+		ac.sweep(now)
+	}
+
+	b, ok := ac.buckets[client]
+	if !ok {
+		b = &bucket{tokens: quota.Burst, last: now}
+		ac.buckets[client] = b
+	}
+	b.refill(quota, now)
+
+	if b.tokens < cost {
+		return ErrRateLimited
+	}
+	b.tokens -= cost
+	return nil
+}
+
+// sweep drops the buckets which are full again.
+func (ac *AccessControl) sweep(now time.Time) {
+	for client, b := range ac.buckets {
+		quota := ac.config.PerIP
+		if len(client) > 4 && client[:4] == "key:" {
+			quota = ac.config.APIKeys[client[4:]]
+		}
+		b.refill(quota, now)
+		if b.tokens >= quota.Burst {
+			delete(ac.buckets, client)
+		}
+	}
+	ac.lastSweep = now
+}
+
+func (b *bucket) refill(quota Quota, now time.Time) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * quota.Rate
+		if b.tokens > quota.Burst {
+			b.tokens = quota.Burst
+		}
+		b.last = now
+	}
+}
+
+// accessErrorResponse returns the HTTP status and the response to a request
+// the access control rejected with the given error.
+func accessErrorResponse(request types.RPCRequest, err error) (int, types.RPCResponse) {
+	if errors.Is(err, ErrRateLimited) {
+		return http.StatusTooManyRequests, types.RPCRateLimitedError(request.ID, err)
+	}
+	return http.StatusForbidden, types.RPCForbiddenError(request.ID, err)
+}
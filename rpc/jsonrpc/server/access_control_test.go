@@ -0,0 +1,235 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/creatachain/augusteum/libs/log"
+	types "github.com/creatachain/augusteum/rpc/jsonrpc/types"
+)
+
+func newTestAccessControl(t *testing.T, config AccessConfig) (*AccessControl, *time.Time) {
+	ac, err := NewAccessControl(config)
+	require.NoError(t, err)
+	now := time.Now()
+	ac.now = func() time.Time { return now }
+	return ac, &now
+}
+
+func requestFrom(remoteAddr, apiKey string) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.RemoteAddr = remoteAddr
+	if apiKey != "" {
+		r.Header.Set(APIKeyHeader, apiKey)
+	}
+	return r
+}
+
+func TestNewAccessControl(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config AccessConfig
+	}{
+		{"negative rate", AccessConfig{PerIP: Quota{Rate: -1, Burst: 1}}},
+		{"no burst", AccessConfig{PerIP: Quota{Rate: 1}}},
+		{"empty API key", AccessConfig{APIKeys: map[string]Quota{"": {}}}},
+		{"API key without burst", AccessConfig{APIKeys: map[string]Quota{"key": {Rate: 1}}}},
+		{"negative cost", AccessConfig{MethodCosts: map[string]float64{"c": -1}}},
+	}
+	for _, tc := range testCases {
+		_, err := NewAccessControl(tc.config)
+		assert.Error(t, err, tc.name)
+	}
+
+	// the error doesn't leak the key
+	_, err := NewAccessControl(AccessConfig{APIKeys: map[string]Quota{"secretkey": {Rate: 1}}})
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "secr")
+
+	_, err = NewAccessControl(AccessConfig{})
+	assert.NoError(t, err)
+}
+
+func TestAccessControlRateLimit(t *testing.T) {
+	ac, now := newTestAccessControl(t, AccessConfig{
+		PerIP:       Quota{Rate: 2, Burst: 4},
+		MethodCosts: map[string]float64{"heavy": 3, "free": 0},
+	})
+	alice := requestFrom("1.1.1.1:1000", "")
+	// the port is not part of the identity of the client
+	aliceAgain := requestFrom("1.1.1.1:2000", "")
+	bob := requestFrom("2.2.2.2:1000", "")
+
+	require.NoError(t, ac.Allow(alice, "c"))
+	require.NoError(t, ac.Allow(aliceAgain, "heavy"))
+	assert.ErrorIs(t, ac.Allow(alice, "c"), ErrRateLimited)
+	assert.ErrorIs(t, ac.Allow(aliceAgain, "c"), ErrRateLimited)
+	assert.NoError(t, ac.Allow(alice, "free"))
+	assert.NoError(t, ac.Allow(bob, "c"))
+
+	// 2 tokens per second
+	*now = now.Add(500 * time.Millisecond)
+	assert.NoError(t, ac.Allow(alice, "c"))
+	assert.ErrorIs(t, ac.Allow(alice, "c"), ErrRateLimited)
+
+	// the bucket does not fill beyond the burst
+	*now = now.Add(time.Hour)
+	require.NoError(t, ac.Allow(alice, "heavy"))
+	assert.ErrorIs(t, ac.Allow(alice, "heavy"), ErrRateLimited)
+	assert.NoError(t, ac.Allow(alice, "c"))
+
+	// full buckets are dropped
+	*now = now.Add(time.Hour)
+	assert.NoError(t, ac.Allow(bob, "c"))
+	assert.Len(t, ac.buckets, 1)
+}
+
+func TestAccessControlAPIKeys(t *testing.T) {
+	ac, _ := newTestAccessControl(t, AccessConfig{
+		PerIP:   Quota{Rate: 1, Burst: 1},
+		APIKeys: map[string]Quota{"limited": {Rate: 1, Burst: 2}, "unlimited": {}},
+	})
+
+	assert.ErrorIs(t, ac.Allow(requestFrom("1.1.1.1:1000", "unknown"), "c"), ErrInvalidAPIKey)
+
+	// a key has its own bucket, shared by all the IPs using it
+	require.NoError(t, ac.Allow(requestFrom("1.1.1.1:1000", ""), "c"))
+	require.NoError(t, ac.Allow(requestFrom("1.1.1.1:1000", "limited"), "c"))
+	require.NoError(t, ac.Allow(requestFrom("2.2.2.2:1000", "limited"), "c"))
+	assert.ErrorIs(t, ac.Allow(requestFrom("3.3.3.3:1000", "limited"), "c"), ErrRateLimited)
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, ac.Allow(requestFrom("1.1.1.1:1000", "unlimited"), "c"))
+	}
+}
+
+func TestAccessControlMethods(t *testing.T) {
+	ac, _ := newTestAccessControl(t, AccessConfig{DeniedMethods: []string{"d"}})
+	assert.NoError(t, ac.Allow(requestFrom("1.1.1.1:1000", ""), "c"))
+	assert.ErrorIs(t, ac.Allow(requestFrom("1.1.1.1:1000", ""), "d"), ErrMethodNotAllowed)
+
+	ac, _ = newTestAccessControl(t, AccessConfig{AllowedMethods: []string{"c", "d"}, DeniedMethods: []string{"d"}})
+	assert.NoError(t, ac.Allow(requestFrom("1.1.1.1:1000", ""), "c"))
+	assert.ErrorIs(t, ac.Allow(requestFrom("1.1.1.1:1000", ""), "d"), ErrMethodNotAllowed)
+	assert.ErrorIs(t, ac.Allow(requestFrom("1.1.1.1:1000", ""), "e"), ErrMethodNotAllowed)
+}
+
+func TestAccessControlHTTP(t *testing.T) {
+	ac, _ := newTestAccessControl(t, AccessConfig{
+		PerIP:         Quota{Rate: 1, Burst: 1},
+		DeniedMethods: []string{"d"},
+	})
+	funcMap := map[string]*RPCFunc{
+		"c": NewRPCFunc(func(ctx *types.Context) (string, error) { return "foo", nil }, ""),
+		"d": NewRPCFunc(func(ctx *types.Context) (string, error) { return "foo", nil }, ""),
+	}
+	mux := http.NewServeMux()
+	RegisterRPCFuncsWithAccessControl(mux, funcMap, ac, log.TestingLogger())
+
+	do := func(r *http.Request) (int, types.RPCResponse) {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, r)
+		var resp types.RPCResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp), rec.Body.String())
+		return rec.Code, resp
+	}
+	uri := func(method string) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "/"+method, nil)
+		r.RemoteAddr = "1.1.1.1:1000"
+		return r
+	}
+
+	code, resp := do(uri("d"))
+	assert.Equal(t, http.StatusForbidden, code)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, -32001, resp.Error.Code)
+
+	code, resp = do(uri("c"))
+	assert.Equal(t, http.StatusOK, code)
+	assert.Nil(t, resp.Error)
+
+	code, resp = do(uri("c"))
+	assert.Equal(t, http.StatusTooManyRequests, code)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, -32005, resp.Error.Code)
+
+	// JSON-RPC, from another IP with a fresh bucket
+	r := httptest.NewRequest(http.MethodPost, "/",
+		strings.NewReader(`[{"jsonrpc":"2.0","id":1,"method":"c"},{"jsonrpc":"2.0","id":2,"method":"c"}]`))
+	r.RemoteAddr = "2.2.2.2:1000"
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, r)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var responses []types.RPCResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &responses), rec.Body.String())
+	require.Len(t, responses, 2)
+	assert.Nil(t, responses[0].Error)
+	require.NotNil(t, responses[1].Error)
+	assert.Equal(t, -32005, responses[1].Error.Code)
+
+	// a request rejected on its own has the status of the rejection
+	jsonrpc := func(method string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/",
+			strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"`+method+`"}`))
+		r.RemoteAddr = "2.2.2.2:1000"
+		return r
+	}
+	code, resp = do(jsonrpc("c"))
+	assert.Equal(t, http.StatusTooManyRequests, code)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, -32005, resp.Error.Code)
+
+	code, resp = do(jsonrpc("d"))
+	assert.Equal(t, http.StatusForbidden, code)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, -32001, resp.Error.Code)
+}
+
+func TestAccessControlWebsocket(t *testing.T) {
+	ac, _ := newTestAccessControl(t, AccessConfig{
+		APIKeys: map[string]Quota{"key": {Rate: 1, Burst: 1}},
+	})
+	funcMap := map[string]*RPCFunc{
+		"c": NewWSRPCFunc(func(ctx *types.Context) (string, error) { return "foo", nil }, ""),
+	}
+	wm := NewWebsocketManager(funcMap)
+	wm.SetLogger(log.TestingLogger())
+	wm.SetAccessControl(ac)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/websocket", wm.WebsocketHandler)
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	url := "ws://" + s.Listener.Addr().String() + "/websocket"
+	d := websocket.Dialer{}
+
+	// an unknown key is rejected at the upgrade
+	_, dialResp, err := d.Dial(url, http.Header{APIKeyHeader: {"unknown"}})
+	require.Error(t, err)
+	assert.Equal(t, http.StatusUnauthorized, dialResp.StatusCode)
+	dialResp.Body.Close()
+
+	c, dialResp, err := d.Dial(url, http.Header{APIKeyHeader: {"key"}})
+	require.NoError(t, err)
+	defer dialResp.Body.Close()
+	defer c.Close()
+
+	call := func(id int) types.RPCResponse {
+		require.NoError(t, c.WriteJSON(types.RPCRequest{JSONRPC: "2.0", ID: types.JSONRPCIntID(id), Method: "c"}))
+		var resp types.RPCResponse
+		require.NoError(t, c.ReadJSON(&resp))
+		return resp
+	}
+	assert.Nil(t, call(1).Error)
+	resp := call(2)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, -32005, resp.Error.Code)
+}
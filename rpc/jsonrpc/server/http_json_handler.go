@@ -17,7 +17,7 @@ import (
 // HTTP + JSON handler
 
 // jsonrpc calls grab the given method's function info and runs reflect.Call
-func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger log.Logger) http.HandlerFunc {
+func makeJSONRPCHandler(funcMap map[string]*RPCFunc, ac *AccessControl, logger log.Logger) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		b, err := ioutil.ReadAll(r.Body)
 		if err != nil {
@@ -43,6 +43,12 @@ func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger log.Logger) http.Han
 		var (
 			requests  []types.RPCRequest
 			responses []types.RPCResponse
+			// number of responses which never change
+			cacheable int
+			// number of requests rejected by the access control, and the HTTP
+			// status of the first one
+			rejected     int
+			rejectedCode int
 		)
 		if err := json.Unmarshal(b, &requests); err != nil {
 			// next, try to unmarshal as a single request
@@ -84,6 +90,17 @@ func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger log.Logger) http.Han
 				responses = append(responses, types.RPCMethodNotFoundError(request.ID))
 				continue
 			}
+			if ac != nil {
+				if err := ac.Allow(r, request.Method); err != nil {
+					httpCode, resp := accessErrorResponse(request, err)
+					if rejected == 0 {
+						rejectedCode = httpCode
+					}
+					rejected++
+					responses = append(responses, resp)
+					continue
+				}
+			}
 			ctx := &types.Context{JSONReq: &request, HTTPReq: r}
 			args := []reflect.Value{reflect.ValueOf(ctx)}
 			if len(request.Params) > 0 {
@@ -105,8 +122,21 @@ func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger log.Logger) http.Han
 				continue
 			}
 			responses = append(responses, types.NewRPCSuccessResponse(request.ID, result))
+			if ctx.Cacheable() {
+				cacheable++
+			}
 		}
 		if len(responses) > 0 {
+			// the status of a batch only reflects the access control if it
+			// rejected every request
+			if rejected == len(responses) {
+				writeRPCResponseHTTP(w, rejectedCode, responses...)
+				return
+			}
+			if cacheable == len(responses) {
+				WriteCacheableRPCResponseHTTP(w, r, responses...)
+				return
+			}
 			WriteRPCResponseHTTP(w, responses...)
 		}
 	}
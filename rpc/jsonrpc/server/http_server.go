@@ -3,6 +3,8 @@ package server
 
 import (
 	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -19,6 +21,10 @@ import (
 	types "github.com/creatachain/augusteum/rpc/jsonrpc/types"
 )
 
+// cacheControlImmutable is the Cache-Control header of the responses which
+// never change.
+const cacheControlImmutable = "public, max-age=31536000, immutable"
+
 // Config is a RPC server configuration.
 type Config struct {
 	// see netutil.LimitListener
@@ -113,6 +119,46 @@ func WriteRPCResponseHTTPError(
 //
 // Panics if it can't Marshal res or write to w.
 func WriteRPCResponseHTTP(w http.ResponseWriter, res ...types.RPCResponse) {
+	writeRPCResponseHTTP(w, http.StatusOK, res...)
+}
+
+// writeRPCResponseHTTP marshals res as JSON and writes it to w with the given
+// HTTP status.
+func writeRPCResponseHTTP(w http.ResponseWriter, httpCode int, res ...types.RPCResponse) {
+	jsonBytes := marshalRPCResponses(res)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpCode)
+	if _, err := w.Write(jsonBytes); err != nil {
+		panic(err)
+	}
+}
+
+// WriteCacheableRPCResponseHTTP is like WriteRPCResponseHTTP, for responses
+// which never change. It lets HTTP caches store the response with the
+// Cache-Control and ETag headers, and responds 304 Not Modified if the request
+// has the ETag of the response in If-None-Match.
+//
+// Panics if it can't Marshal res or write to w.
+func WriteCacheableRPCResponseHTTP(w http.ResponseWriter, r *http.Request, res ...types.RPCResponse) {
+	jsonBytes := marshalRPCResponses(res)
+	hash := sha256.Sum256(jsonBytes)
+	etag := `"` + hex.EncodeToString(hash[:16]) + `"`
+
+	w.Header().Set("Cache-Control", cacheControlImmutable)
+	w.Header().Set("ETag", etag)
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	if _, err := w.Write(jsonBytes); err != nil {
+		panic(err)
+	}
+}
+
+func marshalRPCResponses(res []types.RPCResponse) []byte {
 	var v interface{}
 	if len(res) == 1 {
 		v = res[0]
@@ -124,11 +170,18 @@ func WriteRPCResponseHTTP(w http.ResponseWriter, res ...types.RPCResponse) {
 	if err != nil {
 		panic(err)
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	if _, err := w.Write(jsonBytes); err != nil {
-		panic(err)
+	return jsonBytes
+}
+
+// etagMatches returns true if the If-None-Match header lists the ETag.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == etag || tag == "*" {
+			return true
+		}
 	}
+	return false
 }
 
 //-----------------------------------------------------------------------------
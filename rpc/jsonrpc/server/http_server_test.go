@@ -2,12 +2,14 @@ package server
 
 import (
 	"crypto/tls"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -157,6 +159,105 @@ func TestWriteRPCResponseHTTP(t *testing.T) {
 ]`, string(body))
 }
 
+func TestWriteCacheableRPCResponseHTTP(t *testing.T) {
+	id := types.JSONRPCIntID(-1)
+	r := httptest.NewRequest(http.MethodGet, "/c", nil)
+
+	w := httptest.NewRecorder()
+	WriteCacheableRPCResponseHTTP(w, r, types.NewRPCSuccessResponse(id, &sampleResult{"hello"}))
+	resp := w.Result()
+	body, err := ioutil.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, cacheControlImmutable, resp.Header.Get("Cache-Control"))
+	etag := resp.Header.Get("ETag")
+	assert.NotEmpty(t, etag)
+	assert.Contains(t, string(body), "hello")
+
+	// the ETag depends on the response only
+	w = httptest.NewRecorder()
+	WriteCacheableRPCResponseHTTP(w, r, types.NewRPCSuccessResponse(id, &sampleResult{"world"}))
+	assert.NotEqual(t, etag, w.Result().Header.Get("ETag"))
+
+	r.Header.Set("If-None-Match", `"other", W/`+etag)
+	w = httptest.NewRecorder()
+	WriteCacheableRPCResponseHTTP(w, r, types.NewRPCSuccessResponse(id, &sampleResult{"hello"}))
+	resp = w.Result()
+	body, err = ioutil.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotModified, resp.StatusCode)
+	assert.Equal(t, etag, resp.Header.Get("ETag"))
+	assert.Empty(t, body)
+}
+
+func TestCacheableHandlers(t *testing.T) {
+	funcMap := map[string]*RPCFunc{
+		"fixed": NewRPCFunc(func(ctx *types.Context) (string, error) {
+			ctx.MarkCacheable()
+			return "foo", nil
+		}, ""),
+		"latest": NewRPCFunc(func(ctx *types.Context) (string, error) { return "foo", nil }, ""),
+	}
+	mux := http.NewServeMux()
+	RegisterRPCFuncs(mux, funcMap, log.TestingLogger())
+
+	do := func(r *http.Request) *http.Response {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		return w.Result()
+	}
+	jsonrpc := func(body string) *http.Request {
+		return httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	}
+
+	resp := do(httptest.NewRequest(http.MethodGet, "/fixed", nil))
+	assert.Equal(t, cacheControlImmutable, resp.Header.Get("Cache-Control"))
+	assert.NotEmpty(t, resp.Header.Get("ETag"))
+	resp = do(httptest.NewRequest(http.MethodGet, "/latest", nil))
+	assert.Empty(t, resp.Header.Get("Cache-Control"))
+	assert.Empty(t, resp.Header.Get("ETag"))
+
+	resp = do(jsonrpc(`{"jsonrpc":"2.0","id":1,"method":"fixed"}`))
+	assert.Equal(t, cacheControlImmutable, resp.Header.Get("Cache-Control"))
+	// a batch is cacheable only if all its responses are
+	resp = do(jsonrpc(`[{"jsonrpc":"2.0","id":1,"method":"fixed"},{"jsonrpc":"2.0","id":2,"method":"latest"}]`))
+	assert.Empty(t, resp.Header.Get("Cache-Control"))
+	resp = do(jsonrpc(`[{"jsonrpc":"2.0","id":1,"method":"fixed"},{"jsonrpc":"2.0","id":2,"method":"none"}]`))
+	assert.Empty(t, resp.Header.Get("Cache-Control"))
+}
+
+func TestSchemaHandler(t *testing.T) {
+	mux := testMux()
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, SchemaPath, nil))
+	resp := w.Result()
+	defer resp.Body.Close()
+	require.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+	var schema struct {
+		OpenAPI string `json:"openapi"`
+		Paths   map[string]struct {
+			Get struct {
+				OperationID string `json:"operationId"`
+				Parameters  []struct {
+					Name string `json:"name"`
+				} `json:"parameters"`
+			} `json:"get"`
+		} `json:"paths"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&schema))
+	assert.Equal(t, "3.0.0", schema.OpenAPI)
+	require.Len(t, schema.Paths, 1)
+	c := schema.Paths["/c"].Get
+	assert.Equal(t, "c", c.OperationID)
+	require.Len(t, c.Parameters, 2)
+	assert.Equal(t, "s", c.Parameters[0].Name)
+	assert.Equal(t, "i", c.Parameters[1].Name)
+}
+
 func TestWriteRPCResponseHTTPError(t *testing.T) {
 	w := httptest.NewRecorder()
 	WriteRPCResponseHTTPError(w,
@@ -18,7 +18,8 @@ import (
 var reInt = regexp.MustCompile(`^-?[0-9]+$`)
 
 // convert from a function name to the http handler
-func makeHTTPHandler(rpcFunc *RPCFunc, logger log.Logger) func(http.ResponseWriter, *http.Request) {
+func makeHTTPHandler(funcName string, rpcFunc *RPCFunc, ac *AccessControl,
+	logger log.Logger) func(http.ResponseWriter, *http.Request) {
 	// Always return -1 as there's no ID here.
 	dummyID := types.JSONRPCIntID(-1) // URIClientRequestID
 
@@ -34,6 +35,14 @@ func makeHTTPHandler(rpcFunc *RPCFunc, logger log.Logger) func(http.ResponseWrit
 	return func(w http.ResponseWriter, r *http.Request) {
 		logger.Debug("HTTP HANDLER", "req", r)
 
+		if ac != nil {
+			if err := ac.Allow(r, funcName); err != nil {
+				httpCode, res := accessErrorResponse(types.RPCRequest{ID: dummyID}, err)
+				WriteRPCResponseHTTPError(w, httpCode, res)
+				return
+			}
+		}
+
 		ctx := &types.Context{HTTPReq: r}
 		args := []reflect.Value{reflect.ValueOf(ctx)}
 
@@ -60,6 +69,10 @@ func makeHTTPHandler(rpcFunc *RPCFunc, logger log.Logger) func(http.ResponseWrit
 				types.RPCInternalError(dummyID, err))
 			return
 		}
+		if ctx.Cacheable() {
+			WriteCacheableRPCResponseHTTP(w, r, types.NewRPCSuccessResponse(dummyID, result))
+			return
+		}
 		WriteRPCResponseHTTP(w, types.NewRPCSuccessResponse(dummyID, result))
 	}
 }
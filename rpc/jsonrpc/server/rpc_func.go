@@ -10,17 +10,27 @@ import (
 )
 
 // RegisterRPCFuncs adds a route for each function in the funcMap, as well as
-// general jsonrpc and websocket handlers for all functions. "result" is the
-// interface on which the result objects are registered, and is popualted with
-// every RPCResponse
+// general jsonrpc and websocket handlers for all functions, and the OpenAPI
+// schema of the routes at SchemaPath. "result" is the interface on which the
+// result objects are registered, and is popualted with every RPCResponse
 func RegisterRPCFuncs(mux *http.ServeMux, funcMap map[string]*RPCFunc, logger log.Logger) {
+	RegisterRPCFuncsWithAccessControl(mux, funcMap, nil, logger)
+}
+
+// RegisterRPCFuncsWithAccessControl is like RegisterRPCFuncs, but every call
+// is first checked by the given access control, if not nil.
+func RegisterRPCFuncsWithAccessControl(mux *http.ServeMux, funcMap map[string]*RPCFunc, ac *AccessControl,
+	logger log.Logger) {
 	// HTTP endpoints
 	for funcName, rpcFunc := range funcMap {
-		mux.HandleFunc("/"+funcName, makeHTTPHandler(rpcFunc, logger))
+		mux.HandleFunc("/"+funcName, makeHTTPHandler(funcName, rpcFunc, ac, logger))
 	}
 
 	// JSONRPC endpoints
-	mux.HandleFunc("/", handleInvalidJSONRPCPaths(makeJSONRPCHandler(funcMap, logger)))
+	mux.HandleFunc("/", handleInvalidJSONRPCPaths(makeJSONRPCHandler(funcMap, ac, logger)))
+
+	// schema of the endpoints
+	mux.HandleFunc(SchemaPath, makeSchemaHandler(funcMap))
 }
 
 // Function introspection
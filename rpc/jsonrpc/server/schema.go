@@ -0,0 +1,90 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+
+	tmjson "github.com/creatachain/augusteum/libs/json"
+	types "github.com/creatachain/augusteum/rpc/jsonrpc/types"
+	"github.com/creatachain/augusteum/version"
+)
+
+// SchemaPath is the path the OpenAPI schema of the routes is served at.
+const SchemaPath = "/openapi.json"
+
+// Schema returns the OpenAPI 3.0 document of the routes of funcMap, with the
+// schemas of their parameters and results generated from their Go types, as
+// encoded by the server. Each route is a GET operation with its parameters in
+// the query, as served by the URI handlers; the websocket only routes are
+// marked with "x-websocket-only".
+func Schema(funcMap map[string]*RPCFunc) map[string]interface{} {
+	defs := make(map[string]interface{})
+	errorSchema := tmjson.Schema(reflect.TypeOf(types.RPCError{}), defs)
+
+	paths := make(map[string]interface{}, len(funcMap))
+	for name, rpcFunc := range funcMap {
+		// skip types.Context
+		params := make([]interface{}, len(rpcFunc.argNames))
+		for i, argName := range rpcFunc.argNames {
+			params[i] = map[string]interface{}{
+				"in":     "query",
+				"name":   argName,
+				"schema": tmjson.Schema(rpcFunc.args[i+1], defs),
+			}
+		}
+
+		response := map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"jsonrpc": map[string]interface{}{"type": "string", "example": "2.0"},
+				"id":      map[string]interface{}{"type": "integer", "example": -1},
+				"result":  tmjson.Schema(rpcFunc.returns[0], defs),
+				"error":   errorSchema,
+			},
+			"required": []string{"jsonrpc", "id"},
+		}
+		operation := map[string]interface{}{
+			"operationId": name,
+			"parameters":  params,
+			"responses": map[string]interface{}{
+				"200": map[string]interface{}{
+					"description": "The result of " + name,
+					"content": map[string]interface{}{
+						"application/json": map[string]interface{}{"schema": response},
+					},
+				},
+			},
+		}
+		if rpcFunc.ws {
+			operation["x-websocket-only"] = true
+		}
+		paths["/"+name] = map[string]interface{}{"get": operation}
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.0.0",
+		"info": map[string]interface{}{
+			"title":   "Augusteum RPC",
+			"version": version.TMCoreSemVer,
+		},
+		"paths":      paths,
+		"components": map[string]interface{}{"schemas": defs},
+	}
+}
+
+// makeSchemaHandler returns a handler serving the schema of the routes.
+func makeSchemaHandler(funcMap map[string]*RPCFunc) http.HandlerFunc {
+	schema, err := json.MarshalIndent(Schema(funcMap), "", "  ")
+	if err != nil {
+		panic(err)
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		if _, err := w.Write(schema); err != nil {
+			panic(err)
+		}
+	}
+}
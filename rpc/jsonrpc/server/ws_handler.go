@@ -34,6 +34,7 @@ type WebsocketManager struct {
 
 	funcMap       map[string]*RPCFunc
 	logger        log.Logger
+	accessControl *AccessControl
 	wsConnOptions []func(*wsConnection)
 }
 
@@ -69,9 +70,22 @@ func (wm *WebsocketManager) SetLogger(l log.Logger) {
 	wm.logger = l
 }
 
+// SetAccessControl sets the access control checking every call made over the
+// connections, identifying their clients by the upgrade request.
+func (wm *WebsocketManager) SetAccessControl(ac *AccessControl) {
+	wm.accessControl = ac
+}
+
 // WebsocketHandler upgrades the request/response (via http.Hijack) and starts
 // the wsConnection.
 func (wm *WebsocketManager) WebsocketHandler(w http.ResponseWriter, r *http.Request) {
+	if wm.accessControl != nil {
+		if _, _, err := wm.accessControl.identify(r); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+	}
+
 	wsConn, err := wm.Upgrade(w, r, nil)
 	if err != nil {
 		// TODO - return http error
@@ -86,6 +100,8 @@ func (wm *WebsocketManager) WebsocketHandler(w http.ResponseWriter, r *http.Requ
 
 	// register connection
 	con := newWSConnection(wsConn, wm.funcMap, wm.wsConnOptions...)
+	con.accessControl = wm.accessControl
+	con.upgradeReq = r
 	con.SetLogger(wm.logger.With("remote", wsConn.RemoteAddr()))
 	wm.logger.Info("New websocket connection", "remote", con.remoteAddr)
 	err = con.Start() // BLOCKING
@@ -118,6 +134,10 @@ type wsConnection struct {
 
 	funcMap map[string]*RPCFunc
 
+	// checks every call, identifying the client by the upgrade request
+	accessControl *AccessControl
+	upgradeReq    *http.Request
+
 	// write channel capacity
 	writeChanCapacity int
 
@@ -362,6 +382,15 @@ func (wsc *wsConnection) readRoutine() {
 				}
 				continue
 			}
+			if wsc.accessControl != nil {
+				if err := wsc.accessControl.Allow(wsc.upgradeReq, request.Method); err != nil {
+					_, resp := accessErrorResponse(request, err)
+					if err := wsc.WriteRPCResponse(writeCtx, resp); err != nil {
+						wsc.Logger.Error("Error writing RPC response", "err", err)
+					}
+					continue
+				}
+			}
 
 			ctx := &types.Context{JSONReq: &request, WSConn: wsc}
 			args := []reflect.Value{reflect.ValueOf(ctx)}
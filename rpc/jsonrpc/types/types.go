@@ -240,6 +240,14 @@ func RPCServerError(id jsonrpcid, err error) RPCResponse {
 	return NewRPCErrorResponse(id, -32000, "Server error", err.Error())
 }
 
+func RPCForbiddenError(id jsonrpcid, err error) RPCResponse {
+	return NewRPCErrorResponse(id, -32001, "Forbidden", err.Error())
+}
+
+func RPCRateLimitedError(id jsonrpcid, err error) RPCResponse {
+	return NewRPCErrorResponse(id, -32005, "Rate limited", err.Error())
+}
+
 //----------------------------------------
 
 // WSRPCConnection represents a websocket connection.
@@ -267,6 +275,24 @@ type Context struct {
 	WSConn WSRPCConnection
 	// http request
 	HTTPReq *http.Request
+
+	// true if the result never changes
+	cacheable bool
+}
+
+// MarkCacheable marks the result of the request as one which never changes,
+// e.g. a block at a past height, so that HTTP caches can store it.
+// Only HTTP requests are marked, as the contexts of other requests may be
+// shared between calls.
+func (ctx *Context) MarkCacheable() {
+	if ctx.HTTPReq != nil {
+		ctx.cacheable = true
+	}
+}
+
+// Cacheable returns true if the result of the request never changes.
+func (ctx *Context) Cacheable() bool {
+	return ctx.cacheable
 }
 
 // RemoteAddr returns the remote address (usually a string "IP:port").
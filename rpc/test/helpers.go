@@ -115,6 +115,11 @@ func GetGRPCClient() core_grpc.BroadcastAPIClient {
 	return core_grpc.StartGRPCClient(grpcAddr)
 }
 
+func GetGRPCQueryClient() core_grpc.QueryAPIClient {
+	grpcAddr := globalConfig.RPC.GRPCListenAddress
+	return core_grpc.StartGRPCQueryClient(grpcAddr)
+}
+
 // StartAugusteum starts a test augusteum server in a go routine and returns when it is initialized
 func StartAugusteum(app msm.Application, opts ...func(*Options)) *nm.Node {
 	nodeOpts := defaultOptions
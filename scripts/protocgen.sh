@@ -10,6 +10,7 @@ for dir in $proto_dirs; do
   --gogofaster_out=\
 Mgoogle/protobuf/timestamp.proto=github.com/gogo/protobuf/types,\
 Mgoogle/protobuf/duration.proto=github.com/golang/protobuf/ptypes/duration,\
+Mgoogle/protobuf/wrappers.proto=github.com/gogo/protobuf/types,\
 plugins=grpc,paths=source_relative:. \
   $(find "${dir}" -maxdepth 1 -name '*.proto')
 done
@@ -20,3 +21,4 @@ rm -rf augusteum
 mv ./proto/augusteum/msm/types.pb.go ./msm/types
 
 mv ./proto/augusteum/rpc/grpc/types.pb.go ./rpc/grpc
+mv ./proto/augusteum/rpc/grpc/query.pb.go ./rpc/grpc
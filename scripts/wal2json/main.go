@@ -3,6 +3,9 @@
 
 	Usage:
 			wal2json <path-to-wal>
+
+	The same output, for the rotated WAL files as well, is available with
+	`augusteum debug wal json`.
 */
 
 package main
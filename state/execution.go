@@ -91,11 +91,15 @@ func (blockExec *BlockExecutor) SetEventBus(eventBus types.BlockEventPublisher)
 // and txs from the mempool. The max bytes must be big enough to fit the commit.
 // Up to 1/10th of the block space is allcoated for maximum sized evidence.
 // The rest is given to txs, up to the max gas.
+// The reaped txs and the vote extensions of lastVotes, the precommits of the
+// last height, are handed to the application with PrepareProposal, which
+// returns the txs of the block. lastVotes is nil for the initial height.
 func (blockExec *BlockExecutor) CreateProposalBlock(
 	height int64,
 	state State, commit *types.Commit,
+	lastVotes *types.VoteSet,
 	proposerAddr []byte,
-) (*types.Block, *types.PartSet) {
+) (*types.Block, *types.PartSet, error) {
 
 	maxBytes := state.ConsensusParams.Block.MaxBytes
 	maxGas := state.ConsensusParams.Block.MaxGas
@@ -107,7 +111,63 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 
 	txs := blockExec.mempool.ReapMaxBytesMaxGas(maxDataBytes, maxGas)
 
-	return state.MakeBlock(height, txs, commit, evidence, proposerAddr)
+	reqTxs := make([][]byte, len(txs))
+	for i, tx := range txs {
+		reqTxs[i] = tx
+	}
+	res, err := blockExec.proxyApp.PrepareProposalSync(msm.RequestPrepareProposal{
+		MaxTxBytes:      maxDataBytes,
+		Txs:             reqTxs,
+		LocalLastCommit: getExtendedCommitInfo(lastVotes, state.LastValidators),
+		Height:          height,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txs = make(types.Txs, len(res.Txs))
+	for i, tx := range res.Txs {
+		txs[i] = tx
+	}
+	if size := types.ComputeProtoSizeForTxs(txs); size > maxDataBytes {
+		return nil, nil, fmt.Errorf("prepared txs take %d bytes, exceeding the %d bytes available", size, maxDataBytes)
+	}
+
+	block, parts := state.MakeBlock(height, txs, commit, evidence, proposerAddr)
+	return block, parts, nil
+}
+
+// ExtendVote asks the application for the extension of a precommit for a
+// block of the local validator.
+func (blockExec *BlockExecutor) ExtendVote(vote *types.Vote) ([]byte, error) {
+	res, err := blockExec.proxyApp.ExtendVoteSync(msm.RequestExtendVote{
+		Hash:   vote.BlockID.Hash,
+		Height: vote.Height,
+		Round:  vote.Round,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.VoteExtension, nil
+}
+
+// VerifyVoteExtension asks the application to verify the extension of a
+// precommit for a block of another validator.
+func (blockExec *BlockExecutor) VerifyVoteExtension(vote *types.Vote) error {
+	res, err := blockExec.proxyApp.VerifyVoteExtensionSync(msm.RequestVerifyVoteExtension{
+		Hash:             vote.BlockID.Hash,
+		ValidatorAddress: vote.ValidatorAddress,
+		Height:           vote.Height,
+		Round:            vote.Round,
+		VoteExtension:    vote.Extension,
+	})
+	if err != nil {
+		return err
+	}
+	if res.Status != msm.ResponseVerifyVoteExtension_ACCEPT {
+		return types.ErrVoteInvalidExtension
+	}
+	return nil
 }
 
 // ValidateBlock validates the given block against the given state.
@@ -374,6 +434,33 @@ func getBeginBlockValidatorInfo(block *types.Block, store Store,
 	}
 }
 
+// getExtendedCommitInfo returns the precommits of lastVotes for each validator
+// of lastValSet. Only the precommits for the committed block carry their
+// extension.
+func getExtendedCommitInfo(lastVotes *types.VoteSet, lastValSet *types.ValidatorSet) msm.ExtendedCommitInfo {
+	if lastVotes == nil {
+		return msm.ExtendedCommitInfo{}
+	}
+
+	blockID, _ := lastVotes.TwoThirdsMajority()
+	voteInfos := make([]msm.ExtendedVoteInfo, len(lastValSet.Validators))
+	for i, val := range lastValSet.Validators {
+		vote := lastVotes.GetByIndex(int32(i))
+		voteInfos[i] = msm.ExtendedVoteInfo{
+			Validator:       types.TM2PB.Validator(val),
+			SignedLastBlock: vote != nil,
+		}
+		if vote != nil && vote.BlockID.Equals(blockID) {
+			voteInfos[i].VoteExtension = vote.Extension
+		}
+	}
+
+	return msm.ExtendedCommitInfo{
+		Round: lastVotes.GetRound(),
+		Votes: voteInfos,
+	}
+}
+
 func validateValidatorUpdates(msmUpdates []msm.ValidatorUpdate,
 	params tmproto.ValidatorParams) error {
 	for _, valUpdate := range msmUpdates {
@@ -436,6 +523,10 @@ func updateState(
 		if err != nil {
 			return state, fmt.Errorf("error updating consensus params: %v", err)
 		}
+		err = types.ValidateConsensusParamsUpdate(state.ConsensusParams, nextParams, header.Height)
+		if err != nil {
+			return state, fmt.Errorf("error updating consensus params: %v", err)
+		}
 
 		state.Version.Consensus.App = nextParams.Version.AppVersion
 
@@ -449,6 +449,63 @@ func TestEndBlockValidatorUpdatesResultingInEmptySet(t *testing.T) {
 	assert.NotEmpty(t, state.NextValidators.Validators)
 }
 
+// TestCreateProposalBlockVoteExtensions ensures the vote extensions of the
+// last commit are delivered to the application preparing the proposal.
+func TestCreateProposalBlockVoteExtensions(t *testing.T) {
+	app := &testApp{}
+	cc := proxy.NewLocalClientCreator(app)
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	state, stateDB, privVals := makeState(7, 2)
+	stateStore := sm.NewStore(stateDB)
+	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyApp.Consensus(),
+		mmock.Mempool{}, sm.EmptyEvidencePool{})
+
+	blockID := makeBlockID([]byte("headerhash"), 1000, []byte("partshash"))
+	lastVotes := types.NewVoteSet(chainID, 1, 0, tmproto.PrecommitType, state.LastValidators)
+	for i, val := range state.LastValidators.Validators {
+		vote := &types.Vote{
+			Type:             tmproto.PrecommitType,
+			Height:           1,
+			BlockID:          blockID,
+			Timestamp:        tmtime.Now(),
+			ValidatorAddress: val.Address,
+			ValidatorIndex:   int32(i),
+		}
+		switch i {
+		case 0:
+			vote.Extension = []byte("extension")
+		case 5:
+			vote.BlockID = types.BlockID{}
+		case 6:
+			continue // absent
+		}
+		v := vote.ToProto()
+		require.NoError(t, privVals[val.Address.String()].SignVote(chainID, v))
+		vote.Signature = v.Signature
+		vote.ExtensionSignature = v.ExtensionSignature
+		require.NoError(t, vote.VerifyExtension(chainID, val.PubKey))
+
+		added, err := lastVotes.AddVote(vote)
+		require.NoError(t, err)
+		require.True(t, added)
+	}
+
+	block, _, err := blockExec.CreateProposalBlock(2, state, lastVotes.MakeCommit(), lastVotes,
+		state.Validators.GetProposer().Address)
+	require.NoError(t, err)
+
+	require.Len(t, app.ExtendedVotes, 7)
+	assert.Equal(t, []byte("extension"), app.ExtendedVotes[0].VoteExtension)
+	assert.True(t, app.ExtendedVotes[5].SignedLastBlock)
+	assert.Empty(t, app.ExtendedVotes[5].VoteExtension)
+	assert.False(t, app.ExtendedVotes[6].SignedLastBlock)
+	assert.Equal(t, types.Txs{types.Tx("extension")}, block.Txs)
+}
+
 func makeBlockID(hash []byte, partSetSize uint32, partSetHash []byte) types.BlockID {
 	var (
 		h   = make([]byte, tmhash.Size)
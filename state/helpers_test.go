@@ -236,6 +236,7 @@ type testApp struct {
 	CommitVotes         []msm.VoteInfo
 	ByzantineValidators []msm.Evidence
 	ValidatorUpdates    []msm.ValidatorUpdate
+	ExtendedVotes       []msm.ExtendedVoteInfo
 }
 
 var _ msm.Application = (*testApp)(nil)
@@ -250,6 +251,18 @@ func (app *testApp) BeginBlock(req msm.RequestBeginBlock) msm.ResponseBeginBlock
 	return msm.ResponseBeginBlock{}
 }
 
+// PrepareProposal proposes the vote extensions of the last commit as txs.
+func (app *testApp) PrepareProposal(req msm.RequestPrepareProposal) msm.ResponsePrepareProposal {
+	app.ExtendedVotes = req.LocalLastCommit.Votes
+	txs := req.Txs
+	for _, vote := range req.LocalLastCommit.Votes {
+		if len(vote.VoteExtension) > 0 {
+			txs = append(txs, vote.VoteExtension)
+		}
+	}
+	return msm.ResponsePrepareProposal{Txs: txs}
+}
+
 func (app *testApp) EndBlock(req msm.RequestEndBlock) msm.ResponseEndBlock {
 	return msm.ResponseEndBlock{
 		ValidatorUpdates: app.ValidatorUpdates,
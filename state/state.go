@@ -245,9 +245,12 @@ func (state State) MakeBlock(
 
 	// Set time.
 	var timestamp time.Time
-	if height == state.InitialHeight {
+	switch {
+	case types.IsPBTSEnabled(state.ConsensusParams, height):
+		timestamp = proposerTime(state.LastBlockTime)
+	case height == state.InitialHeight:
 		timestamp = state.LastBlockTime // genesis time
-	} else {
+	default:
 		timestamp = MedianTime(commit, state.LastValidators)
 	}
 
@@ -263,6 +266,18 @@ func (state State) MakeBlock(
 	return block, block.MakePartSet(types.BlockPartSizeBytes)
 }
 
+// proposerTime returns the time of the local clock for a block proposed after
+// a block at lastBlockTime, or genesis time. Block times must increase, so a
+// proposer whose clock lags behind uses the earliest valid time, which is only
+// accepted by the validators if they find it timely.
+func proposerTime(lastBlockTime time.Time) time.Time {
+	now := tmtime.Now()
+	if !now.After(lastBlockTime) {
+		return lastBlockTime.Add(time.Millisecond)
+	}
+	return now
+}
+
 // MedianTime computes a median time for a given Commit (based on Timestamp field of votes messages) and the
 // corresponding validator set. The computed time is always between timestamps of
 // the votes sent by honest processes, i.e., a faulty processes can not arbitrarily increase or decrease the
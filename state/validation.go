@@ -120,6 +120,11 @@ func validateBlock(state State, block *types.Block) error {
 				state.LastBlockTime,
 			)
 		}
+		// With proposer-based timestamps, the timeliness of the block time is
+		// checked by the validators when prevoting for its proposal.
+		if types.IsPBTSEnabled(state.ConsensusParams, block.Height) {
+			break
+		}
 		medianTime := MedianTime(block.LastCommit, state.LastValidators)
 		if !block.Time.Equal(medianTime) {
 			return fmt.Errorf("invalid block time. Expected %v, got %v",
@@ -130,6 +135,15 @@ func validateBlock(state State, block *types.Block) error {
 
 	case block.Height == state.InitialHeight:
 		genesisTime := state.LastBlockTime
+		if types.IsPBTSEnabled(state.ConsensusParams, block.Height) {
+			if block.Time.Before(genesisTime) {
+				return fmt.Errorf("block time %v is before genesis time %v",
+					block.Time,
+					genesisTime,
+				)
+			}
+			break
+		}
 		if !block.Time.Equal(genesisTime) {
 			return fmt.Errorf("block time %v is not equal to genesis time %v",
 				block.Time,
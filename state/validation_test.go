@@ -93,6 +93,46 @@ func TestValidateBlockHeader(t *testing.T) {
 	}
 }
 
+func TestValidateBlockTimePBTS(t *testing.T) {
+	proxyApp := newTestApp()
+	require.NoError(t, proxyApp.Start())
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	state, stateDB, privVals := makeState(3, 1)
+	state.ConsensusParams.Synchrony.PBTSEnableHeight = 1
+	stateStore := sm.NewStore(stateDB)
+	blockExec := sm.NewBlockExecutor(
+		stateStore,
+		log.TestingLogger(),
+		proxyApp.Consensus(),
+		memmock.Mempool{},
+		sm.EmptyEvidencePool{},
+	)
+	lastCommit := types.NewCommit(0, 0, types.BlockID{}, nil)
+
+	for height := int64(1); height < validationTestsStopHeight; height++ {
+		proposerAddr := state.Validators.GetProposer().Address
+
+		/*
+			A block timestamped before the last block (or genesis) time fails
+		*/
+		block, _ := state.MakeBlock(height, makeTxs(height), lastCommit, nil, proposerAddr)
+		block.Time = state.LastBlockTime.Add(-time.Millisecond)
+		require.Error(t, blockExec.ValidateBlock(state, block), "height %d", height)
+
+		/*
+			A block timestamped by its proposer rather than the median passes
+		*/
+		block, _ = state.MakeBlock(height, makeTxs(height), lastCommit, nil, proposerAddr)
+		block.Time = state.LastBlockTime.Add(time.Hour)
+		require.NoError(t, blockExec.ValidateBlock(state, block), "height %d", height)
+
+		var err error
+		state, _, lastCommit, err = makeAndCommitGoodBlock(state, height, lastCommit, proposerAddr, blockExec, privVals, nil)
+		require.NoError(t, err, "height %d", height)
+	}
+}
+
 func TestValidateBlockCommit(t *testing.T) {
 	proxyApp := newTestApp()
 	require.NoError(t, proxyApp.Start())
@@ -126,12 +126,31 @@ func (bs *BlockStore) LoadBlock(height int64) *types.Block {
 // If no block is found for that hash, it returns nil.
 // Panics if it fails to parse height associated with the given hash.
 func (bs *BlockStore) LoadBlockByHash(hash []byte) *types.Block {
+	height, ok := bs.heightByHash(hash)
+	if !ok {
+		return nil
+	}
+	return bs.LoadBlock(height)
+}
+
+// LoadBlockMetaByHash returns the block meta of the block with the given hash.
+// If no block is found for that hash, it returns nil.
+// Panics if it fails to parse height associated with the given hash.
+func (bs *BlockStore) LoadBlockMetaByHash(hash []byte) *types.BlockMeta {
+	height, ok := bs.heightByHash(hash)
+	if !ok {
+		return nil
+	}
+	return bs.LoadBlockMeta(height)
+}
+
+func (bs *BlockStore) heightByHash(hash []byte) (int64, bool) {
 	bz, err := bs.db.Get(calcBlockHashKey(hash))
 	if err != nil {
 		panic(err)
 	}
 	if len(bz) == 0 {
-		return nil
+		return 0, false
 	}
 
 	s := string(bz)
@@ -140,7 +159,7 @@ func (bs *BlockStore) LoadBlockByHash(hash []byte) *types.Block {
 	if err != nil {
 		panic(fmt.Sprintf("failed to extract height from %s: %v", s, err))
 	}
-	return bs.LoadBlock(height)
+	return height, true
 }
 
 // LoadBlockPart returns the Part at the given index
@@ -297,6 +316,9 @@ func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
 		if err := batch.Delete(calcSeenCommitKey(h)); err != nil {
 			return 0, err
 		}
+		if err := batch.Delete(calcExtendedVotesKey(h)); err != nil {
+			return 0, err
+		}
 		for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
 			if err := batch.Delete(calcBlockPartKey(h, p)); err != nil {
 				return 0, err
@@ -424,6 +446,55 @@ func (bs *BlockStore) SaveSeenCommit(height int64, seenCommit *types.Commit) err
 	return bs.db.Set(calcSeenCommitKey(height), seenCommitBytes)
 }
 
+// SaveExtendedVotes saves the precommits for the block at the given height
+// which carry a vote extension, as the extensions aren't part of the seen
+// commit. Only the extended votes of the last height are kept, since they are
+// only needed to restore the last commit.
+func (bs *BlockStore) SaveExtendedVotes(height int64, votes []*types.Vote) {
+	pbv := &tmstore.ExtendedVotes{Votes: make([]*tmproto.Vote, len(votes))}
+	for i, vote := range votes {
+		pbv.Votes[i] = vote.ToProto()
+	}
+
+	batch := bs.db.NewBatch()
+	defer batch.Close()
+	if err := batch.Set(calcExtendedVotesKey(height), mustEncode(pbv)); err != nil {
+		panic(err)
+	}
+	if err := batch.Delete(calcExtendedVotesKey(height - 1)); err != nil {
+		panic(err)
+	}
+	if err := batch.WriteSync(); err != nil {
+		panic(err)
+	}
+}
+
+// LoadExtendedVotes returns the precommits for the block at the given height
+// which carry a vote extension, or nil if they weren't saved.
+func (bs *BlockStore) LoadExtendedVotes(height int64) []*types.Vote {
+	bz, err := bs.db.Get(calcExtendedVotesKey(height))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return nil
+	}
+	var pbv tmstore.ExtendedVotes
+	if err := proto.Unmarshal(bz, &pbv); err != nil {
+		panic(fmt.Sprintf("error reading extended votes: %v", err))
+	}
+
+	votes := make([]*types.Vote, len(pbv.Votes))
+	for i, pb := range pbv.Votes {
+		vote, err := types.VoteFromProto(pb)
+		if err != nil {
+			panic(fmt.Errorf("error from proto vote: %w", err))
+		}
+		votes[i] = vote
+	}
+	return votes
+}
+
 //-----------------------------------------------------------------------------
 
 func calcBlockMetaKey(height int64) []byte {
@@ -442,6 +513,10 @@ func calcSeenCommitKey(height int64) []byte {
 	return []byte(fmt.Sprintf("SC:%v", height))
 }
 
+func calcExtendedVotesKey(height int64) []byte {
+	return []byte(fmt.Sprintf("EV:%v", height))
+}
+
 func calcBlockHashKey(hash []byte) []byte {
 	return []byte(fmt.Sprintf("BH:%x", hash))
 }
@@ -16,9 +16,11 @@ import (
 
 	cfg "github.com/creatachain/augusteum/config"
 	"github.com/creatachain/augusteum/crypto"
+	"github.com/creatachain/augusteum/crypto/tmhash"
 	"github.com/creatachain/augusteum/libs/log"
 	tmrand "github.com/creatachain/augusteum/libs/rand"
 	tmstore "github.com/creatachain/augusteum/proto/augusteum/store"
+	tmproto "github.com/creatachain/augusteum/proto/augusteum/types"
 	tmversion "github.com/creatachain/augusteum/proto/augusteum/version"
 	sm "github.com/creatachain/augusteum/state"
 	"github.com/creatachain/augusteum/types"
@@ -470,6 +472,8 @@ func TestPruneBlocks(t *testing.T) {
 	require.NotNil(t, bs.LoadBlock(1200))
 	require.Nil(t, bs.LoadBlock(1199))
 	require.Nil(t, bs.LoadBlockByHash(prunedBlock.Hash()))
+	require.Nil(t, bs.LoadBlockMetaByHash(prunedBlock.Hash()))
+	require.Equal(t, bs.LoadBlockMeta(1200), bs.LoadBlockMetaByHash(bs.LoadBlock(1200).Hash()))
 	require.Nil(t, bs.LoadBlockCommit(1199))
 	require.Nil(t, bs.LoadBlockMeta(1199))
 	require.Nil(t, bs.LoadBlockPart(1199, 1))
@@ -509,6 +513,32 @@ func TestPruneBlocks(t *testing.T) {
 	assert.Nil(t, bs.LoadBlock(1501))
 }
 
+func TestSaveLoadExtendedVotes(t *testing.T) {
+	bs, _ := freshBlockStore()
+	require.Nil(t, bs.LoadExtendedVotes(10))
+
+	vote := &types.Vote{
+		Type:             tmproto.PrecommitType,
+		Height:           10,
+		ValidatorAddress: tmrand.Bytes(crypto.AddressSize),
+		Timestamp:        tmtime.Now(),
+		BlockID: types.BlockID{
+			Hash:          tmrand.Bytes(tmhash.Size),
+			PartSetHeader: types.PartSetHeader{Total: 1, Hash: tmrand.Bytes(tmhash.Size)},
+		},
+		Signature:          []byte("Signature"),
+		Extension:          []byte("Extension"),
+		ExtensionSignature: []byte("ExtensionSignature"),
+	}
+	bs.SaveExtendedVotes(10, []*types.Vote{vote})
+	assert.Equal(t, []*types.Vote{vote}, bs.LoadExtendedVotes(10))
+
+	// only the extended votes of the last height are kept
+	bs.SaveExtendedVotes(11, nil)
+	assert.Nil(t, bs.LoadExtendedVotes(10))
+	assert.Empty(t, bs.LoadExtendedVotes(11))
+}
+
 func TestLoadBlockMeta(t *testing.T) {
 	bs, db := freshBlockStore()
 	height := int64(10)
@@ -15,6 +15,10 @@ type Manifest struct {
 	// InitialHeight specifies the initial block height, set in genesis. Defaults to 1.
 	InitialHeight int64 `toml:"initial_height"`
 
+	// PBTSEnableHeight sets the height from which proposer-based timestamps
+	// are used, in the genesis consensus params. Defaults to 0 (disabled).
+	PBTSEnableHeight int64 `toml:"pbts_enable_height"`
+
 	// InitialState is an initial set of key/value pairs for the application,
 	// set in genesis. Defaults to nothing.
 	InitialState map[string]string `toml:"initial_state"`
@@ -87,7 +91,7 @@ type ManifestNode struct {
 	// runner will wait for the network to reach at least this block height.
 	StartAt int64 `toml:"start_at"`
 
-	// FastSync specifies the fast sync mode: "" (disable), "v0", "v1", or "v2".
+	// FastSync specifies the fast sync mode: "" (disable), "v0", "v1", "v2" or "v3".
 	// Defaults to disabled.
 	FastSync string `toml:"fast_sync"`
 
@@ -54,6 +54,7 @@ type Testnet struct {
 	Dir              string
 	IP               *net.IPNet
 	InitialHeight    int64
+	PBTSEnableHeight int64
 	InitialState     map[string]string
 	Validators       map[*Node]int64
 	ValidatorUpdates map[int64]map[*Node]int64
@@ -117,6 +118,7 @@ func LoadTestnet(file string) (*Testnet, error) {
 		Dir:              dir,
 		IP:               ipGen.Network(),
 		InitialHeight:    1,
+		PBTSEnableHeight: manifest.PBTSEnableHeight,
 		InitialState:     manifest.InitialState,
 		Validators:       map[*Node]int64{},
 		ValidatorUpdates: map[int64]map[*Node]int64{},
@@ -267,6 +269,9 @@ func (t Testnet) Validate() error {
 	if len(t.Nodes) == 0 {
 		return errors.New("network has no nodes")
 	}
+	if t.PBTSEnableHeight < 0 {
+		return fmt.Errorf("invalid PBTS enable height %d", t.PBTSEnableHeight)
+	}
 	for _, node := range t.Nodes {
 		if err := node.Validate(t); err != nil {
 			return fmt.Errorf("invalid node %q: %w", node.Name, err)
@@ -297,7 +302,7 @@ func (n Node) Validate(testnet Testnet) error {
 		}
 	}
 	switch n.FastSync {
-	case "", "v0", "v1", "v2":
+	case "", "v0", "v1", "v2", "v3":
 	default:
 		return fmt.Errorf("invalid fast sync setting %q", n.FastSync)
 	}
@@ -25,6 +25,12 @@ func TestEvidence_Misbehavior(t *testing.T) {
 					if bytes.Equal(evidence.VoteA.ValidatorAddress, node.PrivvalKey.PubKey().Address()) {
 						nodeEvidence = evidence
 					}
+				case *types.LightClientAttackEvidence:
+					for _, val := range evidence.ByzantineValidators {
+						if bytes.Equal(val.Address, node.PrivvalKey.PubKey().Address()) {
+							nodeEvidence = evidence
+						}
+					}
 				default:
 					t.Fatalf("unexpected evidence type %T", evidence)
 				}
@@ -33,22 +39,32 @@ func TestEvidence_Misbehavior(t *testing.T) {
 				continue // no evidence for the node at this height
 			}
 
-			// Check that evidence was as expected
-			misbehavior, ok := node.Misbehaviors[nodeEvidence.Height()]
+			// Check that evidence was as expected. Light client attack evidence
+			// is at its common height, below the height of the misbehavior.
+			misbehaviorHeight := nodeEvidence.Height()
+			if ev, ok := nodeEvidence.(*types.LightClientAttackEvidence); ok {
+				misbehaviorHeight = ev.ConflictingBlock.Height
+			}
+			misbehavior, ok := node.Misbehaviors[misbehaviorHeight]
 			require.True(t, ok, "found unexpected evidence %v in height %v",
 				nodeEvidence, block.Height)
 
 			switch misbehavior {
 			case "double-prevote":
 				require.IsType(t, &types.DuplicateVoteEvidence{}, nodeEvidence, "unexpected evidence type")
+			case "lunatic-light-block", "equivocating-light-block":
+				require.IsType(t, &types.LightClientAttackEvidence{}, nodeEvidence, "unexpected evidence type")
 			default:
 				t.Fatalf("unknown misbehavior %v", misbehavior)
 			}
 
-			seenEvidence[nodeEvidence.Height()] = struct{}{}
+			seenEvidence[misbehaviorHeight] = struct{}{}
 		}
 		// see if there is any evidence that we were expecting but didn't see
 		for height, misbehavior := range node.Misbehaviors {
+			if misbehavior == "untimely-proposal" {
+				continue // untimely proposals are rejected, but aren't evidence
+			}
 			_, ok := seenEvidence[height]
 			require.True(t, ok, "expected evidence for %v misbehavior at height %v by node but was never found",
 				misbehavior, height)
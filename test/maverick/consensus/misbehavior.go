@@ -2,16 +2,24 @@ package consensus
 
 import (
 	"fmt"
+	"time"
 
 	tmcon "github.com/creatachain/augusteum/consensus"
 	cstypes "github.com/creatachain/augusteum/consensus/types"
+	"github.com/creatachain/augusteum/crypto"
+	"github.com/creatachain/augusteum/crypto/ed25519"
+	"github.com/creatachain/augusteum/crypto/tmhash"
 	tmproto "github.com/creatachain/augusteum/proto/augusteum/types"
 	"github.com/creatachain/augusteum/types"
+	tmtime "github.com/creatachain/augusteum/types/time"
 )
 
 // MisbehaviorList encompasses a list of all possible behaviors
 var MisbehaviorList = map[string]Misbehavior{
-	"double-prevote": DoublePrevoteMisbehavior(),
+	"double-prevote":           DoublePrevoteMisbehavior(),
+	"untimely-proposal":        UntimelyProposalMisbehavior(),
+	"lunatic-light-block":      LunaticLightBlockMisbehavior(),
+	"equivocating-light-block": EquivocatingLightBlockMisbehavior(),
 }
 
 type Misbehavior struct {
@@ -106,12 +114,204 @@ func DoublePrevoteMisbehavior() Misbehavior {
 	return b
 }
 
+// UntimelyProposalMisbehavior will make a node, when it's the proposer, propose
+// a new block whose time is ahead of its clock by more than the synchrony
+// precision. With proposer-based timestamps, correct validators prevote nil.
+func UntimelyProposalMisbehavior() Misbehavior {
+	b := DefaultMisbehavior()
+	b.Name = "untimely-proposal"
+	b.EnterPropose = func(cs *State, height int64, round int32) {
+		// If we don't get the proposal and all block parts quick enough, enterPrevote
+		cs.scheduleTimeout(cs.proposeTimeout(round), height, round, cstypes.RoundStepPropose)
+
+		if cs.privValidator == nil {
+			return
+		}
+		pubKey, err := cs.privValidator.GetPubKey()
+		if err != nil {
+			cs.Logger.Error("Error on retrival of pubkey", "err", err)
+			return
+		}
+		if !cs.isProposer(pubKey.Address()) {
+			return
+		}
+
+		block, _ := cs.createProposalBlock()
+		if block == nil {
+			return
+		}
+		block.Time = block.Time.Add(2*cs.state.ConsensusParams.Synchrony.Precision + time.Second)
+		blockParts := block.MakePartSet(types.BlockPartSizeBytes)
+
+		if err := cs.wal.FlushAndSync(); err != nil {
+			cs.Logger.Error("Error flushing to disk")
+		}
+
+		propBlockID := types.BlockID{Hash: block.Hash(), PartSetHeader: blockParts.Header()}
+		proposal := types.NewProposal(height, round, -1, propBlockID)
+		proposal.Timestamp = block.Time
+		p := proposal.ToProto()
+		if err := cs.privValidator.SignProposal(cs.state.ChainID, p); err != nil {
+			cs.Logger.Error("enterPropose: Error signing proposal", "height", height, "round", round, "err", err)
+			return
+		}
+		proposal.Signature = p.Signature
+
+		cs.Logger.Info("Sending untimely proposal", "proposal", proposal, "now", tmtime.Now())
+		cs.sendInternalMessage(msgInfo{&tmcon.ProposalMessage{Proposal: proposal}, ""})
+		for i := 0; i < int(blockParts.Total()); i++ {
+			part := blockParts.GetPart(i)
+			cs.sendInternalMessage(msgInfo{&tmcon.BlockPartMessage{Height: cs.Height, Round: cs.Round, Part: part}, ""})
+		}
+	}
+	return b
+}
+
+// LunaticLightBlockMisbehavior will make a node, once it precommits, sign a
+// header conflicting with the proposal block of the height together with a
+// made up validator, replacing the validator set and the app hash. The header
+// is sent to peers as a light block once the chain moved past the height. Full
+// nodes only turn it into evidence if the node holds at least 1/3 of the
+// voting power.
+func LunaticLightBlockMisbehavior() Misbehavior {
+	b := DefaultMisbehavior()
+	b.Name = "lunatic-light-block"
+	b.EnterPrecommit = func(cs *State, height int64, round int32) {
+		defaultEnterPrecommit(cs, height, round)
+
+		if cs.ProposalBlock == nil || cs.privValidatorPubKey == nil {
+			return
+		}
+		_, val := cs.Validators.GetByAddress(cs.privValidatorPubKey.Address())
+		if val == nil {
+			return
+		}
+		fakeKey := ed25519.GenPrivKey()
+		vals := types.NewValidatorSet([]*types.Validator{
+			types.NewValidator(cs.privValidatorPubKey, val.VotingPower),
+			types.NewValidator(fakeKey.PubKey(), val.VotingPower),
+		})
+
+		header := cs.ProposalBlock.Header
+		header.ValidatorsHash = vals.Hash()
+		header.NextValidatorsHash = vals.Hash()
+		header.AppHash = crypto.CRandBytes(tmhash.Size)
+		sendConflictingLightBlock(cs, round, &header, vals, fakeKey)
+	}
+	return b
+}
+
+// EquivocatingLightBlockMisbehavior will make a node, once it precommits, sign
+// a correctly derived header conflicting with the proposal block of the height,
+// differing only by its time. The header is sent to peers as a light block
+// once the chain moved past the height. Full nodes only turn it into evidence
+// if the node holds more than 2/3 of the voting power.
+func EquivocatingLightBlockMisbehavior() Misbehavior {
+	b := DefaultMisbehavior()
+	b.Name = "equivocating-light-block"
+	b.EnterPrecommit = func(cs *State, height int64, round int32) {
+		defaultEnterPrecommit(cs, height, round)
+
+		if cs.ProposalBlock == nil || cs.privValidatorPubKey == nil {
+			return
+		}
+		header := cs.ProposalBlock.Header
+		header.Time = header.Time.Add(time.Millisecond)
+		sendConflictingLightBlock(cs, round, &header, cs.Validators.Copy())
+	}
+	return b
+}
+
+// sendConflictingLightBlock signs the header in the given round with our
+// validator and the given keys, and sends the resulting light block to all
+// peers once the chain is two heights past it, by when they should hold the
+// commit of the header height.
+func sendConflictingLightBlock(cs *State, round int32, header *types.Header, vals *types.ValidatorSet,
+	keys ...crypto.PrivKey) {
+	if cs.sw == nil {
+		cs.Logger.Error("nil switch")
+		return
+	}
+
+	blockID := types.BlockID{
+		Hash:          header.Hash(),
+		PartSetHeader: types.PartSetHeader{Total: 1, Hash: crypto.CRandBytes(tmhash.Size)},
+	}
+	sigs := make([]types.CommitSig, vals.Size())
+	for i := range sigs {
+		sigs[i] = types.NewCommitSigAbsent()
+	}
+	sign := func(addr types.Address, signFn func(*tmproto.Vote) error) error {
+		idx, _ := vals.GetByAddress(addr)
+		if idx < 0 {
+			return fmt.Errorf("%X is not in the validator set", addr)
+		}
+		vote := &types.Vote{
+			Type:             tmproto.PrecommitType,
+			Height:           header.Height,
+			Round:            round,
+			BlockID:          blockID,
+			Timestamp:        header.Time,
+			ValidatorAddress: addr,
+			ValidatorIndex:   idx,
+		}
+		v := vote.ToProto()
+		if err := signFn(v); err != nil {
+			return err
+		}
+		sigs[idx] = types.NewCommitSigForBlock(v.Signature, addr, vote.Timestamp)
+		return nil
+	}
+
+	err := sign(cs.privValidatorPubKey.Address(), func(v *tmproto.Vote) error {
+		return cs.privValidator.SignVote(header.ChainID, v)
+	})
+	if err != nil {
+		cs.Logger.Error("Unable to sign conflicting header", "err", err)
+		return
+	}
+	for _, key := range keys {
+		key := key
+		err := sign(key.PubKey().Address(), func(v *tmproto.Vote) (err error) {
+			v.Signature, err = key.Sign(types.VoteSignBytes(header.ChainID, v))
+			return err
+		})
+		if err != nil {
+			cs.Logger.Error("Unable to sign conflicting header", "err", err)
+			return
+		}
+	}
+
+	lb := &types.LightBlock{
+		SignedHeader: &types.SignedHeader{
+			Header: header,
+			Commit: types.NewCommit(header.Height, round, blockID, sigs),
+		},
+		ValidatorSet: vals,
+	}
+	go func() {
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+		for cs.blockStore.Height() <= header.Height+1 {
+			select {
+			case <-ticker.C:
+			case <-cs.Quit():
+				return
+			}
+		}
+		cs.Logger.Info("Sending conflicting light block", "height", header.Height, "hash", lb.Hash())
+		for _, peer := range cs.sw.Peers().List() {
+			peer.Send(DataChannel, tmcon.MustEncode(&tmcon.LightBlockMessage{LightBlock: lb}))
+		}
+	}()
+}
+
 // DEFAULTS
 
 func defaultEnterPropose(cs *State, height int64, round int32) {
 	logger := cs.Logger.With("height", height, "round", round)
 	// If we don't get the proposal and all block parts quick enough, enterPrevote
-	cs.scheduleTimeout(cs.config.Propose(round), height, round, cstypes.RoundStepPropose)
+	cs.scheduleTimeout(cs.proposeTimeout(round), height, round, cstypes.RoundStepPropose)
 
 	// Nothing more to do if we're not a validator
 	if cs.privValidator == nil {
@@ -173,6 +373,12 @@ func defaultEnterPrevote(cs *State, height int64, round int32) {
 		return
 	}
 
+	if err := cs.checkProposalTime(); err != nil {
+		logger.Info("enterPrevote: proposal time is invalid", "err", err)
+		cs.signAddVote(tmproto.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+
 	// Prevote cs.ProposalBlock
 	// NOTE: the proposal signature is validated when it is received,
 	// and the proposal block parts are validated as they are received (against the merkle hash in the proposal)
@@ -348,7 +554,7 @@ func defaultReceivePrecommit(cs *State, vote *types.Vote) {
 		cs.enterPrecommit(height, vote.Round)
 		if len(blockID.Hash) != 0 {
 			cs.enterCommit(height, vote.Round)
-			if cs.config.SkipTimeoutCommit && precommits.HasAll() {
+			if cs.bypassCommitTimeout() && precommits.HasAll() {
 				cs.enterNewRound(cs.Height, 0)
 			}
 		} else {
@@ -387,6 +593,7 @@ func defaultReceiveProposal(cs *State, proposal *types.Proposal) error {
 
 	proposal.Signature = p.Signature
 	cs.Proposal = proposal
+	cs.ProposalReceiveTime = tmtime.Now()
 	// We don't update cs.ProposalBlockParts if it is already set.
 	// This happens if we're already in cstypes.RoundStepCommit or if there is a valid block in the current round.
 	// TODO: We can check if Proposal is for a different block as this is a sign of misbehavior!
@@ -367,6 +367,9 @@ func (cs *State) addVote(
 			cs.Logger.Debug("Precommit vote came in after commit timeout and has been ignored", "vote", vote)
 			return
 		}
+		if err := cs.verifyVoteExtension(vote, cs.state.LastValidators); err != nil {
+			return false, err
+		}
 		added, err = cs.LastCommit.AddVote(vote)
 		if !added {
 			return
@@ -377,7 +380,7 @@ func (cs *State) addVote(
 		cs.evsw.FireEvent(types.EventVote, vote)
 
 		// if we can skip timeoutCommit and have all the votes now,
-		if cs.config.SkipTimeoutCommit && cs.LastCommit.HasAll() {
+		if cs.bypassCommitTimeout() && cs.LastCommit.HasAll() {
 			// go straight to new round (skip timeout commit)
 			// cs.scheduleTimeout(time.Duration(0), cs.Height, 0, cstypes.RoundStepNewHeight)
 			cs.enterNewRound(cs.Height, 0)
@@ -393,6 +396,10 @@ func (cs *State) addVote(
 		return
 	}
 
+	if err := cs.verifyVoteExtension(vote, cs.Validators); err != nil {
+		return false, err
+	}
+
 	added, err = cs.Votes.AddVote(vote, peerID)
 	if !added {
 		// Either duplicate, or error upon cs.Votes.AddByIndex()
@@ -533,6 +540,12 @@ func (cs *State) GetRoundStateSimpleJSON() ([]byte, error) {
 	return tmjson.Marshal(cs.RoundState.RoundStateSimple())
 }
 
+// GetHeightSummaries implements the consensus state of the RPC. Misbehaving
+// nodes don't keep round summaries.
+func (cs *State) GetHeightSummaries(limit int) []cstypes.HeightSummary {
+	return nil
+}
+
 // GetValidators returns a copy of the current validators.
 func (cs *State) GetValidators() (int64, []*types.Validator) {
 	cs.mtx.RLock()
@@ -789,6 +802,56 @@ func (cs *State) scheduleTimeout(duration time.Duration, height int64, round int
 	cs.timeoutTicker.ScheduleTimeout(timeoutInfo{duration, height, round, step})
 }
 
+// proposeTimeout returns how long to wait for a proposal in the given round.
+// Timeouts set in the consensus params take precedence over the local config.
+func (cs *State) proposeTimeout(round int32) time.Duration {
+	tp := cs.state.ConsensusParams.Timeout
+	return roundTimeout(tp.Propose, tp.ProposeDelta, cs.config.TimeoutPropose, cs.config.TimeoutProposeDelta, round)
+}
+
+// prevoteTimeout returns how long to wait after receiving +2/3 prevotes for
+// "anything" in the given round.
+func (cs *State) prevoteTimeout(round int32) time.Duration {
+	tp := cs.state.ConsensusParams.Timeout
+	return roundTimeout(tp.Prevote, tp.PrevoteDelta, cs.config.TimeoutPrevote, cs.config.TimeoutPrevoteDelta, round)
+}
+
+// precommitTimeout returns how long to wait after receiving +2/3 precommits
+// for "anything" in the given round.
+func (cs *State) precommitTimeout(round int32) time.Duration {
+	tp := cs.state.ConsensusParams.Timeout
+	return roundTimeout(tp.Precommit, tp.PrecommitDelta, cs.config.TimeoutPrecommit, cs.config.TimeoutPrecommitDelta,
+		round)
+}
+
+// commitTime returns when to start the next height after committing a block
+// at time t.
+func (cs *State) commitTime(t time.Time) time.Time {
+	if timeout := cs.state.ConsensusParams.Timeout.Commit; timeout != nil {
+		return t.Add(*timeout)
+	}
+	return cs.config.Commit(t)
+}
+
+// bypassCommitTimeout returns true if we should move on to the next height as
+// soon as we have all the precommits.
+func (cs *State) bypassCommitTimeout() bool {
+	if bypass := cs.state.ConsensusParams.Timeout.BypassCommitTimeout; bypass != nil {
+		return *bypass
+	}
+	return cs.config.SkipTimeoutCommit
+}
+
+func roundTimeout(timeout, delta *time.Duration, defaultTimeout, defaultDelta time.Duration, round int32) time.Duration {
+	if timeout != nil {
+		defaultTimeout = *timeout
+	}
+	if delta != nil {
+		defaultDelta = *delta
+	}
+	return defaultTimeout + defaultDelta*time.Duration(round)
+}
+
 // send a msg into the receiveRoutine regarding our own proposal, block part, or vote
 func (cs *State) sendInternalMessage(mi msgInfo) {
 	select {
@@ -817,6 +880,15 @@ func (cs *State) reconstructLastCommit(state sm.State) {
 		panic("Failed to reconstruct LastCommit: Does not have +2/3 maj")
 	}
 
+	// restore the vote extensions, which aren't part of the seen commit
+	for _, vote := range cs.blockStore.LoadExtendedVotes(state.LastBlockHeight) {
+		v := lastPrecommits.GetByIndex(vote.ValidatorIndex)
+		if v != nil && bytes.Equal(v.Signature, vote.Signature) {
+			v.Extension = vote.Extension
+			v.ExtensionSignature = vote.ExtensionSignature
+		}
+	}
+
 	cs.LastCommit = lastPrecommits
 }
 
@@ -887,19 +959,9 @@ func (cs *State) updateToState(state sm.State) {
 	// RoundState fields
 	cs.updateHeight(height)
 	cs.updateRoundStep(0, cstypes.RoundStepNewHeight)
-	if cs.CommitTime.IsZero() {
-		// "Now" makes it easier to sync up dev nodes.
-		// We add timeoutCommit to allow transactions
-		// to be gathered for the first block.
-		// And alternative solution that relies on clocks:
-		// cs.StartTime = state.LastBlockTime.Add(timeoutCommit)
-		cs.StartTime = cs.config.Commit(tmtime.Now())
-	} else {
-		cs.StartTime = cs.config.Commit(cs.CommitTime)
-	}
-
 	cs.Validators = validators
 	cs.Proposal = nil
+	cs.ProposalReceiveTime = time.Time{}
 	cs.ProposalBlock = nil
 	cs.ProposalBlockParts = nil
 	cs.LockedRound = -1
@@ -915,6 +977,18 @@ func (cs *State) updateToState(state sm.State) {
 
 	cs.state = state
 
+	// The commit timeout is taken from the params of the new state.
+	if cs.CommitTime.IsZero() {
+		// "Now" makes it easier to sync up dev nodes.
+		// We add timeoutCommit to allow transactions
+		// to be gathered for the first block.
+		// And alternative solution that relies on clocks:
+		// cs.StartTime = state.LastBlockTime.Add(timeoutCommit)
+		cs.StartTime = cs.commitTime(tmtime.Now())
+	} else {
+		cs.StartTime = cs.commitTime(cs.CommitTime)
+	}
+
 	// Finally, broadcast RoundState
 	cs.newStep()
 }
@@ -1138,6 +1212,7 @@ func (cs *State) enterNewRound(height int64, round int32) {
 	} else {
 		logger.Info("Resetting Proposal info")
 		cs.Proposal = nil
+		cs.ProposalReceiveTime = time.Time{}
 		cs.ProposalBlock = nil
 		cs.ProposalBlockParts = nil
 	}
@@ -1177,6 +1252,28 @@ func (cs *State) needProofBlock(height int64) bool {
 	return !bytes.Equal(cs.state.AppHash, lastBlockMeta.Header.AppHash)
 }
 
+// checkProposalTime checks, with proposer-based timestamps, that the proposal
+// carries the time of its block and, unless it re-proposes a block with a POL,
+// was received within the synchrony bounds of that time.
+func (cs *State) checkProposalTime() error {
+	if !types.IsPBTSEnabled(cs.state.ConsensusParams, cs.Height) {
+		return nil
+	}
+	if cs.Proposal == nil {
+		return errors.New("missing proposal for the proposal block")
+	}
+	if !cs.Proposal.Timestamp.Equal(cs.ProposalBlock.Time) {
+		return fmt.Errorf("proposal time %v differs from block time %v",
+			cs.Proposal.Timestamp, cs.ProposalBlock.Time)
+	}
+	if cs.Proposal.POLRound == -1 &&
+		!cs.Proposal.IsTimely(cs.ProposalReceiveTime, cs.state.ConsensusParams.Synchrony) {
+		return fmt.Errorf("proposal with time %v received at %v is not timely",
+			cs.Proposal.Timestamp, cs.ProposalReceiveTime)
+	}
+	return nil
+}
+
 func (cs *State) isProposer(address []byte) bool {
 	return bytes.Equal(cs.Validators.GetProposer().Address, address)
 }
@@ -1206,6 +1303,9 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 	// Make proposal
 	propBlockID := types.BlockID{Hash: block.Hash(), PartSetHeader: blockParts.Header()}
 	proposal := types.NewProposal(height, round, cs.ValidRound, propBlockID)
+	if types.IsPBTSEnabled(cs.state.ConsensusParams, height) {
+		proposal.Timestamp = block.Time
+	}
 	p := proposal.ToProto()
 	if err := cs.privValidator.SignProposal(cs.state.ChainID, p); err == nil {
 		proposal.Signature = p.Signature
@@ -1273,7 +1373,17 @@ func (cs *State) createProposalBlock() (block *types.Block, blockParts *types.Pa
 	}
 	proposerAddr := cs.privValidatorPubKey.Address()
 
-	return cs.blockExec.CreateProposalBlock(cs.Height, cs.state, commit, proposerAddr)
+	var lastVotes *types.VoteSet
+	if cs.Height > cs.state.InitialHeight {
+		lastVotes = cs.LastCommit
+	}
+
+	block, blockParts, err := cs.blockExec.CreateProposalBlock(cs.Height, cs.state, commit, lastVotes, proposerAddr)
+	if err != nil {
+		cs.Logger.Error("propose step; failed to create proposal block", "err", err)
+		return nil, nil
+	}
+	return block, blockParts
 }
 
 // Enter: any +2/3 prevotes at next round.
@@ -1303,7 +1413,7 @@ func (cs *State) enterPrevoteWait(height int64, round int32) {
 	}()
 
 	// Wait for some more prevotes; enterPrecommit
-	cs.scheduleTimeout(cs.config.Prevote(round), height, round, cstypes.RoundStepPrevoteWait)
+	cs.scheduleTimeout(cs.prevoteTimeout(round), height, round, cstypes.RoundStepPrevoteWait)
 }
 
 // Enter: any +2/3 precommits for next round.
@@ -1330,7 +1440,7 @@ func (cs *State) enterPrecommitWait(height int64, round int32) {
 	}()
 
 	// Wait for some more precommits; enterNewRound
-	cs.scheduleTimeout(cs.config.Precommit(round), height, round, cstypes.RoundStepPrecommitWait)
+	cs.scheduleTimeout(cs.precommitTimeout(round), height, round, cstypes.RoundStepPrecommitWait)
 }
 
 // Enter: +2/3 precommits for block
@@ -1472,6 +1582,7 @@ func (cs *State) finalizeCommit(height int64) {
 		precommits := cs.Votes.Precommits(cs.CommitRound)
 		seenCommit := precommits.MakeCommit()
 		cs.blockStore.SaveBlock(block, blockParts, seenCommit)
+		cs.blockStore.SaveExtendedVotes(block.Height, extendedVotes(precommits))
 	} else {
 		// Happens during replay if we already saved the block but didn't commit
 		cs.Logger.Debug("calling finalizeCommit on already stored block", "height", block.Height)
@@ -1787,6 +1898,39 @@ func (cs *State) tryAddVote(vote *types.Vote, peerID p2p.ID) (bool, error) {
 
 //-----------------------------------------------------------------------------
 
+// verifyVoteExtension checks that a precommit for a block carries an extension
+// signed by its validator in valSet and, unless it's our own vote, accepted by
+// the application. Votes with an unknown validator are left to the vote set.
+func (cs *State) verifyVoteExtension(vote *types.Vote, valSet *types.ValidatorSet) error {
+	if !types.IsExtendableVote(vote.ToProto()) {
+		return nil
+	}
+	_, val := valSet.GetByIndex(vote.ValidatorIndex)
+	if val == nil {
+		return nil
+	}
+	if err := vote.VerifyExtension(cs.state.ChainID, val.PubKey); err != nil {
+		return err
+	}
+	if cs.privValidatorPubKey != nil && bytes.Equal(vote.ValidatorAddress, cs.privValidatorPubKey.Address()) {
+		return nil
+	}
+	return cs.blockExec.VerifyVoteExtension(vote)
+}
+
+// extendedVotes returns the precommits for a block which carry a vote
+// extension.
+func extendedVotes(precommits *types.VoteSet) []*types.Vote {
+	var votes []*types.Vote
+	for i := int32(0); i < int32(precommits.Size()); i++ {
+		vote := precommits.GetByIndex(i)
+		if vote != nil && len(vote.ExtensionSignature) > 0 && !vote.BlockID.IsZero() {
+			votes = append(votes, vote)
+		}
+	}
+	return votes
+}
+
 // CONTRACT: cs.privValidator is not nil.
 func (cs *State) signVote(
 	msgType tmproto.SignedMsgType,
@@ -1815,8 +1959,17 @@ func (cs *State) signVote(
 		BlockID:          types.BlockID{Hash: hash, PartSetHeader: header},
 	}
 	v := vote.ToProto()
+	if types.IsExtendableVote(v) {
+		ext, err := cs.blockExec.ExtendVote(vote)
+		if err != nil {
+			return nil, err
+		}
+		v.Extension = ext
+	}
 	err := cs.privValidator.SignVote(cs.state.ChainID, v)
 	vote.Signature = v.Signature
+	vote.Extension = v.Extension
+	vote.ExtensionSignature = v.ExtensionSignature
 
 	return vote, err
 }
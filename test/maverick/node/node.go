@@ -21,10 +21,12 @@ import (
 	bcv0 "github.com/creatachain/augusteum/blockchain/v0"
 	bcv1 "github.com/creatachain/augusteum/blockchain/v1"
 	bcv2 "github.com/creatachain/augusteum/blockchain/v2"
+	bcv3 "github.com/creatachain/augusteum/blockchain/v3"
 	cfg "github.com/creatachain/augusteum/config"
 	"github.com/creatachain/augusteum/consensus"
 	"github.com/creatachain/augusteum/crypto"
 	"github.com/creatachain/augusteum/evidence"
+	"github.com/creatachain/augusteum/libs/autofile"
 	tmjson "github.com/creatachain/augusteum/libs/json"
 	"github.com/creatachain/augusteum/libs/log"
 	tmpubsub "github.com/creatachain/augusteum/libs/pubsub"
@@ -412,6 +414,8 @@ func createBlockchainReactor(config *cfg.Config,
 		bcReactor = bcv1.NewBlockchainReactor(state.Copy(), blockExec, blockStore, fastSync)
 	case "v2":
 		bcReactor = bcv2.NewBlockchainReactor(state.Copy(), blockExec, blockStore, fastSync)
+	case "v3":
+		bcReactor = bcv3.NewBlockchainReactor(state.Copy(), blockExec, blockStore, fastSync)
 	default:
 		return nil, fmt.Errorf("unknown fastsync version %s", config.FastSync.Version)
 	}
@@ -515,10 +519,15 @@ func createTransport(
 		)
 	}
 
+	// In validator mode, only the sentries may connect.
+	if config.P2P.Mode == cfg.P2PModeValidator {
+		peerFilters = append(peerFilters, sentryPeerFilter(config.P2P))
+	}
+
 	p2p.MultiplexTransportConnFilters(connFilters...)(transport)
 
 	// Limit the number of incoming connections.
-	max := config.P2P.MaxNumInboundPeers + len(splitAndTrimEmpty(config.P2P.UnconditionalPeerIDs, ",", " "))
+	max := config.P2P.MaxNumInboundPeers + len(unconditionalPeerIDs(config.P2P))
 	p2p.MultiplexTransportMaxIncomingConnections(max)(transport)
 
 	return transport, peerFilters
@@ -535,14 +544,23 @@ func createSwitch(config *cfg.Config,
 	evidenceReactor *evidence.Reactor,
 	nodeInfo p2p.NodeInfo,
 	nodeKey *p2p.NodeKey,
-	p2pLogger log.Logger) *p2p.Switch {
+	p2pLogger log.Logger) (*p2p.Switch, error) {
 
-	sw := p2p.NewSwitch(
-		config.P2P,
-		transport,
+	options := []p2p.SwitchOption{
 		p2p.WithMetrics(p2pMetrics),
 		p2p.SwitchPeerFilters(peerFilters...),
-	)
+	}
+	if captureFile := config.P2P.CaptureFilePath(); captureFile != "" {
+		capture, err := p2p.NewCapture(captureFile, autofile.GroupTotalSizeLimit(config.P2P.CaptureMaxSize))
+		if err != nil {
+			return nil, fmt.Errorf("could not create p2p capture: %w", err)
+		}
+		capture.SetLogger(p2pLogger.With("capture", captureFile))
+		options = append(options, p2p.SwitchCapture(capture))
+		p2pLogger.Info("Capturing p2p messages", "file", captureFile)
+	}
+
+	sw := p2p.NewSwitch(config.P2P, transport, options...)
 	sw.SetLogger(p2pLogger)
 	sw.AddReactor("MEMPOOL", mempoolReactor)
 	sw.AddReactor("BLOCKCHAIN", bcReactor)
@@ -554,7 +572,7 @@ func createSwitch(config *cfg.Config,
 	sw.SetNodeKey(nodeKey)
 
 	p2pLogger.Info("P2P Node ID", "ID", nodeKey.ID(), "file", config.NodeKeyFile())
-	return sw
+	return sw, nil
 }
 
 func createAddrBookAndSetOnSwitch(config *cfg.Config, sw *p2p.Switch,
@@ -599,6 +617,7 @@ func createPEXReactorAndAddToSwitch(addrBook pex.AddrBook, config *cfg.Config,
 			// https://github.com/creatachain/augusteum/issues/3523
 			SeedDisconnectWaitPeriod:     28 * time.Hour,
 			PersistentPeersMaxDialPeriod: config.P2P.PersistentPeersMaxDialPeriod,
+			DNSSeedRefreshPeriod:         config.P2P.DNSSeedRefreshPeriod,
 		})
 	pexReactor.SetLogger(logger.With("module", "pex"))
 	sw.AddReactor("PEX", pexReactor)
@@ -797,7 +816,8 @@ func NewNode(config *cfg.Config,
 		config.StateSync.TempDir)
 	stateSyncReactor.SetLogger(logger.With("module", "statesync"))
 
-	nodeInfo, err := makeNodeInfo(config, nodeKey, txIndexer, genDoc, state)
+	nodeInfo, err := makeNodeInfo(config, nodeKey, txIndexer, genDoc, state,
+		mempoolReactor, bcReactor, stateSyncReactor, consensusReactor, evidenceReactor)
 	if err != nil {
 		return nil, err
 	}
@@ -807,17 +827,20 @@ func NewNode(config *cfg.Config,
 
 	// Setup Switch.
 	p2pLogger := logger.With("module", "p2p")
-	sw := createSwitch(
+	sw, err := createSwitch(
 		config, transport, p2pMetrics, peerFilters, mempoolReactor, bcReactor,
 		stateSyncReactor, consensusReactor, evidenceReactor, nodeInfo, nodeKey, p2pLogger,
 	)
+	if err != nil {
+		return nil, err
+	}
 
 	err = sw.AddPersistentPeers(splitAndTrimEmpty(config.P2P.PersistentPeers, ",", " "))
 	if err != nil {
 		return nil, fmt.Errorf("could not add peers from persistent_peers field: %w", err)
 	}
 
-	err = sw.AddUnconditionalPeerIDs(splitAndTrimEmpty(config.P2P.UnconditionalPeerIDs, ",", " "))
+	err = sw.AddUnconditionalPeerIDs(unconditionalPeerIDs(config.P2P))
 	if err != nil {
 		return nil, fmt.Errorf("could not add peer ids from unconditional_peer_ids field: %w", err)
 	}
@@ -840,7 +863,7 @@ func NewNode(config *cfg.Config,
 	// If PEX is on, it should handle dialing the seeds. Otherwise the switch does it.
 	// Note we currently use the addrBook regardless at least for AddOurAddress
 	var pexReactor *pex.Reactor
-	if config.P2P.PexReactor {
+	if config.P2P.PexEnabled() {
 		pexReactor = createPEXReactorAndAddToSwitch(addrBook, config, sw, logger)
 	}
 
@@ -898,7 +921,7 @@ func (n *Node) OnStart() error {
 	}
 
 	// Add private IDs to addrbook to block those peers being added
-	n.addrBook.AddPrivateIDs(splitAndTrimEmpty(n.config.P2P.PrivatePeerIDs, ",", " "))
+	n.addrBook.AddPrivateIDs(privatePeerIDs(n.config.P2P))
 
 	// Start the RPC server before the P2P server
 	// so we can eg. receive txs for the first block
@@ -1270,6 +1293,7 @@ func makeNodeInfo(
 	txIndexer txindex.TxIndexer,
 	genDoc *types.GenesisDoc,
 	state sm.State,
+	reactors ...p2p.Reactor,
 ) (p2p.NodeInfo, error) {
 	txIndexerStatus := "on"
 	if _, ok := txIndexer.(*null.TxIndex); ok {
@@ -1284,6 +1308,8 @@ func makeNodeInfo(
 		bcChannel = bcv1.BlockchainChannel
 	case "v2":
 		bcChannel = bcv2.BlockchainChannel
+	case "v3":
+		bcChannel = bcv3.BlockchainChannel
 	default:
 		return nil, fmt.Errorf("unknown fastsync version %s", config.FastSync.Version)
 	}
@@ -1309,10 +1335,15 @@ func makeNodeInfo(
 			TxIndex:    txIndexerStatus,
 			RPCAddress: config.RPC.ListenAddress,
 		},
+		ChannelVersions: p2p.ChannelVersionsOf(reactors...),
 	}
 
-	if config.P2P.PexReactor {
+	if config.P2P.PexEnabled() {
 		nodeInfo.Channels = append(nodeInfo.Channels, pex.PexChannel)
+		// The PEX reactor is only created once the switch exists, but its
+		// channels don't depend on its state.
+		nodeInfo.ChannelVersions = append(nodeInfo.ChannelVersions,
+			p2p.ChannelVersionsOf(&pex.Reactor{})...)
 	}
 
 	lAddr := config.P2P.ExternalAddress
@@ -1418,6 +1449,43 @@ func createAndStartPrivValidatorSocketClient(
 	return pvscWithRetries, nil
 }
 
+// privatePeerIDs returns the IDs of the peers whose addresses are never
+// gossiped. In sentry mode, these include the validators behind the sentry.
+func privatePeerIDs(config *cfg.P2PConfig) []string {
+	ids := splitAndTrimEmpty(config.PrivatePeerIDs, ",", " ")
+	if config.Mode == cfg.P2PModeSentry {
+		ids = append(ids, splitAndTrimEmpty(config.ValidatorPeerIDs, ",", " ")...)
+	}
+	return ids
+}
+
+// unconditionalPeerIDs returns the IDs of the peers which are accepted
+// regardless of the peer limits. In sentry mode, these include the validators
+// behind the sentry.
+func unconditionalPeerIDs(config *cfg.P2PConfig) []string {
+	ids := splitAndTrimEmpty(config.UnconditionalPeerIDs, ",", " ")
+	if config.Mode == cfg.P2PModeSentry {
+		ids = append(ids, splitAndTrimEmpty(config.ValidatorPeerIDs, ",", " ")...)
+	}
+	return ids
+}
+
+// sentryPeerFilter rejects any peer but the sentries, which are the
+// persistent peers of a validator.
+func sentryPeerFilter(config *cfg.P2PConfig) p2p.PeerFilterFunc {
+	sentries := make(map[p2p.ID]struct{})
+	for _, addr := range splitAndTrimEmpty(config.PersistentPeers, ",", " ") {
+		sentries[p2p.ID(strings.SplitN(addr, "@", 2)[0])] = struct{}{}
+	}
+
+	return func(_ p2p.IPeerSet, p p2p.Peer) error {
+		if _, ok := sentries[p.ID()]; !ok {
+			return fmt.Errorf("peer %v is not a sentry of this validator", p.ID())
+		}
+		return nil
+	}
+}
+
 // splitAndTrimEmpty slices s into all subslices separated by sep and returns a
 // slice of the string s with all leading and trailing Unicode code points
 // contained in cutset removed. If sep is empty, SplitAndTrim splits after each
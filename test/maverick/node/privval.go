@@ -317,6 +317,14 @@ func (pv *FilePV) signVote(chainID string, vote *tmproto.Vote) error {
 	}
 	pv.saveSigned(height, round, step, signBytes, sig)
 	vote.Signature = sig
+
+	if types.IsExtendableVote(vote) {
+		extSig, err := pv.Key.PrivKey.Sign(types.VoteExtensionSignBytes(chainID, vote))
+		if err != nil {
+			return err
+		}
+		vote.ExtensionSignature = extSig
+	}
 	return nil
 }
 
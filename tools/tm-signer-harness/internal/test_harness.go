@@ -12,6 +12,7 @@ import (
 
 	"github.com/creatachain/augusteum/crypto/ed25519"
 	"github.com/creatachain/augusteum/privval"
+	tmgrpc "github.com/creatachain/augusteum/privval/grpc"
 	"github.com/creatachain/augusteum/state"
 
 	"github.com/creatachain/augusteum/libs/log"
@@ -48,11 +49,21 @@ type TestHarnessError struct {
 
 var _ error = (*TestHarnessError)(nil)
 
+// remoteSigner is the connection of the test harness to the remote signer,
+// either a privval.SignerClient or, for a gRPC remote signer, a
+// tmgrpc.SignerClient.
+type remoteSigner interface {
+	types.PrivValidator
+	WaitForConnection(maxWait time.Duration) error
+	Close() error
+}
+
 // TestHarness allows for testing of a remote signer to ensure compatibility
 // with this version of Augusteum.
 type TestHarness struct {
 	addr             string
-	signerClient     *privval.SignerClient
+	signerClient     remoteSigner
+	acceptDeadline   time.Duration
 	fpv              *privval.FilePV
 	chainID          string
 	acceptRetries    int
@@ -76,6 +87,12 @@ type TestHarnessConfig struct {
 
 	SecretConnKey ed25519.PrivKey
 
+	// Certificate, key and root CA for mutual TLS with a gRPC remote signer,
+	// i.e. when BindAddr has the grpc:// scheme.
+	ClientCertificateFile string
+	ClientKeyFile         string
+	RootCAFile            string
+
 	ExitWhenComplete bool // Whether or not to call os.Exit when the harness has completed.
 }
 
@@ -104,19 +121,33 @@ func NewTestHarness(logger log.Logger, cfg TestHarnessConfig) (*TestHarness, err
 	}
 	logger.Info("Loaded genesis file", "chainID", st.ChainID)
 
-	spv, err := newTestHarnessListener(logger, cfg)
-	if err != nil {
-		return nil, newTestHarnessError(ErrFailedToCreateListener, err, "")
-	}
+	var (
+		signerClient remoteSigner
+		// the listener enforces its own accept deadline
+		acceptDeadline = 10 * time.Millisecond
+	)
+	if tmgrpc.IsGRPCAddr(cfg.BindAddr) {
+		acceptDeadline = cfg.AcceptDeadline
+		signerClient, err = newTestHarnessGRPCClient(logger, cfg, st.ChainID)
+		if err != nil {
+			return nil, newTestHarnessError(ErrFailedToCreateListener, err, "")
+		}
+	} else {
+		spv, err := newTestHarnessListener(logger, cfg)
+		if err != nil {
+			return nil, newTestHarnessError(ErrFailedToCreateListener, err, "")
+		}
 
-	signerClient, err := privval.NewSignerClient(spv, st.ChainID)
-	if err != nil {
-		return nil, newTestHarnessError(ErrFailedToCreateListener, err, "")
+		signerClient, err = privval.NewSignerClient(spv, st.ChainID)
+		if err != nil {
+			return nil, newTestHarnessError(ErrFailedToCreateListener, err, "")
+		}
 	}
 
 	return &TestHarness{
 		addr:             cfg.BindAddr,
 		signerClient:     signerClient,
+		acceptDeadline:   acceptDeadline,
 		fpv:              fpv,
 		chainID:          st.ChainID,
 		acceptRetries:    cfg.AcceptRetries,
@@ -147,7 +178,7 @@ func (th *TestHarness) Run() {
 	for acceptRetries := th.acceptRetries; acceptRetries > 0; acceptRetries-- {
 		th.logger.Info("Attempting to accept incoming connection", "acceptRetries", acceptRetries)
 
-		if err := th.signerClient.WaitForConnection(10 * time.Millisecond); err != nil {
+		if err := th.signerClient.WaitForConnection(th.acceptDeadline); err != nil {
 			// if it wasn't a timeout error
 			if _, ok := err.(timeoutError); !ok {
 				th.logger.Error("Failed to start listener", "err", err)
@@ -375,12 +406,27 @@ func newTestHarnessListener(logger log.Logger, cfg TestHarnessConfig) (*privval.
 		svln = tcpLn
 	default:
 		_ = ln.Close()
-		logger.Error("Unsupported protocol (must be unix:// or tcp://)", "proto", proto)
+		logger.Error("Unsupported protocol (must be unix://, tcp:// or grpc://)", "proto", proto)
 		return nil, newTestHarnessError(ErrInvalidParameters, nil, fmt.Sprintf("Unsupported protocol: %s", proto))
 	}
 	return privval.NewSignerListenerEndpoint(logger, svln), nil
 }
 
+// newTestHarnessGRPCClient creates our client instance for a gRPC remote
+// signer, which connects to it in the background.
+func newTestHarnessGRPCClient(logger log.Logger, cfg TestHarnessConfig, chainID string) (*tmgrpc.SignerClient, error) {
+	tlsConfig, err := tmgrpc.ClientTLSConfig(
+		ExpandPath(cfg.ClientCertificateFile),
+		ExpandPath(cfg.ClientKeyFile),
+		ExpandPath(cfg.RootCAFile),
+	)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Connecting to gRPC remote signer", "addr", cfg.BindAddr)
+	return tmgrpc.DialRemoteSigner(cfg.BindAddr, tlsConfig, chainID, logger)
+}
+
 func newTestHarnessError(code int, err error, info string) *TestHarnessError {
 	return &TestHarnessError{
 		Code: code,
@@ -3,6 +3,7 @@ package internal
 import (
 	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
 	"testing"
 	"time"
@@ -13,7 +14,10 @@ import (
 	"github.com/creatachain/augusteum/crypto"
 	"github.com/creatachain/augusteum/crypto/ed25519"
 	"github.com/creatachain/augusteum/libs/log"
+	tmnet "github.com/creatachain/augusteum/libs/net"
 	"github.com/creatachain/augusteum/privval"
+	tmgrpc "github.com/creatachain/augusteum/privval/grpc"
+	grpctest "github.com/creatachain/augusteum/privval/grpc/test"
 	"github.com/creatachain/augusteum/types"
 )
 
@@ -123,6 +127,57 @@ func TestRemoteSignerVoteSigningFailed(t *testing.T) {
 	)
 }
 
+func TestRemoteSignerTestHarnessGRPC(t *testing.T) {
+	testCases := []struct {
+		name             string
+		privKey          func(th *TestHarness) crypto.PrivKey
+		breakVoteSigning bool
+		expectedExitCode int
+	}{
+		{"success", func(th *TestHarness) crypto.PrivKey { return th.fpv.Key.PrivKey }, false, NoError},
+		{"public key mismatch", func(*TestHarness) crypto.PrivKey { return ed25519.GenPrivKey() }, false,
+			ErrTestPublicKeyFailed},
+		{"vote signing failure", func(th *TestHarness) crypto.PrivKey { return th.fpv.Key.PrivKey }, true,
+			ErrTestSignVoteFailed},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, ca := makeGRPCConfig(t)
+			defer cleanup(cfg)
+
+			th, err := NewTestHarness(log.TestingLogger(), cfg)
+			require.NoError(t, err)
+
+			signerFiles, err := ca.Issue("signer")
+			require.NoError(t, err)
+			tlsConfig, err := tmgrpc.ServerTLSConfig(signerFiles.CertFile, signerFiles.KeyFile, signerFiles.RootCAFile)
+			require.NoError(t, err)
+			_, addr := tmnet.ProtocolAndAddress(cfg.BindAddr)
+			ln, err := net.Listen("tcp", addr)
+			require.NoError(t, err)
+			mockPV := types.NewMockPVWithParams(tc.privKey(th), false, tc.breakVoteSigning)
+			server := tmgrpc.NewServer(tlsConfig, tmgrpc.NewSignerServer(th.chainID, mockPV, th.logger))
+			go server.Serve(ln) //nolint:errcheck // ignore for tests
+			defer server.Stop()
+
+			th.Run()
+			assert.Equal(t, tc.expectedExitCode, th.exitCode)
+		})
+	}
+}
+
+func TestRemoteSignerTestHarnessGRPCMaxAcceptRetriesReached(t *testing.T) {
+	cfg, _ := makeGRPCConfig(t)
+	cfg.AcceptRetries = 2
+	defer cleanup(cfg)
+
+	th, err := NewTestHarness(log.TestingLogger(), cfg)
+	require.NoError(t, err)
+	th.Run()
+	assert.Equal(t, ErrMaxAcceptRetriesReached, th.exitCode)
+}
+
 func newMockSignerServer(
 	t *testing.T,
 	th *TestHarness,
@@ -180,6 +235,22 @@ func makeConfig(t *testing.T, acceptDeadline, acceptRetries int) TestHarnessConf
 	}
 }
 
+// makeGRPCConfig returns the config of a harness testing a gRPC remote signer,
+// along with the CA which issued the certificate of the harness.
+func makeGRPCConfig(t *testing.T) (TestHarnessConfig, *grpctest.CA) {
+	ca, err := grpctest.NewCA(t.TempDir(), "ca")
+	require.NoError(t, err)
+	files, err := ca.Issue("harness")
+	require.NoError(t, err)
+
+	cfg := makeConfig(t, 100, 10)
+	cfg.BindAddr = "grpc://" + privval.GetFreeLocalhostAddrPort()
+	cfg.ClientCertificateFile = files.CertFile
+	cfg.ClientKeyFile = files.KeyFile
+	cfg.RootCAFile = files.RootCAFile
+	return cfg, ca
+}
+
 func cleanup(cfg TestHarnessConfig) {
 	os.Remove(cfg.KeyFile)
 	os.Remove(cfg.StateFile)
@@ -32,6 +32,9 @@ var (
 	flagBindAddr      string
 	flagTMHome        string
 	flagKeyOutputPath string
+	flagClientCert    string
+	flagClientKey     string
+	flagRootCA        string
 )
 
 // Command line commands
@@ -65,8 +68,14 @@ Use "tm-signer-harness help <command>" for more information about that command.`
 		"accept-retries",
 		defaultAcceptRetries,
 		"The number of attempts to listen for incoming connections")
-	runCmd.StringVar(&flagBindAddr, "addr", defaultBindAddr, "Bind to this address for the testing")
+	runCmd.StringVar(&flagBindAddr, "addr", defaultBindAddr,
+		"Bind to this address for the testing, or connect to this grpc:// address of the remote signer")
 	runCmd.StringVar(&flagTMHome, "tmhome", defaultTMHome, "Path to the Augusteum home directory")
+	runCmd.StringVar(&flagClientCert, "client-cert", "",
+		"Path to the certificate to authenticate with to a gRPC remote signer")
+	runCmd.StringVar(&flagClientKey, "client-key", "", "Path to the key of the client certificate")
+	runCmd.StringVar(&flagRootCA, "root-ca", "",
+		"Path to the root CA the certificate of a gRPC remote signer must be signed by")
 	runCmd.Usage = func() {
 		fmt.Println(`Runs the remote signer test harness for Augusteum.
 
@@ -107,7 +116,7 @@ Usage:
 	}
 }
 
-func runTestHarness(acceptRetries int, bindAddr, tmhome string) {
+func runTestHarness(acceptRetries int, bindAddr, tmhome, clientCert, clientKey, rootCA string) {
 	tmhome = internal.ExpandPath(tmhome)
 	cfg := internal.TestHarnessConfig{
 		BindAddr:         bindAddr,
@@ -119,6 +128,10 @@ func runTestHarness(acceptRetries int, bindAddr, tmhome string) {
 		ConnDeadline:     time.Duration(defaultConnDeadline) * time.Second,
 		SecretConnKey:    ed25519.GenPrivKey(),
 		ExitWhenComplete: true,
+
+		ClientCertificateFile: clientCert,
+		ClientKeyFile:         clientKey,
+		RootCAFile:            rootCA,
 	}
 	harness, err := internal.NewTestHarness(logger, cfg)
 	if err != nil {
@@ -173,7 +186,7 @@ func main() {
 			fmt.Printf("Error parsing flags: %v\n", err)
 			os.Exit(1)
 		}
-		runTestHarness(flagAcceptRetries, flagBindAddr, flagTMHome)
+		runTestHarness(flagAcceptRetries, flagBindAddr, flagTMHome, flagClientCert, flagClientKey, flagRootCA)
 	case "extract_key":
 		if err := extractKeyCmd.Parse(os.Args[2:]); err != nil {
 			fmt.Printf("Error parsing flags: %v\n", err)
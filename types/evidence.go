@@ -45,7 +45,8 @@ type DuplicateVoteEvidence struct {
 var _ Evidence = &DuplicateVoteEvidence{}
 
 // NewDuplicateVoteEvidence creates DuplicateVoteEvidence with right ordering given
-// two conflicting votes. If one of the votes is nil, evidence returned is nil as well
+// two conflicting votes. If one of the votes is nil, evidence returned is nil as well.
+// The vote extensions are left out, as the vote signatures don't cover them.
 func NewDuplicateVoteEvidence(vote1, vote2 *Vote, blockTime time.Time, valSet *ValidatorSet) *DuplicateVoteEvidence {
 	var voteA, voteB *Vote
 	if vote1 == nil || vote2 == nil || valSet == nil {
@@ -57,11 +58,11 @@ func NewDuplicateVoteEvidence(vote1, vote2 *Vote, blockTime time.Time, valSet *V
 	}
 
 	if strings.Compare(vote1.BlockID.Key(), vote2.BlockID.Key()) == -1 {
-		voteA = vote1
-		voteB = vote2
+		voteA = withoutExtension(vote1)
+		voteB = withoutExtension(vote2)
 	} else {
-		voteA = vote2
-		voteB = vote1
+		voteA = withoutExtension(vote2)
+		voteB = withoutExtension(vote1)
 	}
 	return &DuplicateVoteEvidence{
 		VoteA:            voteA,
@@ -132,6 +133,11 @@ func (dve *DuplicateVoteEvidence) ValidateBasic() error {
 	if err := dve.VoteB.ValidateBasic(); err != nil {
 		return fmt.Errorf("invalid VoteB: %w", err)
 	}
+	// Vote extensions aren't covered by the vote signatures, so they could be
+	// changed to alter the hash of the evidence
+	if hasExtension(dve.VoteA) || hasExtension(dve.VoteB) {
+		return errors.New("duplicate votes carry a vote extension")
+	}
 	// Enforce Votes are lexicographically sorted on blockID
 	if strings.Compare(dve.VoteA.BlockID.Key(), dve.VoteB.BlockID.Key()) >= 0 {
 		return errors.New("duplicate votes in invalid order")
@@ -139,6 +145,16 @@ func (dve *DuplicateVoteEvidence) ValidateBasic() error {
 	return nil
 }
 
+func withoutExtension(vote *Vote) *Vote {
+	v := vote.Copy()
+	v.Extension, v.ExtensionSignature = nil, nil
+	return v
+}
+
+func hasExtension(vote *Vote) bool {
+	return len(vote.Extension) > 0 || len(vote.ExtensionSignature) > 0
+}
+
 // ToProto encodes DuplicateVoteEvidence to protobuf
 func (dve *DuplicateVoteEvidence) ToProto() *tmproto.DuplicateVoteEvidence {
 	voteB := dve.VoteB.ToProto()
@@ -49,6 +49,28 @@ func TestDuplicateVoteEvidence(t *testing.T) {
 	assert.Equal(t, ev.Height(), height)
 }
 
+func TestDuplicateVoteEvidenceWithoutExtensions(t *testing.T) {
+	val := NewMockPV()
+	blockID := makeBlockID(tmhash.Sum([]byte("blockhash")), math.MaxInt32, tmhash.Sum([]byte("partshash")))
+	blockID2 := makeBlockID(tmhash.Sum([]byte("blockhash2")), math.MaxInt32, tmhash.Sum([]byte("partshash")))
+	const chainID = "mychain"
+	vote1 := makeVote(t, val, chainID, 0, 10, 2, 0x02, blockID, defaultVoteTime)
+	vote2 := makeVote(t, val, chainID, 0, 10, 2, 0x02, blockID2, defaultVoteTime)
+	valSet := NewValidatorSet([]*Validator{val.ExtractIntoValidator(10)})
+	ev := NewDuplicateVoteEvidence(vote1, vote2, defaultVoteTime, valSet)
+
+	// the extensions don't change the evidence
+	extended1, extended2 := vote1.Copy(), vote2.Copy()
+	extended1.Extension, extended1.ExtensionSignature = []byte("extension"), []byte("signature")
+	extended2.Extension, extended2.ExtensionSignature = []byte("other"), []byte("other signature")
+	extendedEv := NewDuplicateVoteEvidence(extended1, extended2, defaultVoteTime, valSet)
+	assert.Equal(t, ev.Hash(), extendedEv.Hash())
+	assert.NoError(t, extendedEv.ValidateBasic())
+
+	// nor are the votes modified
+	assert.Equal(t, []byte("extension"), extended1.Extension)
+}
+
 func TestDuplicateVoteEvidenceValidation(t *testing.T) {
 	val := NewMockPV()
 	blockID := makeBlockID(tmhash.Sum([]byte("blockhash")), math.MaxInt32, tmhash.Sum([]byte("partshash")))
@@ -75,6 +97,10 @@ func TestDuplicateVoteEvidenceValidation(t *testing.T) {
 			ev.VoteA = ev.VoteB.Copy()
 			ev.VoteB = swap
 		}, true},
+		{"Vote extension", func(ev *DuplicateVoteEvidence) { ev.VoteA.Extension = []byte("extension") }, true},
+		{"Vote extension signature", func(ev *DuplicateVoteEvidence) {
+			ev.VoteB.ExtensionSignature = []byte("signature")
+		}, true},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -28,6 +28,8 @@ func DefaultConsensusParams() *tmproto.ConsensusParams {
 		Evidence:  DefaultEvidenceParams(),
 		Validator: DefaultValidatorParams(),
 		Version:   DefaultVersionParams(),
+		Synchrony: DefaultSynchronyParams(),
+		Timeout:   DefaultTimeoutParams(),
 	}
 }
 
@@ -63,6 +65,28 @@ func DefaultVersionParams() tmproto.VersionParams {
 	}
 }
 
+// DefaultSynchronyParams returns a default SynchronyParams, which leave
+// proposer-based timestamps disabled.
+func DefaultSynchronyParams() tmproto.SynchronyParams {
+	return tmproto.SynchronyParams{
+		Precision:    505 * time.Millisecond,
+		MessageDelay: 12 * time.Second,
+	}
+}
+
+// DefaultTimeoutParams returns a default TimeoutParams, which leave every
+// timeout to the local consensus config of each node.
+func DefaultTimeoutParams() tmproto.TimeoutParams {
+	return tmproto.TimeoutParams{}
+}
+
+// IsPBTSEnabled returns true if the time of the block at the given height is
+// set by its proposer, rather than being the median time of the last commit.
+func IsPBTSEnabled(params tmproto.ConsensusParams, height int64) bool {
+	enableHeight := params.Synchrony.PBTSEnableHeight
+	return enableHeight > 0 && height >= enableHeight
+}
+
 func IsValidPubkeyType(params tmproto.ValidatorParams, pubkeyType string) bool {
 	for i := 0; i < len(params.PubKeyTypes); i++ {
 		if params.PubKeyTypes[i] == pubkeyType {
@@ -118,6 +142,41 @@ func ValidateConsensusParams(params tmproto.ConsensusParams) error {
 		return errors.New("len(Validator.PubKeyTypes) must be greater than 0")
 	}
 
+	if params.Synchrony.PBTSEnableHeight < 0 {
+		return fmt.Errorf("synchrony.PBTSEnableHeight must be non negative. Got: %d",
+			params.Synchrony.PBTSEnableHeight)
+	}
+
+	if params.Synchrony.PBTSEnableHeight > 0 {
+		if params.Synchrony.Precision <= 0 {
+			return fmt.Errorf("synchrony.Precision must be greater than 0 with PBTS enabled. Got: %v",
+				params.Synchrony.Precision)
+		}
+		if params.Synchrony.MessageDelay <= 0 {
+			return fmt.Errorf("synchrony.MessageDelay must be greater than 0 with PBTS enabled. Got: %v",
+				params.Synchrony.MessageDelay)
+		}
+	}
+
+	timeouts := []struct {
+		name  string
+		value *time.Duration
+	}{
+		{"Propose", params.Timeout.Propose},
+		{"ProposeDelta", params.Timeout.ProposeDelta},
+		{"Prevote", params.Timeout.Prevote},
+		{"PrevoteDelta", params.Timeout.PrevoteDelta},
+		{"Precommit", params.Timeout.Precommit},
+		{"PrecommitDelta", params.Timeout.PrecommitDelta},
+		{"Commit", params.Timeout.Commit},
+	}
+	for _, timeout := range timeouts {
+		if timeout.value != nil && *timeout.value < 0 {
+			return fmt.Errorf("timeout.%s must be non negative if provided. Got: %v",
+				timeout.name, *timeout.value)
+		}
+	}
+
 	// Check if keyType is a known MSMPubKeyType
 	for i := 0; i < len(params.Validator.PubKeyTypes); i++ {
 		keyType := params.Validator.PubKeyTypes[i]
@@ -130,6 +189,29 @@ func ValidateConsensusParams(params tmproto.ConsensusParams) error {
 	return nil
 }
 
+// ValidateConsensusParamsUpdate validates the update of the params to
+// nextParams by the block at the given height. nextParams apply from the next
+// height, so PBTSEnableHeight can only be set to a later height, and can't be
+// changed once proposer-based timestamps are enabled.
+func ValidateConsensusParamsUpdate(params, nextParams tmproto.ConsensusParams, height int64) error {
+	enableHeight := params.Synchrony.PBTSEnableHeight
+	nextEnableHeight := nextParams.Synchrony.PBTSEnableHeight
+	if nextEnableHeight == enableHeight {
+		return nil
+	}
+
+	if IsPBTSEnabled(params, height) {
+		return fmt.Errorf("synchrony.PBTSEnableHeight can't be changed once PBTS is enabled. Got: %d, enabled at: %d",
+			nextEnableHeight, enableHeight)
+	}
+	if nextEnableHeight > 0 && nextEnableHeight <= height {
+		return fmt.Errorf("synchrony.PBTSEnableHeight must be greater than the current height %d. Got: %d",
+			height, nextEnableHeight)
+	}
+
+	return nil
+}
+
 // Hash returns a hash of a subset of the parameters to store in the block header.
 // Only the Block.MaxBytes and Block.MaxGas are included in the hash.
 // This allows the ConsensusParams to evolve more without breaking the block
@@ -181,5 +263,11 @@ func UpdateConsensusParams(params tmproto.ConsensusParams, params2 *msm.Consensu
 	if params2.Version != nil {
 		res.Version.AppVersion = params2.Version.AppVersion
 	}
+	if params2.Synchrony != nil {
+		res.Synchrony = *params2.Synchrony
+	}
+	if params2.Timeout != nil {
+		res.Timeout = *params2.Timeout
+	}
 	return res
 }
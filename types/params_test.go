@@ -51,6 +51,63 @@ func TestConsensusParamsValidation(t *testing.T) {
 	}
 }
 
+func TestConsensusParamsValidation_Synchrony(t *testing.T) {
+	testCases := []struct {
+		synchrony tmproto.SynchronyParams
+		valid     bool
+	}{
+		0: {tmproto.SynchronyParams{}, true},
+		1: {DefaultSynchronyParams(), true},
+		2: {tmproto.SynchronyParams{PBTSEnableHeight: -1}, false},
+		3: {tmproto.SynchronyParams{Precision: time.Second, MessageDelay: time.Second, PBTSEnableHeight: 1}, true},
+		4: {tmproto.SynchronyParams{Precision: 0, MessageDelay: time.Second, PBTSEnableHeight: 1}, false},
+		5: {tmproto.SynchronyParams{Precision: time.Second, MessageDelay: 0, PBTSEnableHeight: 1}, false},
+	}
+	for i, tc := range testCases {
+		params := makeParams(1, 0, 10, 2, 0, valEd25519)
+		params.Synchrony = tc.synchrony
+		if tc.valid {
+			assert.NoErrorf(t, ValidateConsensusParams(params), "expected no error for valid params (#%d)", i)
+		} else {
+			assert.Errorf(t, ValidateConsensusParams(params), "expected error for non valid params (#%d)", i)
+		}
+	}
+}
+
+func TestConsensusParamsValidation_Timeout(t *testing.T) {
+	negative, positive := -time.Second, time.Second
+
+	testCases := []struct {
+		timeout tmproto.TimeoutParams
+		valid   bool
+	}{
+		0: {DefaultTimeoutParams(), true},
+		1: {tmproto.TimeoutParams{Propose: &positive, PrevoteDelta: &positive, Commit: &positive}, true},
+		2: {tmproto.TimeoutParams{Propose: &negative}, false},
+		3: {tmproto.TimeoutParams{PrecommitDelta: &negative}, false},
+		4: {tmproto.TimeoutParams{Commit: &negative}, false},
+	}
+	for i, tc := range testCases {
+		params := makeParams(1, 0, 10, 2, 0, valEd25519)
+		params.Timeout = tc.timeout
+		if tc.valid {
+			assert.NoErrorf(t, ValidateConsensusParams(params), "expected no error for valid params (#%d)", i)
+		} else {
+			assert.Errorf(t, ValidateConsensusParams(params), "expected error for non valid params (#%d)", i)
+		}
+	}
+}
+
+func TestIsPBTSEnabled(t *testing.T) {
+	params := DefaultConsensusParams()
+	assert.False(t, IsPBTSEnabled(*params, 1))
+
+	params.Synchrony.PBTSEnableHeight = 10
+	assert.False(t, IsPBTSEnabled(*params, 9))
+	assert.True(t, IsPBTSEnabled(*params, 10))
+	assert.True(t, IsPBTSEnabled(*params, 11))
+}
+
 func makeParams(
 	blockBytes, blockGas int64,
 	blockTimeIotaMs int64,
@@ -149,3 +206,78 @@ func TestConsensusParamsUpdate_AppVersion(t *testing.T) {
 
 	assert.EqualValues(t, 1, updated.Version.AppVersion)
 }
+
+func TestConsensusParamsUpdate_Synchrony(t *testing.T) {
+	params := makeParams(1, 2, 10, 3, 0, valEd25519)
+
+	assert.EqualValues(t, 0, params.Synchrony.PBTSEnableHeight)
+
+	updated := UpdateConsensusParams(params,
+		&msm.ConsensusParams{Synchrony: &tmproto.SynchronyParams{
+			Precision:        time.Second,
+			MessageDelay:     2 * time.Second,
+			PBTSEnableHeight: 5,
+		}})
+
+	assert.EqualValues(t, 5, updated.Synchrony.PBTSEnableHeight)
+	assert.Equal(t, time.Second, updated.Synchrony.Precision)
+	assert.Equal(t, 2*time.Second, updated.Synchrony.MessageDelay)
+}
+
+func TestConsensusParamsUpdateValidation_Synchrony(t *testing.T) {
+	const height = 10
+	params := makeParams(1, 2, 10, 3, 0, valEd25519)
+	withEnableHeight := func(params tmproto.ConsensusParams, enableHeight int64) tmproto.ConsensusParams {
+		params.Synchrony.PBTSEnableHeight = enableHeight
+		return params
+	}
+
+	testCases := []struct {
+		enableHeight     int64
+		nextEnableHeight int64
+		valid            bool
+	}{
+		// PBTS disabled
+		0: {0, 0, true},
+		1: {0, height + 1, true},
+		2: {0, height, false},
+		3: {0, 1, false},
+		// PBTS enabled at a later height
+		4: {height + 5, height + 5, true},
+		5: {height + 5, height + 1, true},
+		6: {height + 5, 0, true},
+		7: {height + 5, height - 1, false},
+		// PBTS enabled
+		8:  {height, height, true},
+		9:  {height, 0, false},
+		10: {height, height + 1, false},
+		11: {height - 5, height - 1, false},
+	}
+	for i, tc := range testCases {
+		err := ValidateConsensusParamsUpdate(withEnableHeight(params, tc.enableHeight),
+			withEnableHeight(params, tc.nextEnableHeight), height)
+		if tc.valid {
+			assert.NoError(t, err, "expected no error for valid update #%d", i)
+		} else {
+			assert.Error(t, err, "expected error for invalid update #%d", i)
+		}
+	}
+}
+
+func TestConsensusParamsUpdate_Timeout(t *testing.T) {
+	params := makeParams(1, 2, 10, 3, 0, valEd25519)
+
+	assert.Nil(t, params.Timeout.Commit)
+
+	commit, bypass := 2*time.Second, true
+	updated := UpdateConsensusParams(params,
+		&msm.ConsensusParams{Timeout: &tmproto.TimeoutParams{Commit: &commit, BypassCommitTimeout: &bypass}})
+
+	if assert.NotNil(t, updated.Timeout.Commit) {
+		assert.Equal(t, commit, *updated.Timeout.Commit)
+	}
+	if assert.NotNil(t, updated.Timeout.BypassCommitTimeout) {
+		assert.True(t, *updated.Timeout.BypassCommitTimeout)
+	}
+	assert.Nil(t, params.Timeout.Commit, "original params must not be modified")
+}
@@ -82,6 +82,14 @@ func (pv MockPV) SignVote(chainID string, vote *tmproto.Vote) error {
 		return err
 	}
 	vote.Signature = sig
+
+	if IsExtendableVote(vote) {
+		extSig, err := pv.PrivKey.Sign(VoteExtensionSignBytes(useChainID, vote))
+		if err != nil {
+			return err
+		}
+		vote.ExtensionSignature = extSig
+	}
 	return nil
 }
 
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	tmbytes "github.com/creatachain/augusteum/libs/bytes"
@@ -79,6 +80,24 @@ func (p *Proposal) ValidateBasic() error {
 	return nil
 }
 
+// IsTimely returns true if the proposal was received at recvTime within the
+// synchrony bounds of its timestamp: no earlier than the precision before it,
+// and no later than the message delay plus the precision after it. The message
+// delay grows by 10% every round, so that consensus recovers from a message
+// delay which is too small for the network.
+func (p *Proposal) IsTimely(recvTime time.Time, sp tmproto.SynchronyParams) bool {
+	messageDelay := sp.MessageDelay
+	if d := float64(messageDelay) * math.Pow(1.1, float64(p.Round)); d < math.MaxInt64 {
+		messageDelay = time.Duration(d)
+	} else {
+		messageDelay = math.MaxInt64
+	}
+
+	lowerBound := p.Timestamp.Add(-sp.Precision)
+	upperBound := p.Timestamp.Add(sp.Precision).Add(messageDelay)
+	return !recvTime.Before(lowerBound) && !recvTime.After(upperBound)
+}
+
 // String returns a string representation of the Proposal.
 //
 // 1. height
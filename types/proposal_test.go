@@ -163,6 +163,38 @@ func TestProposalValidateBasic(t *testing.T) {
 	}
 }
 
+func TestProposalIsTimely(t *testing.T) {
+	stamp := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	sp := tmproto.SynchronyParams{
+		Precision:    500 * time.Millisecond,
+		MessageDelay: 2 * time.Second,
+	}
+
+	testCases := []struct {
+		name     string
+		round    int32
+		recvTime time.Time
+		timely   bool
+	}{
+		{"received at timestamp", 0, stamp, true},
+		{"received within precision before", 0, stamp.Add(-500 * time.Millisecond), true},
+		{"received too early", 0, stamp.Add(-501 * time.Millisecond), false},
+		{"received within upper bound", 0, stamp.Add(2500 * time.Millisecond), true},
+		{"received too late", 0, stamp.Add(2501 * time.Millisecond), false},
+		{"message delay grows with round", 1, stamp.Add(2700 * time.Millisecond), true},
+		{"message delay bounded by round", 1, stamp.Add(2701 * time.Millisecond), false},
+		{"huge round does not overflow", math.MaxInt32, stamp.Add(time.Hour), true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			p := NewProposal(1, tc.round, -1, makeBlockID([]byte("hash"), 2, []byte("part_set_hash")))
+			p.Timestamp = stamp
+			assert.Equal(t, tc.timely, p.IsTimely(tc.recvTime, sp))
+		})
+	}
+}
+
 func TestProposalProtoBuf(t *testing.T) {
 	proposal := NewProposal(1, 2, 3, makeBlockID([]byte("hash"), 2, []byte("part_set_hash")))
 	proposal.Signature = []byte("sig")
@@ -105,6 +105,8 @@ func (tm2pb) ConsensusParams(params *tmproto.ConsensusParams) *msm.ConsensusPara
 		},
 		Evidence:  &params.Evidence,
 		Validator: &params.Validator,
+		Synchrony: &params.Synchrony,
+		Timeout:   &params.Timeout,
 	}
 }
 
@@ -9,6 +9,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/creatachain/augusteum/crypto/batch"
 	"github.com/creatachain/augusteum/crypto/merkle"
 	tmmath "github.com/creatachain/augusteum/libs/math"
 	tmproto "github.com/creatachain/augusteum/proto/augusteum/types"
@@ -677,6 +678,7 @@ func (vals *ValidatorSet) VerifyCommit(chainID string, blockID BlockID,
 
 	talliedVotingPower := int64(0)
 	votingPowerNeeded := vals.TotalVotingPower() * 2 / 3
+	entries := make([]commitSigEntry, 0, len(commit.Signatures))
 	for idx, commitSig := range commit.Signatures {
 		if commitSig.Absent() {
 			continue // OK, some signatures can be absent.
@@ -686,12 +688,7 @@ func (vals *ValidatorSet) VerifyCommit(chainID string, blockID BlockID,
 		// This means we don't need the validator address or to do any lookup.
 		val := vals.Validators[idx]
 
-		// Validate signature.
-		voteSignBytes := commit.VoteSignBytes(chainID, int32(idx))
-		if !val.PubKey.VerifySignature(voteSignBytes, commitSig.Signature) {
-			return fmt.Errorf("wrong signature (#%d): %X", idx, commitSig.Signature)
-		}
-		// Good!
+		entries = append(entries, newCommitSigEntry(chainID, commit, idx, val))
 		if commitSig.ForBlock() {
 			talliedVotingPower += val.VotingPower
 		}
@@ -701,6 +698,11 @@ func (vals *ValidatorSet) VerifyCommit(chainID string, blockID BlockID,
 		// }
 	}
 
+	// Validate signatures.
+	if err := verifyCommitSigs(entries); err != nil {
+		return err
+	}
+
 	if got, needed := talliedVotingPower, votingPowerNeeded; got <= needed {
 		return ErrNotEnoughVotingPowerSigned{Got: got, Needed: needed}
 	}
@@ -732,6 +734,7 @@ func (vals *ValidatorSet) VerifyCommitLight(chainID string, blockID BlockID,
 
 	talliedVotingPower := int64(0)
 	votingPowerNeeded := vals.TotalVotingPower() * 2 / 3
+	entries := make([]commitSigEntry, 0, len(commit.Signatures))
 	for idx, commitSig := range commit.Signatures {
 		// No need to verify absent or nil votes.
 		if !commitSig.ForBlock() {
@@ -742,21 +745,25 @@ func (vals *ValidatorSet) VerifyCommitLight(chainID string, blockID BlockID,
 		// This means we don't need the validator address or to do any lookup.
 		val := vals.Validators[idx]
 
-		// Validate signature.
-		voteSignBytes := commit.VoteSignBytes(chainID, int32(idx))
-		if !val.PubKey.VerifySignature(voteSignBytes, commitSig.Signature) {
-			return fmt.Errorf("wrong signature (#%d): %X", idx, commitSig.Signature)
-		}
-
+		entries = append(entries, newCommitSigEntry(chainID, commit, idx, val))
 		talliedVotingPower += val.VotingPower
 
-		// return as soon as +2/3 of the signatures are verified
+		// stop as soon as the signatures of +2/3 are collected
 		if talliedVotingPower > votingPowerNeeded {
-			return nil
+			break
 		}
 	}
 
-	return ErrNotEnoughVotingPowerSigned{Got: talliedVotingPower, Needed: votingPowerNeeded}
+	// Validate signatures.
+	if err := verifyCommitSigs(entries); err != nil {
+		return err
+	}
+
+	if talliedVotingPower <= votingPowerNeeded {
+		return ErrNotEnoughVotingPowerSigned{Got: talliedVotingPower, Needed: votingPowerNeeded}
+	}
+
+	return nil
 }
 
 // VerifyCommitLightTrusting verifies that trustLevel of the validator set signed
@@ -785,6 +792,7 @@ func (vals *ValidatorSet) VerifyCommitLightTrusting(chainID string, commit *Comm
 	}
 	votingPowerNeeded := totalVotingPowerMulByNumerator / int64(trustLevel.Denominator)
 
+	entries := make([]commitSigEntry, 0, len(commit.Signatures))
 	for idx, commitSig := range commit.Signatures {
 		// No need to verify absent or nil votes.
 		if !commitSig.ForBlock() {
@@ -796,28 +804,98 @@ func (vals *ValidatorSet) VerifyCommitLightTrusting(chainID string, commit *Comm
 		valIdx, val := vals.GetByAddress(commitSig.ValidatorAddress)
 
 		if val != nil {
-			// check for double vote of validator on the same commit
+			// check for double vote of validator on the same commit, once the
+			// signatures before it are known to be valid
 			if firstIndex, ok := seenVals[valIdx]; ok {
+				if err := verifyCommitSigs(entries); err != nil {
+					return err
+				}
 				secondIndex := idx
 				return fmt.Errorf("double vote from %v (%d and %d)", val, firstIndex, secondIndex)
 			}
 			seenVals[valIdx] = idx
 
-			// Validate signature.
-			voteSignBytes := commit.VoteSignBytes(chainID, int32(idx))
-			if !val.PubKey.VerifySignature(voteSignBytes, commitSig.Signature) {
-				return fmt.Errorf("wrong signature (#%d): %X", idx, commitSig.Signature)
-			}
-
+			entries = append(entries, newCommitSigEntry(chainID, commit, idx, val))
 			talliedVotingPower += val.VotingPower
 
 			if talliedVotingPower > votingPowerNeeded {
-				return nil
+				break
+			}
+		}
+	}
+
+	// Validate signatures.
+	if err := verifyCommitSigs(entries); err != nil {
+		return err
+	}
+
+	if talliedVotingPower <= votingPowerNeeded {
+		return ErrNotEnoughVotingPowerSigned{Got: talliedVotingPower, Needed: votingPowerNeeded}
+	}
+
+	return nil
+}
+
+// batchVerifyThreshold is the minimum number of signatures of a commit which
+// are verified in a batch rather than one by one.
+const batchVerifyThreshold = 2
+
+// commitSigEntry is a signature of a commit, along with its validator.
+type commitSigEntry struct {
+	idx       int
+	val       *Validator
+	signBytes []byte
+	signature []byte
+}
+
+func newCommitSigEntry(chainID string, commit *Commit, idx int, val *Validator) commitSigEntry {
+	return commitSigEntry{
+		idx:       idx,
+		val:       val,
+		signBytes: commit.VoteSignBytes(chainID, int32(idx)),
+		signature: commit.Signatures[idx].Signature,
+	}
+}
+
+// verifyCommitSigs verifies the given signatures of a commit. They are
+// verified in a batch if there are enough of them and their keys support it,
+// and one by one otherwise or if the batch can't be built. An error is
+// returned for the first invalid signature.
+func verifyCommitSigs(entries []commitSigEntry) error {
+	if len(entries) >= batchVerifyThreshold {
+		if valid, ok := batchVerifyCommitSigs(entries); ok {
+			for i, entry := range entries {
+				if !valid[i] {
+					return fmt.Errorf("wrong signature (#%d): %X", entry.idx, entry.signature)
+				}
 			}
+			return nil
+		}
+	}
+
+	for _, entry := range entries {
+		if !entry.val.PubKey.VerifySignature(entry.signBytes, entry.signature) {
+			return fmt.Errorf("wrong signature (#%d): %X", entry.idx, entry.signature)
 		}
 	}
+	return nil
+}
 
-	return ErrNotEnoughVotingPowerSigned{Got: talliedVotingPower, Needed: votingPowerNeeded}
+// batchVerifyCommitSigs verifies the given signatures in a batch, and returns
+// the validity of each. It returns false if the keys don't support batch
+// verification, are of different types or a signature is malformed.
+func batchVerifyCommitSigs(entries []commitSigEntry) ([]bool, bool) {
+	bv, ok := batch.CreateBatchVerifier(entries[0].val.PubKey)
+	if !ok {
+		return nil, false
+	}
+	for _, entry := range entries {
+		if err := bv.Add(entry.val.PubKey, entry.signBytes, entry.signature); err != nil {
+			return nil, false
+		}
+	}
+	_, valid := bv.Verify()
+	return valid, true
 }
 
 // findPreviousProposer reverses the compare proposer priority function to find the validator
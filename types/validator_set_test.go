@@ -812,6 +812,68 @@ func TestValidatorSet_VerifyCommitLightTrusting_ReturnsAsSoonAsTrustLevelOfVotin
 	assert.NoError(t, err)
 }
 
+func TestValidatorSet_VerifyCommit_FindsWrongSignatureInBatch(t *testing.T) {
+	var (
+		chainID = "test_chain_id"
+		h       = int64(3)
+		blockID = makeBlockIDRandom()
+	)
+
+	voteSet, valSet, vals := randVoteSet(h, 0, tmproto.PrecommitType, 4, 10)
+	commit, err := MakeCommit(blockID, h, 0, voteSet, vals, time.Now())
+	require.NoError(t, err)
+
+	// malleate 2nd signature, which is needed for every verification
+	vote := voteSet.GetByIndex(1)
+	v := vote.ToProto()
+	err = vals[1].SignVote("CentaurusA", v)
+	require.NoError(t, err)
+	vote.Signature = v.Signature
+	commit.Signatures[1] = vote.CommitSig()
+
+	err = valSet.VerifyCommit(chainID, blockID, h, commit)
+	if assert.Error(t, err, "VerifyCommit") {
+		assert.Contains(t, err.Error(), "wrong signature (#1)", "VerifyCommit")
+	}
+	err = valSet.VerifyCommitLight(chainID, blockID, h, commit)
+	if assert.Error(t, err, "VerifyCommitLight") {
+		assert.Contains(t, err.Error(), "wrong signature (#1)", "VerifyCommitLight")
+	}
+	err = valSet.VerifyCommitLightTrusting(chainID, commit, tmmath.Fraction{Numerator: 1, Denominator: 3})
+	if assert.Error(t, err, "VerifyCommitLightTrusting") {
+		assert.Contains(t, err.Error(), "wrong signature (#1)", "VerifyCommitLightTrusting")
+	}
+
+	// a malformed signature can't be added to the batch
+	commit.Signatures[1].Signature = commit.Signatures[1].Signature[:10]
+	err = valSet.VerifyCommit(chainID, blockID, h, commit)
+	if assert.Error(t, err, "VerifyCommit") {
+		assert.Contains(t, err.Error(), "wrong signature (#1)", "VerifyCommit")
+	}
+}
+
+func BenchmarkValidatorSet_VerifyCommit(b *testing.B) {
+	var (
+		chainID = "test_chain_id"
+		h       = int64(3)
+		blockID = makeBlockIDRandom()
+	)
+
+	for _, n := range []int{1, 8, 64, 100} {
+		voteSet, valSet, vals := randVoteSet(h, 0, tmproto.PrecommitType, n, 10)
+		commit, err := MakeCommit(blockID, h, 0, voteSet, vals, time.Now())
+		require.NoError(b, err)
+
+		b.Run(fmt.Sprintf("valset size %d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				err := valSet.VerifyCommit(chainID, blockID, h, commit)
+				require.NoError(b, err)
+			}
+		})
+	}
+}
+
 func TestEmptySet(t *testing.T) {
 
 	var valList []*Validator
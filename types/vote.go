@@ -14,6 +14,10 @@ import (
 
 const (
 	nilVoteStr string = "nil-Vote"
+
+	// MaxVoteExtensionSize is the maximum size of the application data a
+	// precommit can be extended with.
+	MaxVoteExtensionSize int = 64 * 1024
 )
 
 var (
@@ -21,6 +25,8 @@ var (
 	ErrVoteInvalidValidatorIndex     = errors.New("invalid validator index")
 	ErrVoteInvalidValidatorAddress   = errors.New("invalid validator address")
 	ErrVoteInvalidSignature          = errors.New("invalid signature")
+	ErrVoteInvalidExtensionSignature = errors.New("invalid vote extension signature")
+	ErrVoteInvalidExtension          = errors.New("invalid vote extension")
 	ErrVoteInvalidBlockHash          = errors.New("invalid block hash")
 	ErrVoteNonDeterministicSignature = errors.New("non-deterministic signature")
 	ErrVoteNil                       = errors.New("nil vote")
@@ -56,6 +62,10 @@ type Vote struct {
 	ValidatorAddress Address               `json:"validator_address"`
 	ValidatorIndex   int32                 `json:"validator_index"`
 	Signature        []byte                `json:"signature"`
+	// Application data attached to precommits for a block, signed separately
+	// from the vote. Extensions aren't part of the commit.
+	Extension          []byte `json:"extension"`
+	ExtensionSignature []byte `json:"extension_signature"`
 }
 
 // CommitSig converts the Vote to a CommitSig.
@@ -100,6 +110,26 @@ func VoteSignBytes(chainID string, vote *tmproto.Vote) []byte {
 	return bz
 }
 
+// VoteExtensionSignBytes returns the proto-encoding of the canonicalized vote
+// extension, for signing. Panics if the marshaling fails.
+//
+// See CanonicalizeVoteExtension
+func VoteExtensionSignBytes(chainID string, vote *tmproto.Vote) []byte {
+	pb := CanonicalizeVoteExtension(chainID, vote)
+	bz, err := protoio.MarshalDelimited(&pb)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// IsExtendableVote returns true if the vote is a precommit for a block, the
+// only votes which carry an extension.
+func IsExtendableVote(vote *tmproto.Vote) bool {
+	return vote.Type == tmproto.PrecommitType && len(vote.BlockID.Hash) > 0
+}
+
 func (vote *Vote) Copy() *Vote {
 	voteCopy := *vote
 	return &voteCopy
@@ -155,6 +185,19 @@ func (vote *Vote) Verify(chainID string, pubKey crypto.PubKey) error {
 	return nil
 }
 
+// VerifyExtension checks the extension signature of a precommit for a block.
+// Other votes carry no extension and always pass.
+func (vote *Vote) VerifyExtension(chainID string, pubKey crypto.PubKey) error {
+	v := vote.ToProto()
+	if !IsExtendableVote(v) {
+		return nil
+	}
+	if !pubKey.VerifySignature(VoteExtensionSignBytes(chainID, v), vote.ExtensionSignature) {
+		return ErrVoteInvalidExtensionSignature
+	}
+	return nil
+}
+
 // ValidateBasic performs basic validation.
 func (vote *Vote) ValidateBasic() error {
 	if !IsVoteTypeValid(vote.Type) {
@@ -198,6 +241,19 @@ func (vote *Vote) ValidateBasic() error {
 		return fmt.Errorf("signature is too big (max: %d)", MaxSignatureSize)
 	}
 
+	if !IsExtendableVote(vote.ToProto()) {
+		if len(vote.Extension) > 0 || len(vote.ExtensionSignature) > 0 {
+			return errors.New("only precommits for a block can have an extension")
+		}
+		return nil
+	}
+	if len(vote.Extension) > MaxVoteExtensionSize {
+		return fmt.Errorf("extension is too big (max: %d)", MaxVoteExtensionSize)
+	}
+	if len(vote.ExtensionSignature) > MaxSignatureSize {
+		return fmt.Errorf("extension signature is too big (max: %d)", MaxSignatureSize)
+	}
+
 	return nil
 }
 
@@ -209,14 +265,16 @@ func (vote *Vote) ToProto() *tmproto.Vote {
 	}
 
 	return &tmproto.Vote{
-		Type:             vote.Type,
-		Height:           vote.Height,
-		Round:            vote.Round,
-		BlockID:          vote.BlockID.ToProto(),
-		Timestamp:        vote.Timestamp,
-		ValidatorAddress: vote.ValidatorAddress,
-		ValidatorIndex:   vote.ValidatorIndex,
-		Signature:        vote.Signature,
+		Type:               vote.Type,
+		Height:             vote.Height,
+		Round:              vote.Round,
+		BlockID:            vote.BlockID.ToProto(),
+		Timestamp:          vote.Timestamp,
+		ValidatorAddress:   vote.ValidatorAddress,
+		ValidatorIndex:     vote.ValidatorIndex,
+		Signature:          vote.Signature,
+		Extension:          vote.Extension,
+		ExtensionSignature: vote.ExtensionSignature,
 	}
 }
 
@@ -241,6 +299,8 @@ func VoteFromProto(pv *tmproto.Vote) (*Vote, error) {
 	vote.ValidatorAddress = pv.ValidatorAddress
 	vote.ValidatorIndex = pv.ValidatorIndex
 	vote.Signature = pv.Signature
+	vote.Extension = pv.Extension
+	vote.ExtensionSignature = pv.ExtensionSignature
 
 	return vote, vote.ValidateBasic()
 }
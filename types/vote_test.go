@@ -217,6 +217,41 @@ func TestVoteVerify(t *testing.T) {
 	}
 }
 
+func TestVoteExtension(t *testing.T) {
+	privVal := NewMockPV()
+	pubkey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+
+	vote := examplePrecommit()
+	vote.ValidatorAddress = pubkey.Address()
+	vote.Extension = []byte("extension")
+	v := vote.ToProto()
+	require.NoError(t, privVal.SignVote("test_chain_id", v))
+	vote.Signature = v.Signature
+	vote.ExtensionSignature = v.ExtensionSignature
+
+	require.NoError(t, vote.Verify("test_chain_id", pubkey))
+	require.NoError(t, vote.VerifyExtension("test_chain_id", pubkey))
+	assert.Equal(t, ErrVoteInvalidExtensionSignature, vote.VerifyExtension("other_chain_id", pubkey))
+
+	// the extension can't be moved to a vote for another block
+	other := *vote
+	other.BlockID.Hash = tmhash.Sum([]byte("other block"))
+	assert.Equal(t, ErrVoteInvalidExtensionSignature, other.VerifyExtension("test_chain_id", pubkey))
+
+	// the extension isn't part of the vote sign bytes
+	vote.Extension = []byte("tampered")
+	require.NoError(t, vote.Verify("test_chain_id", pubkey))
+	assert.Equal(t, ErrVoteInvalidExtensionSignature, vote.VerifyExtension("test_chain_id", pubkey))
+
+	// prevotes carry no extension
+	prevote := examplePrevote()
+	v = prevote.ToProto()
+	require.NoError(t, privVal.SignVote("test_chain_id", v))
+	assert.Empty(t, v.ExtensionSignature)
+	require.NoError(t, prevote.VerifyExtension("test_chain_id", pubkey))
+}
+
 func TestVoteString(t *testing.T) {
 	str := examplePrecommit().String()
 	expected := `Vote{56789:6AF1F4111082 12345/02/SIGNED_MSG_TYPE_PRECOMMIT(Precommit) 8B01023386C3 000000000000 @ 2017-12-25T03:00:01.234Z}` //nolint:lll //ignore line length for tests
@@ -249,6 +284,16 @@ func TestVoteValidateBasic(t *testing.T) {
 		{"Invalid ValidatorIndex", func(v *Vote) { v.ValidatorIndex = -1 }, true},
 		{"Invalid Signature", func(v *Vote) { v.Signature = nil }, true},
 		{"Too big Signature", func(v *Vote) { v.Signature = make([]byte, MaxSignatureSize+1) }, true},
+		{"Extension", func(v *Vote) { v.Extension = []byte("extension") }, false},
+		{"Too big Extension", func(v *Vote) { v.Extension = make([]byte, MaxVoteExtensionSize+1) }, true},
+		{"Extension on nil precommit", func(v *Vote) {
+			v.BlockID = BlockID{}
+			v.Extension = []byte("extension")
+		}, true},
+		{"Extension on prevote", func(v *Vote) {
+			v.Type = tmproto.PrevoteType
+			v.Extension = []byte("extension")
+		}, true},
 	}
 	for _, tc := range testCases {
 		tc := tc